@@ -35,6 +35,7 @@ func (cl *Commandline) setupFlags(cmd *cobra.Command, options *server.Options) {
 	cmd.Flags().Bool("replication-is-replica", false, "set systemdb and defaultdb as replica")
 	cmd.Flags().Bool("replication-sync-enabled", false, "enable synchronous replication")
 	cmd.Flags().Int("replication-sync-acks", 0, "set a minimum number of replica acknowledgements required before transactions can be committed")
+	cmd.Flags().Int("replication-max-export-streams-per-replica", options.MaxExportStreamsPerReplica, "maximum number of concurrent streams each replica can use to export transactions (0 = unlimited)")
 	cmd.Flags().String("replication-primary-host", "", "primary database host (if replica=true)")
 	cmd.Flags().Int("replication-primary-port", 3322, "primary database port (if replica=true)")
 	cmd.Flags().String("replication-primary-username", "", "username in the primary database used for replication of systemdb and defaultdb")
@@ -86,6 +87,9 @@ func (cl *Commandline) setupFlags(cmd *cobra.Command, options *server.Options) {
 	cmd.Flags().Duration("sessions-guard-check-interval", 1*time.Minute, "sessions guard check interval")
 	cmd.Flags().MarkHidden("sessions-guard-check-interval")
 	cmd.Flags().Bool("grpc-reflection", options.GRPCReflectionServerEnabled, "GRPC reflection server enabled")
+	cmd.Flags().Duration("document-sweep-frequency", options.DocumentSweepFrequency, "how frequently expired documents are deleted from collections with a ttl (0 = never, expired documents are still excluded from queries)")
+	cmd.Flags().Int("max-document-size", options.MaxDocumentSize, "maximum size in bytes of the documents written into any collection (0 = unlimited)")
+	cmd.Flags().Int("max-document-fields", options.MaxDocumentFields, "maximum number of fields, including nested ones, of the documents written into any collection (0 = unlimited)")
 
 	flagNameMapping := map[string]string{
 		"replication-enabled":           "replication-is-replica",
@@ -145,7 +145,11 @@ func parseOptions() (options *server.Options, err error) {
 		WithSessionOptions(sessionOptions).
 		WithPProf(pprof).
 		WithLogFormat(logFormat).
-		WithGRPCReflectionServerEnabled(grpcReflectionServerEnabled)
+		WithGRPCReflectionServerEnabled(grpcReflectionServerEnabled).
+		WithMaxExportStreamsPerReplica(viper.GetInt("replication-max-export-streams-per-replica")).
+		WithDocumentSweepFrequency(viper.GetDuration("document-sweep-frequency")).
+		WithMaxDocumentSize(viper.GetInt("max-document-size")).
+		WithMaxDocumentFields(viper.GetInt("max-document-fields"))
 
 	return options, nil
 }
@@ -73,6 +73,29 @@ func NewDocumentIDFromTimestamp(timestamp time.Time, txID uint64) DocumentID {
 	return b[:]
 }
 
+// NewSequenceDocumentID generates the DocumentID of a document of a collection with sequential ids,
+// the sequence number is big-endian encoded so ids are sorted as their sequence numbers
+func NewSequenceDocumentID(seq uint64) DocumentID {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], seq)
+	return b[:]
+}
+
+// NewUUIDDocumentID generates a random (version 4) UUID as DocumentID.
+func NewUUIDDocumentID() DocumentID {
+	var b [16]byte
+
+	_, err := io.ReadFull(rand.Reader, b[:])
+	if err != nil {
+		panic(fmt.Errorf("cannot generate uuid document id: %v", err))
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40 // version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // variant 10
+
+	return b[:]
+}
+
 // NewDocumentIDFromRawBytes generates a new DocumentID from a byte slice.
 func NewDocumentIDFromRawBytes(b []byte) (DocumentID, error) {
 	if len(b) == 0 {
@@ -96,7 +119,7 @@ func NewDocumentIDFromHexEncodedString(hexEncodedDocID string) (DocumentID, erro
 
 	_, err := hex.Decode(buf, []byte(hexEncodedDocID))
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %v", ErrIllegalArguments, err)
 	}
 
 	return NewDocumentIDFromRawBytes(buf)
@@ -16,6 +16,7 @@ limitations under the License.
 package document
 
 import (
+	"bytes"
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
@@ -92,6 +93,9 @@ func TestDocumentID_FromDocumentHex(t *testing.T) {
 		genTime := id.Timestamp()
 		require.Equal(t, test.Expected, genTime.String())
 	}
+
+	_, err := NewDocumentIDFromHexEncodedString("invalid")
+	require.ErrorIs(t, err, ErrIllegalArguments)
 }
 
 func TestDocumentID_IncrementalCounter(t *testing.T) {
@@ -125,3 +129,20 @@ func BenchmarkHex(b *testing.B) {
 		id.EncodeToHexString()
 	}
 }
+
+func TestSequenceDocumentID(t *testing.T) {
+	require.Equal(t, "0000000000000001", NewSequenceDocumentID(1).EncodeToHexString())
+	require.Equal(t, "00000000000001ff", NewSequenceDocumentID(511).EncodeToHexString())
+
+	// ids are sorted as their sequence numbers
+	require.Negative(t, bytes.Compare(NewSequenceDocumentID(255), NewSequenceDocumentID(256)))
+}
+
+func TestUUIDDocumentID(t *testing.T) {
+	id := NewUUIDDocumentID()
+	require.Len(t, id, 16)
+	require.Equal(t, byte(0x40), id[6]&0xf0)
+	require.Equal(t, byte(0x80), id[8]&0xc0)
+
+	require.NotEqual(t, id, NewUUIDDocumentID())
+}
@@ -37,12 +37,33 @@ type DocumentReader interface {
 type documentReader struct {
 	rowReader       sql.RowReader
 	onCloseCallback func(reader DocumentReader)
+
+	// only set when documents are projected, otherwise the whole document is read from the first value of each row
+	decodeDocument func(row *sql.Row) (*structpb.Struct, error)
+
+	// only set when documents may expire, in which case offset and limit are applied by the reader
+	isExpired func(row *sql.Row) (bool, error)
+	limit     int64 // no limit when zero
+	offset    int64
+	skipped   int64
+	read      int64
+}
+
+func newDocumentReader(rowReader sql.RowReader, onCloseCallback func(reader DocumentReader)) *documentReader {
+	return &documentReader{
+		rowReader:       rowReader,
+		onCloseCallback: onCloseCallback,
+	}
 }
 
-func newDocumentReader(rowReader sql.RowReader, onCloseCallback func(reader DocumentReader)) DocumentReader {
+// newExpiringDocumentReader returns a reader skipping expired documents
+func newExpiringDocumentReader(rowReader sql.RowReader, isExpired func(row *sql.Row) (bool, error), limit, offset int64, onCloseCallback func(reader DocumentReader)) *documentReader {
 	return &documentReader{
 		rowReader:       rowReader,
 		onCloseCallback: onCloseCallback,
+		isExpired:       isExpired,
+		limit:           limit,
+		offset:          offset,
 	}
 }
 
@@ -57,20 +78,11 @@ func (r *documentReader) ReadN(ctx context.Context, count int) ([]*protomodel.Do
 	var err error
 
 	for l := 0; l < count; l++ {
-		var row *sql.Row
-		row, err = r.rowReader.Read(ctx)
-		if errors.Is(err, sql.ErrNoMoreRows) {
-			err = ErrNoMoreDocuments
+		var doc *structpb.Struct
+		doc, err = r.readDocument(ctx)
+		if errors.Is(err, ErrNoMoreDocuments) {
 			break
 		}
-		if err != nil {
-			return nil, mayTranslateError(err)
-		}
-
-		docBytes := row.ValuesByPosition[0].RawValue().([]byte)
-
-		doc := &structpb.Struct{}
-		err = proto.Unmarshal(docBytes, doc)
 		if err != nil {
 			return nil, err
 		}
@@ -95,28 +107,145 @@ func (r *documentReader) Close() error {
 
 // Read reads a single message from a reader and returns it as a Struct message.
 func (r *documentReader) Read(ctx context.Context) (*protomodel.DocumentAtRevision, error) {
-	var row *sql.Row
-	row, err := r.rowReader.Read(ctx)
-	if errors.Is(err, sql.ErrNoMoreRows) {
-		err = ErrNoMoreDocuments
+	doc, err := r.readDocument(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revision := &protomodel.DocumentAtRevision{
+		TransactionId: 0, // TODO: not yet available
+		Revision:      0, // TODO: not yet available
+		Document:      doc,
 	}
+
+	return revision, err
+}
+
+func (r *documentReader) readDocument(ctx context.Context) (*structpb.Struct, error) {
+	row, err := r.readRow(ctx)
 	if err != nil {
-		return nil, mayTranslateError(err)
+		return nil, err
+	}
+
+	if r.decodeDocument != nil {
+		return r.decodeDocument(row)
 	}
 
-	docBytes := row.ValuesByPosition[0].RawValue().([]byte)
+	return unmarshalDocument(row.ValuesByPosition[0])
+}
 
-	doc := &structpb.Struct{}
-	err = proto.Unmarshal(docBytes, doc)
+func (r *documentReader) readRow(ctx context.Context) (*sql.Row, error) {
+	for {
+		if r.limit > 0 && r.read == r.limit {
+			return nil, ErrNoMoreDocuments
+		}
+
+		row, err := r.rowReader.Read(ctx)
+		if errors.Is(err, sql.ErrNoMoreRows) {
+			err = ErrNoMoreDocuments
+		}
+		if err != nil {
+			return nil, mayTranslateError(err)
+		}
+
+		if r.isExpired != nil {
+			expired, err := r.isExpired(row)
+			if err != nil {
+				return nil, err
+			}
+
+			if expired {
+				continue
+			}
+
+			if r.skipped < r.offset {
+				r.skipped++
+				continue
+			}
+		}
+
+		r.read++
+
+		return row, nil
+	}
+}
+
+// sortedDocumentReader returns documents out of rows sorted in memory,
+// it's used when the requested order can not be provided by an index
+type sortedDocumentReader struct {
+	rows            []*sql.Row
+	decodeDocument  func(row *sql.Row) (*structpb.Struct, error)
+	onCloseCallback func(reader DocumentReader)
+}
+
+func newSortedDocumentReader(rows []*sql.Row, decodeDocument func(row *sql.Row) (*structpb.Struct, error), onCloseCallback func(reader DocumentReader)) *sortedDocumentReader {
+	return &sortedDocumentReader{
+		rows:            rows,
+		decodeDocument:  decodeDocument,
+		onCloseCallback: onCloseCallback,
+	}
+}
+
+// ReadN reads n number of messages from a reader and returns them as a slice of Struct messages.
+func (r *sortedDocumentReader) ReadN(ctx context.Context, count int) ([]*protomodel.DocumentAtRevision, error) {
+	if count < 1 {
+		return nil, sql.ErrIllegalArguments
+	}
+
+	revisions := make([]*protomodel.DocumentAtRevision, 0)
+
+	for l := 0; l < count; l++ {
+		revision, err := r.Read(ctx)
+		if errors.Is(err, ErrNoMoreDocuments) {
+			return revisions, err
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		revisions = append(revisions, revision)
+	}
+
+	return revisions, nil
+}
+
+// Read reads a single message from a reader and returns it as a Struct message.
+func (r *sortedDocumentReader) Read(ctx context.Context) (*protomodel.DocumentAtRevision, error) {
+	if len(r.rows) == 0 {
+		return nil, ErrNoMoreDocuments
+	}
+
+	doc, err := r.decodeDocument(r.rows[0])
 	if err != nil {
 		return nil, err
 	}
 
-	revision := &protomodel.DocumentAtRevision{
+	r.rows = r.rows[1:]
+
+	return &protomodel.DocumentAtRevision{
 		TransactionId: 0, // TODO: not yet available
 		Revision:      0, // TODO: not yet available
 		Document:      doc,
+	}, nil
+}
+
+func (r *sortedDocumentReader) Close() error {
+	r.rows = nil
+
+	if r.onCloseCallback != nil {
+		r.onCloseCallback(r)
 	}
 
-	return revision, err
+	return nil
+}
+
+func unmarshalDocument(value sql.TypedValue) (*structpb.Struct, error) {
+	doc := &structpb.Struct{}
+
+	err := proto.Unmarshal(value.RawValue().([]byte), doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return doc, nil
 }
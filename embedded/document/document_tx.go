@@ -0,0 +1,94 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package document
+
+import (
+	"context"
+
+	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/pkg/api/protomodel"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// DocumentTx groups document writes over one or more collections into a single transaction,
+// none of the writes is visible outside the transaction until it gets committed
+type DocumentTx struct {
+	engine *Engine
+	sqlTx  *sql.SQLTx
+}
+
+// NewDocumentTx starts a transaction, which must be either committed or cancelled
+func (e *Engine) NewDocumentTx(ctx context.Context) (*DocumentTx, error) {
+	sqlTx, err := e.sqlEngine.NewTx(ctx, sql.DefaultTxOptions().WithExplicitClose(true))
+	if err != nil {
+		return nil, mayTranslateError(err)
+	}
+
+	return &DocumentTx{
+		engine: e,
+		sqlTx:  sqlTx,
+	}, nil
+}
+
+// InsertDocuments inserts the documents into the collection as part of the transaction
+func (tx *DocumentTx) InsertDocuments(ctx context.Context, collectionName string, docs []*structpb.Struct) ([]DocumentID, error) {
+	if tx.sqlTx.Closed() {
+		return nil, ErrAlreadyClosed
+	}
+
+	return tx.engine.writeDocuments(ctx, tx.sqlTx, collectionName, docs, true)
+}
+
+// ReplaceDocuments replaces the documents matching the query as part of the transaction,
+// the documents written by the transaction are taken into account when evaluating the query
+func (tx *DocumentTx) ReplaceDocuments(ctx context.Context, query *protomodel.Query, doc *structpb.Struct) ([]DocumentID, error) {
+	if tx.sqlTx.Closed() {
+		return nil, ErrAlreadyClosed
+	}
+
+	return tx.engine.replaceDocumentsInTx(ctx, tx.sqlTx, query, doc, false)
+}
+
+// UpsertDocuments replaces the documents matching the query as part of the transaction,
+// the document is inserted if none matches the query
+func (tx *DocumentTx) UpsertDocuments(ctx context.Context, query *protomodel.Query, doc *structpb.Struct) ([]DocumentID, error) {
+	if tx.sqlTx.Closed() {
+		return nil, ErrAlreadyClosed
+	}
+
+	return tx.engine.replaceDocumentsInTx(ctx, tx.sqlTx, query, doc, true)
+}
+
+// Commit writes every document of the transaction at once and returns the id of the committed transaction,
+// which is zero when no document was written
+func (tx *DocumentTx) Commit(ctx context.Context) (txID uint64, err error) {
+	err = tx.sqlTx.Commit(ctx)
+	if err != nil {
+		return 0, mayTranslateError(err)
+	}
+
+	if !tx.sqlTx.Committed() {
+		return 0, nil
+	}
+
+	return tx.sqlTx.TxHeader().ID, nil
+}
+
+// Cancel discards the transaction, none of its documents is written
+func (tx *DocumentTx) Cancel() error {
+	return mayTranslateError(tx.sqlTx.Cancel())
+}
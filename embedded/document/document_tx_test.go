@@ -0,0 +1,239 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package document
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/protomodel"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestDocumentTx(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	err := engine.CreateCollection(
+		ctx,
+		"customers",
+		"",
+		[]*protomodel.Field{
+			{Name: "name", Type: protomodel.FieldType_STRING},
+		},
+		nil,
+	)
+	require.NoError(t, err)
+
+	err = engine.CreateCollection(
+		ctx,
+		"orders",
+		"",
+		[]*protomodel.Field{
+			{Name: "customer", Type: protomodel.FieldType_STRING},
+			{Name: "amount", Type: protomodel.FieldType_INTEGER},
+		},
+		nil,
+	)
+	require.NoError(t, err)
+
+	queryAll := func(collectionName string) *protomodel.Query {
+		return &protomodel.Query{CollectionName: collectionName}
+	}
+
+	readAll := func(t *testing.T, collectionName string) []*protomodel.DocumentAtRevision {
+		reader, err := engine.GetDocuments(ctx, queryAll(collectionName), 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		revisions, err := reader.ReadN(ctx, 10)
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+
+		return revisions
+	}
+
+	t.Run("writes over multiple collections are committed at once", func(t *testing.T) {
+		tx, err := engine.NewDocumentTx(ctx)
+		require.NoError(t, err)
+		defer tx.Cancel()
+
+		customerIDs, err := tx.InsertDocuments(ctx, "customers", []*structpb.Struct{
+			{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("John")}},
+		})
+		require.NoError(t, err)
+		require.Len(t, customerIDs, 1)
+
+		orderIDs, err := tx.InsertDocuments(ctx, "orders", []*structpb.Struct{
+			{Fields: map[string]*structpb.Value{
+				"customer": structpb.NewStringValue(customerIDs[0].EncodeToHexString()),
+				"amount":   structpb.NewNumberValue(10),
+			}},
+			{Fields: map[string]*structpb.Value{
+				"customer": structpb.NewStringValue(customerIDs[0].EncodeToHexString()),
+				"amount":   structpb.NewNumberValue(20),
+			}},
+		})
+		require.NoError(t, err)
+		require.Len(t, orderIDs, 2)
+
+		// documents written by the transaction are visible to its own queries
+		replacedIDs, err := tx.ReplaceDocuments(ctx, &protomodel.Query{
+			CollectionName: "orders",
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{Field: "amount", Operator: protomodel.ComparisonOperator_EQ, Value: structpb.NewNumberValue(20)},
+					},
+				},
+			},
+		}, &structpb.Struct{Fields: map[string]*structpb.Value{
+			"customer": structpb.NewStringValue(customerIDs[0].EncodeToHexString()),
+			"amount":   structpb.NewNumberValue(25),
+		}})
+		require.NoError(t, err)
+		require.Equal(t, orderIDs[1:], replacedIDs)
+
+		// but not outside of it until committed
+		require.Empty(t, readAll(t, "customers"))
+		require.Empty(t, readAll(t, "orders"))
+
+		txID, err := tx.Commit(ctx)
+		require.NoError(t, err)
+		require.NotZero(t, txID)
+
+		require.Len(t, readAll(t, "customers"), 1)
+
+		orders := readAll(t, "orders")
+		require.Len(t, orders, 2)
+
+		for _, order := range orders {
+			require.Equal(t, customerIDs[0].EncodeToHexString(), order.Document.Fields["customer"].GetStringValue())
+		}
+
+		auditTxID := func(collectionName string, docID DocumentID) uint64 {
+			revisions, err := engine.AuditDocument(ctx, collectionName, docID, false, 0, 10)
+			require.NoError(t, err)
+			require.Len(t, revisions, 1)
+
+			return revisions[0].TransactionId
+		}
+
+		require.Equal(t, txID, auditTxID("customers", customerIDs[0]))
+		require.Equal(t, txID, auditTxID("orders", orderIDs[0]))
+		require.Equal(t, txID, auditTxID("orders", orderIDs[1]))
+
+		_, err = tx.InsertDocuments(ctx, "customers", []*structpb.Struct{
+			{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("Mary")}},
+		})
+		require.ErrorIs(t, err, ErrAlreadyClosed)
+
+		_, err = tx.ReplaceDocuments(ctx, queryAll("customers"), nil)
+		require.ErrorIs(t, err, ErrAlreadyClosed)
+
+		_, err = tx.UpsertDocuments(ctx, queryAll("customers"), nil)
+		require.ErrorIs(t, err, ErrAlreadyClosed)
+	})
+
+	t.Run("cancelled transactions do not write any document", func(t *testing.T) {
+		tx, err := engine.NewDocumentTx(ctx)
+		require.NoError(t, err)
+
+		_, err = tx.InsertDocuments(ctx, "customers", []*structpb.Struct{
+			{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("Mary")}},
+		})
+		require.NoError(t, err)
+
+		err = tx.Cancel()
+		require.NoError(t, err)
+
+		require.Len(t, readAll(t, "customers"), 1)
+	})
+
+	t.Run("transactions without writes", func(t *testing.T) {
+		tx, err := engine.NewDocumentTx(ctx)
+		require.NoError(t, err)
+
+		txID, err := tx.Commit(ctx)
+		require.NoError(t, err)
+		require.Zero(t, txID)
+	})
+
+	t.Run("writes over an unexistent collection", func(t *testing.T) {
+		tx, err := engine.NewDocumentTx(ctx)
+		require.NoError(t, err)
+		defer tx.Cancel()
+
+		_, err = tx.InsertDocuments(ctx, "suppliers", []*structpb.Struct{
+			{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("ACME")}},
+		})
+		require.ErrorIs(t, err, ErrCollectionDoesNotExist)
+
+		_, err = tx.ReplaceDocuments(ctx, queryAll("suppliers"), nil)
+		require.ErrorIs(t, err, ErrCollectionDoesNotExist)
+
+		_, err = tx.UpsertDocuments(ctx, nil, nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	t.Run("concurrent transactions replacing the same documents", func(t *testing.T) {
+		doc := &structpb.Struct{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("Johnny")}}
+
+		tx1, err := engine.NewDocumentTx(ctx)
+		require.NoError(t, err)
+		defer tx1.Cancel()
+
+		tx2, err := engine.NewDocumentTx(ctx)
+		require.NoError(t, err)
+		defer tx2.Cancel()
+
+		_, err = tx1.ReplaceDocuments(ctx, queryAll("customers"), doc)
+		require.NoError(t, err)
+
+		_, err = tx2.ReplaceDocuments(ctx, queryAll("customers"), doc)
+		require.NoError(t, err)
+
+		_, err = tx1.Commit(ctx)
+		require.NoError(t, err)
+
+		_, err = tx2.Commit(ctx)
+		require.ErrorIs(t, err, ErrConflict)
+	})
+
+	t.Run("upserting documents", func(t *testing.T) {
+		tx, err := engine.NewDocumentTx(ctx)
+		require.NoError(t, err)
+		defer tx.Cancel()
+
+		docIDs, err := tx.UpsertDocuments(ctx, &protomodel.Query{
+			CollectionName: "customers",
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{Field: "name", Operator: protomodel.ComparisonOperator_EQ, Value: structpb.NewStringValue("Mary")},
+					},
+				},
+			},
+		}, &structpb.Struct{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("Mary")}})
+		require.NoError(t, err)
+		require.Len(t, docIDs, 1)
+
+		_, err = tx.Commit(ctx)
+		require.NoError(t, err)
+
+		require.Len(t, readAll(t, "customers"), 2)
+	})
+}
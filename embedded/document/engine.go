@@ -18,10 +18,14 @@ package document
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/embedded/store"
@@ -35,6 +39,17 @@ const (
 	DefaultDocumentIDField     = "_id"
 	DocumentBLOBField          = "_doc"
 	documentFieldPathSeparator = "."
+
+	// the ttl of a collection is kept alongside the sql catalog (key=DOC.TTL.{tableID}, value={ttl})
+	collectionTTLPrefix = "DOC.TTL."
+
+	// the id generator of a collection is kept alongside the sql catalog (key=DOC.IDGEN.{tableID}, value={generator}),
+	// it's only set when ids are not generated as object ids
+	collectionIDGeneratorPrefix = "DOC.IDGEN."
+
+	// the document limits of a collection are kept alongside the sql catalog
+	// (key=DOC.LIMITS.{tableID}, value={maxDocumentSize}{maxDocumentFields}), they're only set when any is specified
+	collectionLimitsPrefix = "DOC.LIMITS."
 )
 
 var reservedWords = map[string]struct{}{
@@ -51,10 +66,21 @@ var fieldNameValidation = regexp.MustCompile(`^[a-zA-Z_]+[a-zA-Z0-9_\-.]*$`)
 type Engine struct {
 	sqlEngine *sql.Engine
 
-	maxNestedFields int
+	maxNestedFields   int
+	maxSortBufferSize int
+
+	// limits enforced on the documents written into any collection
+	maxDocumentSize   int
+	maxDocumentFields int
+
+	// sequence numbers are assigned by the engine so concurrent transactions never assign the same id,
+	// the last assigned one of each collection is loaded when a document is first inserted into it
+	sequencesMutex sync.Mutex
+	sequences      map[uint32]uint64 // last assigned sequence number by table id
 }
 
 type EncodedDocument struct {
+	Key             []byte // key of the entry holding the document
 	TxID            uint64
 	Revision        uint64 // revision is only set when txID == 0 and info is fetch from the index
 	KVMetadata      *store.KVMetadata
@@ -77,8 +103,12 @@ func NewEngine(store *store.ImmuStore, opts *Options) (*Engine, error) {
 	}
 
 	return &Engine{
-		sqlEngine:       engine,
-		maxNestedFields: opts.maxNestedFields,
+		sqlEngine:         engine,
+		maxNestedFields:   opts.maxNestedFields,
+		maxSortBufferSize: opts.maxSortBufferSize,
+		maxDocumentSize:   opts.maxDocumentSize,
+		maxDocumentFields: opts.maxDocumentFields,
+		sequences:         make(map[uint32]uint64),
 	}, nil
 }
 
@@ -130,11 +160,41 @@ func validateFieldName(fieldName string) error {
 }
 
 func (e *Engine) CreateCollection(ctx context.Context, name, documentIdFieldName string, fields []*protomodel.Field, indexes []*protomodel.Index) error {
+	return e.CreateCollectionWithOptions(ctx, name, documentIdFieldName, fields, indexes, DefaultCollectionOptions())
+}
+
+// documentLimits restricts the documents written into a collection, zero values mean no restriction
+type documentLimits struct {
+	// MaxDocumentSize is the maximum size in bytes of the serialized document, including its id field
+	MaxDocumentSize int
+	// MaxDocumentFields is the maximum number of fields of the document, including its id field
+	// and the fields of nested documents
+	MaxDocumentFields int
+}
+
+// CreateCollectionWithOptions creates a collection whose document ids generation, expiration and limits
+// are set by the given options
+func (e *Engine) CreateCollectionWithOptions(
+	ctx context.Context,
+	name, documentIdFieldName string,
+	fields []*protomodel.Field,
+	indexes []*protomodel.Index,
+	collectionOpts *CollectionOptions,
+) error {
 	err := validateCollectionName(name)
 	if err != nil {
 		return err
 	}
 
+	err = collectionOpts.Validate()
+	if err != nil {
+		return err
+	}
+
+	idGenerator := collectionOpts.idGenerator
+	ttl := collectionOpts.ttl
+	limits := collectionOpts.limits
+
 	if documentIdFieldName == "" {
 		documentIdFieldName = DefaultDocumentIDField
 	}
@@ -175,17 +235,10 @@ func (e *Engine) CreateCollection(ctx context.Context, name, documentIdFieldName
 			return fmt.Errorf("%w: id field name '%s' should not be specified", ErrIllegalArguments, field.Name)
 		}
 
-		sqlType, err := protomodelValueTypeToSQLValueType(field.Type)
-		if err != nil {
-			return err
-		}
-
-		colLen, err := sqlValueTypeDefaultLength(sqlType)
+		columns[i+2], err = colSpecForField(field)
 		if err != nil {
 			return err
 		}
-
-		columns[i+2] = sql.NewColSpec(field.Name, sqlType, colLen, false, false)
 	}
 
 	_, _, err = e.sqlEngine.ExecPreparedStmts(
@@ -241,10 +294,304 @@ func (e *Engine) CreateCollection(ctx context.Context, name, documentIdFieldName
 		}
 	}
 
+	if ttl > 0 {
+		table, err := getTableForCollection(sqlTx, name)
+		if err != nil {
+			return err
+		}
+
+		var encTTL [8]byte
+		binary.BigEndian.PutUint64(encTTL[:], uint64(ttl))
+
+		err = sqlTx.Set(e.collectionTTLKey(table.ID()), nil, encTTL[:])
+		if err != nil {
+			return mayTranslateError(err)
+		}
+	}
+
+	if idGenerator != protomodel.DocumentIdGenerator_OBJECT_ID {
+		table, err := getTableForCollection(sqlTx, name)
+		if err != nil {
+			return err
+		}
+
+		err = sqlTx.Set(e.collectionIDGeneratorKey(table.ID()), nil, []byte{byte(idGenerator)})
+		if err != nil {
+			return mayTranslateError(err)
+		}
+	}
+
+	if limits != (documentLimits{}) {
+		table, err := getTableForCollection(sqlTx, name)
+		if err != nil {
+			return err
+		}
+
+		var encLimits [16]byte
+		binary.BigEndian.PutUint64(encLimits[:], uint64(limits.MaxDocumentSize))
+		binary.BigEndian.PutUint64(encLimits[8:], uint64(limits.MaxDocumentFields))
+
+		err = sqlTx.Set(e.collectionLimitsKey(table.ID()), nil, encLimits[:])
+		if err != nil {
+			return mayTranslateError(err)
+		}
+	}
+
 	err = sqlTx.Commit(ctx)
 	return mayTranslateError(err)
 }
 
+func colSpecForField(field *protomodel.Field) (*sql.ColSpec, error) {
+	sqlType, err := protomodelValueTypeToSQLValueType(field.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	colLen, err := sqlValueTypeDefaultLength(sqlType)
+	if err != nil {
+		return nil, err
+	}
+
+	return sql.NewColSpec(field.Name, sqlType, colLen, false, false), nil
+}
+
+func (e *Engine) collectionTTLKey(tableID uint32) []byte {
+	return sql.MapKey(e.sqlEngine.GetPrefix(), collectionTTLPrefix, sql.EncodeID(tableID))
+}
+
+// collectionTTL returns the ttl of the collection as seen by the transaction, zero if documents never expire
+func (e *Engine) collectionTTL(sqlTx *sql.SQLTx, table *sql.Table) (time.Duration, error) {
+	vref, err := sqlTx.Get(e.collectionTTLKey(table.ID()))
+	if errors.Is(err, store.ErrKeyNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, mayTranslateError(err)
+	}
+
+	encTTL, err := vref.Resolve()
+	if err != nil {
+		return 0, mayTranslateError(err)
+	}
+
+	if len(encTTL) != 8 {
+		return 0, fmt.Errorf("%w: invalid ttl of collection '%s'", store.ErrCorruptedData, table.Name())
+	}
+
+	return time.Duration(binary.BigEndian.Uint64(encTTL)), nil
+}
+
+func (e *Engine) collectionIDGeneratorKey(tableID uint32) []byte {
+	return sql.MapKey(e.sqlEngine.GetPrefix(), collectionIDGeneratorPrefix, sql.EncodeID(tableID))
+}
+
+// collectionIDGenerator returns how the ids of the documents inserted into the collection are generated
+func (e *Engine) collectionIDGenerator(sqlTx *sql.SQLTx, table *sql.Table) (protomodel.DocumentIdGenerator, error) {
+	vref, err := sqlTx.Get(e.collectionIDGeneratorKey(table.ID()))
+	if errors.Is(err, store.ErrKeyNotFound) {
+		return protomodel.DocumentIdGenerator_OBJECT_ID, nil
+	}
+	if err != nil {
+		return 0, mayTranslateError(err)
+	}
+
+	encIDGenerator, err := vref.Resolve()
+	if err != nil {
+		return 0, mayTranslateError(err)
+	}
+
+	if len(encIDGenerator) != 1 {
+		return 0, fmt.Errorf("%w: invalid id generator of collection '%s'", store.ErrCorruptedData, table.Name())
+	}
+
+	return protomodel.DocumentIdGenerator(encIDGenerator[0]), nil
+}
+
+func (e *Engine) collectionLimitsKey(tableID uint32) []byte {
+	return sql.MapKey(e.sqlEngine.GetPrefix(), collectionLimitsPrefix, sql.EncodeID(tableID))
+}
+
+// collectionLimits returns the document limits the collection was created with
+func (e *Engine) collectionLimits(sqlTx *sql.SQLTx, table *sql.Table) (documentLimits, error) {
+	vref, err := sqlTx.Get(e.collectionLimitsKey(table.ID()))
+	if errors.Is(err, store.ErrKeyNotFound) {
+		return documentLimits{}, nil
+	}
+	if err != nil {
+		return documentLimits{}, mayTranslateError(err)
+	}
+
+	encLimits, err := vref.Resolve()
+	if err != nil {
+		return documentLimits{}, mayTranslateError(err)
+	}
+
+	if len(encLimits) != 16 {
+		return documentLimits{}, fmt.Errorf("%w: invalid document limits of collection '%s'", store.ErrCorruptedData, table.Name())
+	}
+
+	return documentLimits{
+		MaxDocumentSize:   int(binary.BigEndian.Uint64(encLimits)),
+		MaxDocumentFields: int(binary.BigEndian.Uint64(encLimits[8:])),
+	}, nil
+}
+
+// effectiveLimit returns the lowest of the limits, zero meaning no limit
+func effectiveLimit(engineLimit, collectionLimit int) int {
+	if engineLimit == 0 || (collectionLimit > 0 && collectionLimit < engineLimit) {
+		return collectionLimit
+	}
+
+	return engineLimit
+}
+
+// checkdocumentLimits validates the document, as it's going to be written, against the limits of the engine
+// and the ones of the collection
+func (e *Engine) checkdocumentLimits(doc *structpb.Struct, limits documentLimits) error {
+	maxDocumentFields := effectiveLimit(e.maxDocumentFields, limits.MaxDocumentFields)
+
+	if maxDocumentFields > 0 {
+		fieldCount := countDocumentFields(doc)
+
+		if fieldCount > maxDocumentFields {
+			return fmt.Errorf("%w: the document has %d fields but the maximum allowed is %d",
+				ErrMaxFieldsExceeded, fieldCount, maxDocumentFields)
+		}
+	}
+
+	maxDocumentSize := effectiveLimit(e.maxDocumentSize, limits.MaxDocumentSize)
+
+	if maxDocumentSize > 0 {
+		size := proto.Size(doc)
+
+		if size > maxDocumentSize {
+			return fmt.Errorf("%w: the document size is %d bytes but the maximum allowed is %d bytes",
+				ErrMaxDocumentSizeExceeded, size, maxDocumentSize)
+		}
+	}
+
+	return nil
+}
+
+// countDocumentFields returns the number of fields of the document, including the ones of nested documents
+func countDocumentFields(doc *structpb.Struct) int {
+	count := len(doc.GetFields())
+
+	for _, value := range doc.GetFields() {
+		count += countNestedFields(value)
+	}
+
+	return count
+}
+
+func countNestedFields(value *structpb.Value) int {
+	switch kind := value.GetKind().(type) {
+	case *structpb.Value_StructValue:
+		return countDocumentFields(kind.StructValue)
+	case *structpb.Value_ListValue:
+		count := 0
+
+		for _, v := range kind.ListValue.GetValues() {
+			count += countNestedFields(v)
+		}
+
+		return count
+	}
+
+	return 0
+}
+
+// nextSequenceNumber assigns the next sequence number of a collection with sequential ids
+func (e *Engine) nextSequenceNumber(ctx context.Context, table *sql.Table) (uint64, error) {
+	e.sequencesMutex.Lock()
+	defer e.sequencesMutex.Unlock()
+
+	seq, loaded := e.sequences[table.ID()]
+	if !loaded {
+		var err error
+
+		seq, err = e.loadSequence(ctx, table)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	seq++
+	e.sequences[table.ID()] = seq
+
+	return seq, nil
+}
+
+// loadSequence returns the last sequence number assigned in a collection, which is the one of the last
+// document written into it. Deleted documents are considered as well so their ids are not assigned again.
+func (e *Engine) loadSequence(ctx context.Context, table *sql.Table) (uint64, error) {
+	st := e.sqlEngine.GetStore()
+
+	snap, err := st.SnapshotMustIncludeTxID(ctx, st.LastPrecommittedTxID())
+	if err != nil {
+		return 0, mayTranslateError(err)
+	}
+	defer snap.Close()
+
+	prefix := sql.MapKey(
+		e.sqlEngine.GetPrefix(),
+		sql.PIndexPrefix,
+		sql.EncodeID(1),
+		sql.EncodeID(table.ID()),
+		sql.EncodeID(table.PrimaryIndex().ID()),
+	)
+
+	r, err := snap.NewKeyReader(store.KeyReaderSpec{
+		Prefix:    prefix,
+		DescOrder: true,
+	})
+	if err != nil {
+		return 0, mayTranslateError(err)
+	}
+	defer r.Close()
+
+	key, _, err := r.Read()
+	if errors.Is(err, store.ErrNoMoreEntries) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, mayTranslateError(err)
+	}
+
+	// notnull + id + padding + len(id)
+	encDocID := key[len(prefix):]
+
+	if len(encDocID) != 1+MaxDocumentIDLength+sql.EncLenLen ||
+		binary.BigEndian.Uint32(encDocID[1+MaxDocumentIDLength:]) != 8 {
+		return 0, fmt.Errorf("%w: invalid sequential id in collection '%s'", store.ErrCorruptedData, table.Name())
+	}
+
+	return binary.BigEndian.Uint64(encDocID[1:]), nil
+}
+
+// generateDocumentID generates the id of a document being inserted into the collection
+func (e *Engine) generateDocumentID(ctx context.Context, table *sql.Table, idGenerator protomodel.DocumentIdGenerator) (DocumentID, error) {
+	switch idGenerator {
+	case protomodel.DocumentIdGenerator_SEQUENCE:
+		{
+			seq, err := e.nextSequenceNumber(ctx, table)
+			if err != nil {
+				return nil, err
+			}
+
+			return NewSequenceDocumentID(seq), nil
+		}
+	case protomodel.DocumentIdGenerator_UUID:
+		{
+			return NewUUIDDocumentID(), nil
+		}
+	default:
+		{
+			return NewDocumentIDFromTx(e.sqlEngine.GetStore().LastPrecommittedTxID()), nil
+		}
+	}
+}
+
 func (e *Engine) GetCollection(ctx context.Context, collectionName string) (*protomodel.Collection, error) {
 	opts := sql.DefaultTxOptions().
 		WithReadOnly(true).
@@ -261,7 +608,102 @@ func (e *Engine) GetCollection(ctx context.Context, collectionName string) (*pro
 		return nil, err
 	}
 
-	return collectionFromTable(table), nil
+	return e.collectionFromTable(sqlTx, table)
+}
+
+// CollectionStats holds storage statistics of a collection
+type CollectionStats struct {
+	// DocumentCount is the number of documents, expired documents are included until deleted
+	DocumentCount int64
+	// IndexCount is the number of indexes, including the one over the document id field
+	IndexCount int
+	// Size is the approximate size in bytes of the current revision of the documents and their index entries,
+	// previous revisions, which are kept as well, are not taken into account
+	Size int64
+}
+
+// CollectionStats returns the number of documents and indexes of a collection and an estimation of its size,
+// computed by scanning the entries of the indexes of the collection
+func (e *Engine) CollectionStats(ctx context.Context, collectionName string) (*CollectionStats, error) {
+	err := validateCollectionName(collectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlTx, err := e.sqlEngine.NewTx(ctx, sql.DefaultTxOptions().WithReadOnly(true))
+	if err != nil {
+		return nil, mayTranslateError(err)
+	}
+	defer sqlTx.Cancel()
+
+	table, err := getTableForCollection(sqlTx, collectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	indexes := table.GetIndexes()
+
+	stats := &CollectionStats{
+		IndexCount: len(indexes),
+	}
+
+	for _, index := range indexes {
+		count, size, err := e.scanIndexEntries(ctx, sqlTx, table, index)
+		if err != nil {
+			return nil, err
+		}
+
+		if index.IsPrimary() {
+			stats.DocumentCount = count
+		}
+
+		stats.Size += size
+	}
+
+	return stats, nil
+}
+
+// scanIndexEntries returns the number of entries of an index and the size of their keys and values
+func (e *Engine) scanIndexEntries(ctx context.Context, sqlTx *sql.SQLTx, table *sql.Table, index *sql.Index) (count, size int64, err error) {
+	indexPrefix := sql.SIndexPrefix
+
+	if index.IsPrimary() {
+		indexPrefix = sql.PIndexPrefix
+	} else if index.IsUnique() {
+		indexPrefix = sql.UIndexPrefix
+	}
+
+	r, err := sqlTx.NewKeyReader(store.KeyReaderSpec{
+		Prefix: sql.MapKey(
+			e.sqlEngine.GetPrefix(),
+			indexPrefix,
+			sql.EncodeID(1),
+			sql.EncodeID(table.ID()),
+			sql.EncodeID(index.ID()),
+		),
+		Filters: []store.FilterFn{store.IgnoreExpired, store.IgnoreDeleted},
+	})
+	if err != nil {
+		return 0, 0, mayTranslateError(err)
+	}
+	defer r.Close()
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return 0, 0, err
+		}
+
+		key, valRef, err := r.Read()
+		if errors.Is(err, store.ErrNoMoreEntries) {
+			return count, size, nil
+		}
+		if err != nil {
+			return 0, 0, mayTranslateError(err)
+		}
+
+		count++
+		size += int64(len(key)) + int64(valRef.Len())
+	}
 }
 
 func (e *Engine) GetCollections(ctx context.Context) ([]*protomodel.Collection, error) {
@@ -280,7 +722,10 @@ func (e *Engine) GetCollections(ctx context.Context) ([]*protomodel.Collection,
 	collections := make([]*protomodel.Collection, len(tables))
 
 	for i, table := range tables {
-		collections[i] = collectionFromTable(table)
+		collections[i], err = e.collectionFromTable(sqlTx, table)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return collections, nil
@@ -318,7 +763,22 @@ func getColumnForField(table *sql.Table, field string) (*sql.Column, error) {
 	return column, mayTranslateError(err)
 }
 
-func collectionFromTable(table *sql.Table) *protomodel.Collection {
+func (e *Engine) collectionFromTable(sqlTx *sql.SQLTx, table *sql.Table) (*protomodel.Collection, error) {
+	ttl, err := e.collectionTTL(sqlTx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	idGenerator, err := e.collectionIDGenerator(sqlTx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	limits, err := e.collectionLimits(sqlTx, table)
+	if err != nil {
+		return nil, err
+	}
+
 	documentIdFieldName := docIDFieldName(table)
 
 	indexes := table.GetIndexes()
@@ -327,6 +787,10 @@ func collectionFromTable(table *sql.Table) *protomodel.Collection {
 		Name:                table.Name(),
 		DocumentIdFieldName: documentIdFieldName,
 		Indexes:             make([]*protomodel.Index, len(indexes)),
+		Ttl:                 int64(ttl / time.Second),
+		DocumentIdGenerator: idGenerator,
+		MaxDocumentSize:     int64(limits.MaxDocumentSize),
+		MaxDocumentFields:   int64(limits.MaxDocumentFields),
 	}
 
 	for _, col := range table.Cols() {
@@ -348,6 +812,8 @@ func collectionFromTable(table *sql.Table) *protomodel.Collection {
 				colType = protomodel.FieldType_INTEGER
 			case sql.Float64Type:
 				colType = protomodel.FieldType_DOUBLE
+			case sql.TimestampType:
+				colType = protomodel.FieldType_TIMESTAMP
 			}
 		}
 
@@ -370,7 +836,7 @@ func collectionFromTable(table *sql.Table) *protomodel.Collection {
 		}
 	}
 
-	return collection
+	return collection, nil
 }
 
 func (e *Engine) UpdateCollection(ctx context.Context, collectionName string, documentIdFieldName string) error {
@@ -427,17 +893,23 @@ func (e *Engine) UpdateCollection(ctx context.Context, collectionName string, do
 	return mayTranslateError(err)
 }
 
-// DeleteCollection deletes a collection.
-func (e *Engine) DeleteCollection(ctx context.Context, collectionName string) error {
+// AlterCollection adds fields and indexes to an existing collection. Neither fields nor the document id can be removed.
+// Documents already holding a value for an added field are rewritten within the same transaction so that
+// the field can be filtered by and indexed, thus the alteration fails if any of those values does not match
+// the type of the field. Note the rewritten documents get a new revision, which restarts their ttl if any.
+func (e *Engine) AlterCollection(ctx context.Context, collectionName string, fields []*protomodel.Field, indexes []*protomodel.Index) error {
 	err := validateCollectionName(collectionName)
 	if err != nil {
 		return err
 	}
 
+	if len(fields) == 0 && len(indexes) == 0 {
+		return fmt.Errorf("%w: no fields or indexes specified", ErrIllegalArguments)
+	}
+
+	// existing documents may be rewritten within the same transaction,
+	// thus the snapshot must be up to date and read conflicts must be detected
 	opts := sql.DefaultTxOptions().
-		WithUnsafeMVCC(true).
-		WithSnapshotMustIncludeTxID(func(lastPrecommittedTxID uint64) uint64 { return 0 }).
-		WithSnapshotRenewalPeriod(0).
 		WithExplicitClose(true)
 
 	sqlTx, err := e.sqlEngine.NewTx(ctx, opts)
@@ -446,22 +918,288 @@ func (e *Engine) DeleteCollection(ctx context.Context, collectionName string) er
 	}
 	defer sqlTx.Cancel()
 
-	_, _, err = e.sqlEngine.ExecPreparedStmts(
-		ctx,
-		sqlTx,
-		[]sql.SQLStmt{
-			sql.NewDropTableStmt(collectionName), // delete collection from catalog
-		},
+	table, err := getTableForCollection(sqlTx, collectionName)
+	if err != nil {
+		return err
+	}
+
+	documentIdFieldName := docIDFieldName(table)
+
+	var stmts []sql.SQLStmt
+
+	for _, field := range fields {
+		err := validateFieldName(field.Name)
+		if err != nil {
+			return err
+		}
+
+		if field.Name == documentIdFieldName {
+			return fmt.Errorf("%w: id field '%s' can not be altered", ErrIllegalArguments, field.Name)
+		}
+
+		colSpec, err := colSpecForField(field)
+		if err != nil {
+			return err
+		}
+
+		stmts = append(stmts, sql.NewAddColumnStmt(collectionName, colSpec))
+	}
+
+	for _, index := range indexes {
+		if len(index.Fields) == 0 {
+			return fmt.Errorf("%w: no fields specified", ErrIllegalArguments)
+		}
+
+		for _, field := range index.Fields {
+			err := validateFieldName(field)
+			if err != nil {
+				return err
+			}
+
+			if field == documentIdFieldName {
+				return fmt.Errorf("%w: the primary index on id field '%s' can not be altered", ErrIllegalArguments, field)
+			}
+		}
+
+		stmts = append(stmts, sql.NewCreateIndexStmt(collectionName, index.Fields, index.IsUnique))
+	}
+
+	_, _, err = e.sqlEngine.ExecPreparedStmts(ctx, sqlTx, stmts, nil)
+	if err != nil {
+		return mayTranslateError(err)
+	}
+
+	if len(fields) > 0 {
+		err = e.rewriteDocumentsWithFields(ctx, sqlTx, collectionName, fields)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = sqlTx.Commit(ctx)
+	return mayTranslateError(err)
+}
+
+// rewriteDocumentsWithFields rewrites the rows of the documents holding a value for any of the fields,
+// so the columns of the fields get populated. Expired documents are left as they are.
+func (e *Engine) rewriteDocumentsWithFields(ctx context.Context, sqlTx *sql.SQLTx, collectionName string, fields []*protomodel.Field) error {
+	table, err := getTableForCollection(sqlTx, collectionName)
+	if err != nil {
+		return err
+	}
+
+	ttl, err := e.collectionTTL(sqlTx, table)
+	if err != nil {
+		return err
+	}
+
+	var isExpired func(row *sql.Row) (bool, error)
+	if ttl > 0 {
+		isExpired = e.documentExpiration(sqlTx, table, ttl, 1)
+	}
+
+	op := sql.NewSelectStmt(
+		[]sql.Selector{
+			sql.NewColSelector(collectionName, DocumentBLOBField),
+			sql.NewColSelector(collectionName, docIDFieldName(table)),
+		},
+		collectionName,
+		nil,
+		nil,
+		nil,
 		nil,
 	)
+
+	r, err := e.sqlEngine.QueryPreparedStmt(ctx, sqlTx, op, nil)
 	if err != nil {
 		return mayTranslateError(err)
 	}
 
-	err = sqlTx.Commit(ctx)
+	var rows []*sql.RowSpec
+
+	for {
+		row, err := r.Read(ctx)
+		if errors.Is(err, sql.ErrNoMoreRows) {
+			break
+		}
+		if err != nil {
+			r.Close()
+			return mayTranslateError(err)
+		}
+
+		if isExpired != nil {
+			expired, err := isExpired(row)
+			if err != nil {
+				r.Close()
+				return err
+			}
+
+			if expired {
+				continue
+			}
+		}
+
+		doc, err := unmarshalDocument(row.ValuesByPosition[0])
+		if err != nil {
+			r.Close()
+			return err
+		}
+
+		for _, field := range fields {
+			val, err := e.structValueFromFieldPath(doc, field.Name)
+			if err != nil && !errors.Is(err, ErrFieldDoesNotExist) {
+				r.Close()
+				return fmt.Errorf("%w: field: %s", err, field.Name)
+			}
+
+			if val == nil {
+				continue
+			}
+
+			// the id field of the collection may have been renamed since the document was written
+			docID := DocumentID(row.ValuesByPosition[1].RawValue().([]byte))
+			doc.Fields[docIDFieldName(table)] = structpb.NewStringValue(docID.EncodeToHexString())
+
+			rowSpec, err := e.generateRowSpecForDocument(table, doc)
+			if err != nil {
+				r.Close()
+				return err
+			}
+
+			rows = append(rows, rowSpec)
+			break
+		}
+	}
+
+	r.Close()
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	colNames := make([]string, len(table.Cols()))
+
+	for i, col := range table.Cols() {
+		colNames[i] = col.Name()
+	}
+
+	_, _, err = e.sqlEngine.ExecPreparedStmts(
+		ctx,
+		sqlTx,
+		[]sql.SQLStmt{
+			sql.NewUpserIntoStmt(
+				collectionName,
+				colNames,
+				rows,
+				false,
+				nil,
+			),
+		},
+		nil,
+	)
 	return mayTranslateError(err)
 }
 
+// DeleteCollection deletes a collection.
+// DeleteCollection removes the collection from the catalog, deleting an unexistent collection has no effect
+func (e *Engine) DeleteCollection(ctx context.Context, collectionName string) error {
+	err := validateCollectionName(collectionName)
+	if err != nil {
+		return err
+	}
+
+	opts := sql.DefaultTxOptions().
+		WithUnsafeMVCC(true).
+		WithSnapshotMustIncludeTxID(func(lastPrecommittedTxID uint64) uint64 { return 0 }).
+		WithSnapshotRenewalPeriod(0).
+		WithExplicitClose(true)
+
+	sqlTx, err := e.sqlEngine.NewTx(ctx, opts)
+	if err != nil {
+		return mayTranslateError(err)
+	}
+	defer sqlTx.Cancel()
+
+	table, err := sqlTx.Catalog().GetTableByName(collectionName)
+	if errors.Is(err, sql.ErrTableDoesNotExist) {
+		// the collection was already deleted
+		return nil
+	}
+	if err != nil {
+		return mayTranslateError(err)
+	}
+
+	_, _, err = e.sqlEngine.ExecPreparedStmts(
+		ctx,
+		sqlTx,
+		[]sql.SQLStmt{
+			sql.NewDropTableStmt(collectionName), // delete collection from catalog
+		},
+		nil,
+	)
+	if err != nil {
+		return mayTranslateError(err)
+	}
+
+	ttl, err := e.collectionTTL(sqlTx, table)
+	if err != nil {
+		return err
+	}
+
+	if ttl > 0 {
+		md := store.NewKVMetadata()
+		md.AsDeleted(true)
+
+		err = sqlTx.Set(e.collectionTTLKey(table.ID()), md, nil)
+		if err != nil {
+			return mayTranslateError(err)
+		}
+	}
+
+	idGenerator, err := e.collectionIDGenerator(sqlTx, table)
+	if err != nil {
+		return err
+	}
+
+	if idGenerator != protomodel.DocumentIdGenerator_OBJECT_ID {
+		md := store.NewKVMetadata()
+		md.AsDeleted(true)
+
+		err = sqlTx.Set(e.collectionIDGeneratorKey(table.ID()), md, nil)
+		if err != nil {
+			return mayTranslateError(err)
+		}
+	}
+
+	limits, err := e.collectionLimits(sqlTx, table)
+	if err != nil {
+		return err
+	}
+
+	if limits != (documentLimits{}) {
+		md := store.NewKVMetadata()
+		md.AsDeleted(true)
+
+		err = sqlTx.Set(e.collectionLimitsKey(table.ID()), md, nil)
+		if err != nil {
+			return mayTranslateError(err)
+		}
+	}
+
+	err = sqlTx.Commit(ctx)
+	if err != nil {
+		return mayTranslateError(err)
+	}
+
+	e.sequencesMutex.Lock()
+	delete(e.sequences, table.ID())
+	e.sequencesMutex.Unlock()
+
+	return nil
+}
+
+// CreateIndex creates an index over the specified fields, documents already in the collection are indexed
+// within the same transaction, ErrTooManyDocumentsToIndex is returned when they don't fit into a single one
 func (e *Engine) CreateIndex(ctx context.Context, collectionName string, fields []string, isUnique bool) error {
 	err := validateCollectionName(collectionName)
 	if err != nil {
@@ -472,10 +1210,9 @@ func (e *Engine) CreateIndex(ctx context.Context, collectionName string, fields
 		return fmt.Errorf("%w: no fields specified", ErrIllegalArguments)
 	}
 
+	// existing documents are indexed within the same transaction,
+	// thus the snapshot must be up to date and read conflicts must be detected
 	opts := sql.DefaultTxOptions().
-		WithUnsafeMVCC(true).
-		WithSnapshotMustIncludeTxID(func(lastPrecommittedTxID uint64) uint64 { return 0 }).
-		WithSnapshotRenewalPeriod(0).
 		WithExplicitClose(true)
 
 	sqlTx, err := e.sqlEngine.NewTx(ctx, opts)
@@ -561,6 +1298,7 @@ func (e *Engine) InsertDocument(ctx context.Context, collectionName string, doc
 	return txID, docIDs[0], nil
 }
 
+// InsertDocuments inserts the documents within a single transaction, either all documents are inserted or none of them
 func (e *Engine) InsertDocuments(ctx context.Context, collectionName string, docs []*structpb.Struct) (txID uint64, docIDs []DocumentID, err error) {
 	opts := sql.DefaultTxOptions().
 		WithUnsafeMVCC(true).
@@ -573,21 +1311,69 @@ func (e *Engine) InsertDocuments(ctx context.Context, collectionName string, doc
 	}
 	defer sqlTx.Cancel()
 
+	table, err := getTableForCollection(sqlTx, collectionName)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if hasSecondaryUniqueIndex(table) {
+		// reads of the unique indexes are validated at commit time against every transaction
+		// committed in the meantime, so concurrent insertions of the same values can not both succeed
+		sqlTx.Cancel()
+
+		sqlTx, err = e.sqlEngine.NewTx(ctx, sql.DefaultTxOptions().WithUnsafeMVCC(false))
+		if err != nil {
+			return 0, nil, mayTranslateError(err)
+		}
+		defer sqlTx.Cancel()
+	}
+
 	return e.upsertDocuments(ctx, sqlTx, collectionName, docs, true)
 }
 
+func hasSecondaryUniqueIndex(table *sql.Table) bool {
+	for _, index := range table.GetIndexes() {
+		if index.IsUnique() && !index.IsPrimary() {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (e *Engine) upsertDocuments(ctx context.Context, sqlTx *sql.SQLTx, collectionName string, docs []*structpb.Struct, isInsert bool) (txID uint64, docIDs []DocumentID, err error) {
+	docIDs, err = e.writeDocuments(ctx, sqlTx, collectionName, docs, isInsert)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return sqlTx.TxHeader().ID, docIDs, nil
+}
+
+// writeDocuments writes the documents within the transaction,
+// which is committed unless it requires to be explicitly closed
+func (e *Engine) writeDocuments(ctx context.Context, sqlTx *sql.SQLTx, collectionName string, docs []*structpb.Struct, isInsert bool) (docIDs []DocumentID, err error) {
 	if len(docs) == 0 {
-		return 0, nil, fmt.Errorf("%w: no document specified", ErrIllegalArguments)
+		return nil, fmt.Errorf("%w: no document specified", ErrIllegalArguments)
 	}
 
 	table, err := getTableForCollection(sqlTx, collectionName)
 	if err != nil {
-		return 0, nil, err
+		return nil, err
 	}
 
 	docIDFieldName := docIDFieldName(table)
 
+	idGenerator, err := e.collectionIDGenerator(sqlTx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	limits, err := e.collectionLimits(sqlTx, table)
+	if err != nil {
+		return nil, err
+	}
+
 	colNames := make([]string, len(table.Cols()))
 
 	for i, col := range table.Cols() {
@@ -605,7 +1391,7 @@ func (e *Engine) upsertDocuments(ctx context.Context, sqlTx *sql.SQLTx, collecti
 
 		_, blobFieldProvisioned := doc.Fields[DocumentBLOBField]
 		if blobFieldProvisioned {
-			return 0, nil, fmt.Errorf("%w(%s)", ErrReservedName, DocumentBLOBField)
+			return nil, fmt.Errorf("%w(%s)", ErrReservedName, DocumentBLOBField)
 		}
 
 		var docID DocumentID
@@ -613,26 +1399,38 @@ func (e *Engine) upsertDocuments(ctx context.Context, sqlTx *sql.SQLTx, collecti
 		provisionedDocID, docIDProvisioned := doc.Fields[docIDFieldName]
 		if docIDProvisioned {
 			if isInsert {
-				return 0, nil, fmt.Errorf("%w: field (%s) should NOT be specified when inserting a document", ErrIllegalArguments, docIDFieldName)
+				return nil, fmt.Errorf("%w: field (%s) should NOT be specified when inserting a document", ErrIllegalArguments, docIDFieldName)
+			}
+
+			if _, isString := provisionedDocID.GetKind().(*structpb.Value_StringValue); !isString {
+				return nil, fmt.Errorf("%w: expecting value of type %s: field: %s", ErrUnexpectedValue, sql.BLOBType, docIDFieldName)
 			}
 
 			docID, err = NewDocumentIDFromHexEncodedString(provisionedDocID.GetStringValue())
 			if err != nil {
-				return 0, nil, err
+				return nil, fmt.Errorf("%w: field: %s", err, docIDFieldName)
 			}
 		} else {
 			if !isInsert {
-				return 0, nil, fmt.Errorf("%w: field (%s) should be specified when updating a document", ErrIllegalArguments, docIDFieldName)
+				return nil, fmt.Errorf("%w: field (%s) should be specified when updating a document", ErrIllegalArguments, docIDFieldName)
+			}
+
+			docID, err = e.generateDocumentID(ctx, table, idGenerator)
+			if err != nil {
+				return nil, err
 			}
 
-			// generate document id
-			docID = NewDocumentIDFromTx(e.sqlEngine.GetStore().LastPrecommittedTxID())
 			doc.Fields[docIDFieldName] = structpb.NewStringValue(docID.EncodeToHexString())
 		}
 
+		err = e.checkdocumentLimits(doc, limits)
+		if err != nil {
+			return nil, err
+		}
+
 		rowSpec, err := e.generateRowSpecForDocument(table, doc)
 		if err != nil {
-			return 0, nil, err
+			return nil, err
 		}
 
 		docIDs[i] = docID
@@ -640,7 +1438,7 @@ func (e *Engine) upsertDocuments(ctx context.Context, sqlTx *sql.SQLTx, collecti
 	}
 
 	// add documents to collection
-	_, ctxs, err := e.sqlEngine.ExecPreparedStmts(
+	_, _, err = e.sqlEngine.ExecPreparedStmts(
 		ctx,
 		sqlTx,
 		[]sql.SQLStmt{
@@ -655,12 +1453,10 @@ func (e *Engine) upsertDocuments(ctx context.Context, sqlTx *sql.SQLTx, collecti
 		nil,
 	)
 	if err != nil {
-		return 0, nil, mayTranslateError(err)
+		return nil, mayTranslateError(err)
 	}
 
-	txID = ctxs[0].TxHeader().ID
-
-	return txID, docIDs, nil
+	return docIDs, nil
 }
 
 func (e *Engine) generateRowSpecForDocument(table *sql.Table, doc *structpb.Struct) (*sql.RowSpec, error) {
@@ -710,32 +1506,92 @@ func (e *Engine) structValueFromFieldPath(doc *structpb.Struct, fieldPath string
 			return rval, nil
 		}
 
-		nestedStruct = rval.GetStructValue()
-		if nestedStruct == nil {
+		if _, isNull := rval.GetKind().(*structpb.Value_NullValue); isNull {
 			return nil, fmt.Errorf("%w('%s'): while reading nested field '%s'", ErrFieldDoesNotExist, fieldPath, field)
 		}
+
+		nestedStruct = rval.GetStructValue()
+		if nestedStruct == nil {
+			return nil, fmt.Errorf("%w: expecting nested document at '%s' while reading field '%s'", ErrUnexpectedValue, field, fieldPath)
+		}
 	}
 
 	return nil, fmt.Errorf("%w('%s')", ErrFieldDoesNotExist, fieldPath)
 }
 
+// ReplaceDocuments replaces the documents matching the query, no document is written if none matches the query
 func (e *Engine) ReplaceDocuments(ctx context.Context, query *protomodel.Query, doc *structpb.Struct) (revisions []*protomodel.DocumentAtRevision, err error) {
+	return e.replaceDocuments(ctx, query, doc, false)
+}
+
+// UpsertDocuments replaces the documents matching the query, the document is inserted if none matches the query
+func (e *Engine) UpsertDocuments(ctx context.Context, query *protomodel.Query, doc *structpb.Struct) (revisions []*protomodel.DocumentAtRevision, err error) {
+	return e.replaceDocuments(ctx, query, doc, true)
+}
+
+func (e *Engine) replaceDocuments(ctx context.Context, query *protomodel.Query, doc *structpb.Struct, upsert bool) (revisions []*protomodel.DocumentAtRevision, err error) {
 	if query == nil {
 		return nil, ErrIllegalArguments
 	}
 
-	if doc == nil || len(doc.Fields) == 0 {
-		doc = &structpb.Struct{
-			Fields: make(map[string]*structpb.Value),
-		}
-	}
-
 	sqlTx, err := e.sqlEngine.NewTx(ctx, sql.DefaultTxOptions())
 	if err != nil {
 		return nil, mayTranslateError(err)
 	}
 	defer sqlTx.Cancel()
 
+	docIDs, err := e.replaceDocumentsInTx(ctx, sqlTx, query, doc, upsert)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(docIDs) == 0 {
+		return nil, nil
+	}
+
+	txID := sqlTx.TxHeader().ID
+
+	for _, docID := range docIDs {
+		// fetch revision
+		searchKey, err := e.getKeyForDocument(ctx, sqlTx, query.CollectionName, docID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = e.sqlEngine.GetStore().WaitForIndexingUpto(ctx, txID)
+		if err != nil {
+			return nil, err
+		}
+
+		encDoc, err := e.getEncodedDocument(searchKey, 0, false)
+		if err != nil {
+			return nil, err
+		}
+
+		revisions = append(revisions, &protomodel.DocumentAtRevision{
+			TransactionId: txID,
+			DocumentId:    docID.EncodeToHexString(),
+			Revision:      encDoc.Revision,
+			Metadata:      kvMetadataToProto(encDoc.KVMetadata),
+		})
+	}
+
+	return revisions, nil
+}
+
+// replaceDocumentsInTx writes the replacements of the documents matching the query within the transaction,
+// no document id is returned when none is written
+func (e *Engine) replaceDocumentsInTx(ctx context.Context, sqlTx *sql.SQLTx, query *protomodel.Query, doc *structpb.Struct, upsert bool) ([]DocumentID, error) {
+	if query == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	if doc == nil || len(doc.Fields) == 0 {
+		doc = &structpb.Struct{
+			Fields: make(map[string]*structpb.Value),
+		}
+	}
+
 	table, err := getTableForCollection(sqlTx, query.CollectionName)
 	if err != nil {
 		return nil, err
@@ -823,44 +1679,99 @@ func (e *Engine) ReplaceDocuments(ctx context.Context, query *protomodel.Query,
 
 	r.Close()
 
+	isInsert := false
+
 	if len(docs) == 0 {
-		return nil, nil
-	}
+		if !upsert {
+			return nil, nil
+		}
 
-	txID, docIDs, err := e.upsertDocuments(ctx, sqlTx, query.CollectionName, docs, false)
-	if err != nil {
-		return nil, err
-	}
+		if docIDProvisioned {
+			// the document must not be overwritten if it exists but does not match the query
+			exists, err := e.documentExists(ctx, sqlTx, table, provisionedDocID)
+			if err != nil {
+				return nil, err
+			}
 
-	for _, docID := range docIDs {
-		// fetch revision
-		searchKey, err := e.getKeyForDocument(ctx, sqlTx, query.CollectionName, docID)
-		if err != nil {
-			return nil, err
-		}
+			if exists {
+				return nil, fmt.Errorf("%w: the document with the provisioned %s exists but does not match the query", ErrConflict, documentIdFieldName)
+			}
 
-		err = e.sqlEngine.GetStore().WaitForIndexingUpto(ctx, txID)
-		if err != nil {
-			return nil, err
+			idGenerator, err := e.collectionIDGenerator(sqlTx, table)
+			if err != nil {
+				return nil, err
+			}
+
+			if idGenerator == protomodel.DocumentIdGenerator_SEQUENCE {
+				return nil, fmt.Errorf("%w: field (%s) should NOT be specified when inserting a document into a collection with sequential ids", ErrIllegalArguments, documentIdFieldName)
+			}
 		}
 
-		encDoc, err := e.getEncodedDocument(searchKey, 0, false)
+		newDoc, err := structpb.NewStruct(doc.AsMap())
 		if err != nil {
 			return nil, err
 		}
 
-		revisions = append(revisions, &protomodel.DocumentAtRevision{
-			TransactionId: txID,
-			DocumentId:    docID.EncodeToHexString(),
-			Revision:      encDoc.Revision,
-			Metadata:      kvMetadataToProto(encDoc.KVMetadata),
-		})
+		docs = append(docs, newDoc)
+
+		// a new document id is generated if it was not provisioned
+		isInsert = !docIDProvisioned
 	}
 
-	return revisions, nil
+	return e.writeDocuments(ctx, sqlTx, query.CollectionName, docs, isInsert)
+}
+
+func (e *Engine) documentExists(ctx context.Context, sqlTx *sql.SQLTx, table *sql.Table, docID *structpb.Value) (bool, error) {
+	documentIdFieldName := docIDFieldName(table)
+
+	queryCondition, err := generateSQLFilteringExpression([]*protomodel.QueryExpression{
+		{
+			FieldComparisons: []*protomodel.FieldComparison{
+				{
+					Field:    documentIdFieldName,
+					Operator: protomodel.ComparisonOperator_EQ,
+					Value:    docID,
+				},
+			},
+		},
+	}, table)
+	if err != nil {
+		return false, err
+	}
+
+	queryStmt := sql.NewSelectStmt(
+		[]sql.Selector{sql.NewColSelector(table.Name(), documentIdFieldName)},
+		table.Name(),
+		queryCondition,
+		nil,
+		sql.NewInteger(1),
+		nil,
+	)
+
+	r, err := e.sqlEngine.QueryPreparedStmt(ctx, sqlTx, queryStmt, nil)
+	if err != nil {
+		return false, mayTranslateError(err)
+	}
+	defer r.Close()
+
+	_, err = r.Read(ctx)
+	if errors.Is(err, sql.ErrNoMoreRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, mayTranslateError(err)
+	}
+
+	return true, nil
 }
 
 func (e *Engine) GetDocuments(ctx context.Context, query *protomodel.Query, offset int64) (DocumentReader, error) {
+	return e.GetDocumentsWithProjection(ctx, query, offset, nil)
+}
+
+// GetDocumentsWithProjection returns the documents matching the query, only including the specified fields and
+// the document id field in each of them. Documents are returned whole when no field is specified
+func (e *Engine) GetDocumentsWithProjection(ctx context.Context, query *protomodel.Query, offset int64, fields []string) (DocumentReader, error) {
 	if query == nil {
 		return nil, ErrIllegalArguments
 	}
@@ -870,106 +1781,933 @@ func (e *Engine) GetDocuments(ctx context.Context, query *protomodel.Query, offs
 		return nil, mayTranslateError(err)
 	}
 
-	table, err := getTableForCollection(sqlTx, query.CollectionName)
+	// returning an open reader here, so the caller HAS to close it
+	r, err := e.queryDocuments(ctx, sqlTx, query, fields, int64(query.Limit), offset, func(_ DocumentReader) { sqlTx.Cancel() })
 	if err != nil {
 		defer sqlTx.Cancel()
 		return nil, err
 	}
 
+	return r, nil
+}
+
+// queryDocuments returns a reader of the documents matching the query. When documents of the collection may expire,
+// offset and limit are applied by the reader as the expiration of a document is only known once it's read
+func (e *Engine) queryDocuments(ctx context.Context, sqlTx *sql.SQLTx, query *protomodel.Query, fields []string, limit, offset int64, onCloseCallback func(reader DocumentReader)) (DocumentReader, error) {
+	table, err := getTableForCollection(sqlTx, query.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	ttl, err := e.collectionTTL(sqlTx, table)
+	if err != nil {
+		return nil, err
+	}
+
 	queryCondition, err := generateSQLFilteringExpression(query.Expressions, table)
 	if err != nil {
-		defer sqlTx.Cancel()
 		return nil, err
 	}
 
+	indexedOrder, err := mayOrderByIndex(table, query.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	if indexedOrder {
+		r, err := e.newDocumentReader(ctx, sqlTx, table, ttl, queryCondition, query.OrderBy, fields, limit, offset, onCloseCallback)
+		if err == nil {
+			return r, nil
+		}
+		if !errors.Is(err, sql.ErrNoAvailableIndex) && !errors.Is(err, sql.ErrLimitedOrderBy) {
+			return nil, err
+		}
+		// none of the indexes on the field can provide the requested order
+	}
+
+	return e.newSortedDocumentReader(ctx, sqlTx, table, ttl, queryCondition, query.OrderBy, fields, limit, offset, onCloseCallback)
+}
+
+// mayOrderByIndex tells if an index may provide the requested order, which requires sorting by a single field stored as a column
+func mayOrderByIndex(table *sql.Table, orderBy []*protomodel.OrderByClause) (bool, error) {
+	for _, clause := range orderBy {
+		err := validateFieldName(clause.Field)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if len(orderBy) == 0 {
+		return true, nil
+	}
+
+	if len(orderBy) > 1 {
+		return false, nil
+	}
+
+	_, err := table.GetColumnByName(orderBy[0].Field)
+	if errors.Is(err, sql.ErrColumnDoesNotExist) {
+		return false, nil
+	}
+
+	return err == nil, mayTranslateError(err)
+}
+
+func (e *Engine) newDocumentReader(
+	ctx context.Context,
+	sqlTx *sql.SQLTx,
+	table *sql.Table,
+	ttl time.Duration,
+	queryCondition sql.ValueExp,
+	orderBy []*protomodel.OrderByClause,
+	fields []string,
+	limit, offset int64,
+	onCloseCallback func(reader DocumentReader),
+) (*documentReader, error) {
+	selectors, docIDPos, decodeDocument, err := e.documentProjection(table, fields, false)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlLimit, sqlOffset := limit, offset
+	if ttl > 0 {
+		sqlLimit, sqlOffset = 0, 0
+	}
+
 	op := sql.NewSelectStmt(
-		[]sql.Selector{sql.NewColSelector(query.CollectionName, DocumentBLOBField)},
-		query.CollectionName,
+		selectors,
+		table.Name(),
 		queryCondition,
-		generateSQLOrderByClauses(table, query.OrderBy),
-		sql.NewInteger(int64(query.Limit)),
-		sql.NewInteger(offset),
+		generateSQLOrderByClauses(table, orderBy),
+		sql.NewInteger(sqlLimit),
+		sql.NewInteger(sqlOffset),
 	)
 
-	// returning an open reader here, so the caller HAS to close it
 	r, err := e.sqlEngine.QueryPreparedStmt(ctx, sqlTx, op, nil)
 	if err != nil {
-		defer sqlTx.Cancel()
 		return nil, err
 	}
 
-	return newDocumentReader(r, func(_ DocumentReader) { sqlTx.Cancel() }), nil
-}
+	var reader *documentReader
+
+	if ttl > 0 {
+		reader = newExpiringDocumentReader(r, e.documentExpiration(sqlTx, table, ttl, docIDPos), limit, offset, onCloseCallback)
+	} else {
+		reader = newDocumentReader(r, onCloseCallback)
+	}
+
+	reader.decodeDocument = decodeDocument
+
+	return reader, nil
+}
+
+// sortableTimestampFormat formats timestamps in UTC with a fixed width, so they sort as the instants they represent
+const sortableTimestampFormat = "2006-01-02T15:04:05.000000000Z"
+
+type sortableRow struct {
+	row    *sql.Row
+	values []*structpb.Value // values of the fields to sort by, nil for missing fields
+}
+
+// newSortedDocumentReader sorts the documents matching the query in memory. When a limit is specified, only the
+// documents which may be returned are kept while scanning, otherwise up to maxSortBufferSize documents can be sorted
+func (e *Engine) newSortedDocumentReader(
+	ctx context.Context,
+	sqlTx *sql.SQLTx,
+	table *sql.Table,
+	ttl time.Duration,
+	queryCondition sql.ValueExp,
+	orderBy []*protomodel.OrderByClause,
+	fields []string,
+	limit, offset int64,
+	onCloseCallback func(reader DocumentReader),
+) (*sortedDocumentReader, error) {
+	// the document is read to sort by fields which may not be stored as columns
+	selectors, docIDPos, decodeDocument, err := e.documentProjection(table, fields, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if decodeDocument == nil {
+		decodeDocument = func(row *sql.Row) (*structpb.Struct, error) {
+			return unmarshalDocument(row.ValuesByPosition[0])
+		}
+	}
+
+	op := sql.NewSelectStmt(selectors, table.Name(), queryCondition, nil, nil, nil)
+
+	r, err := e.sqlEngine.QueryPreparedStmt(ctx, sqlTx, op, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := newDocumentReader(r, nil)
+	defer reader.Close()
+
+	if ttl > 0 {
+		reader.isExpired = e.documentExpiration(sqlTx, table, ttl, docIDPos)
+	}
+
+	sortRows := func(rows []sortableRow) {
+		sort.SliceStable(rows, func(i, j int) bool {
+			for k, clause := range orderBy {
+				cmp := compareStructValues(rows[i].values[k], rows[j].values[k])
+				if cmp != 0 {
+					return (cmp < 0) != clause.Desc
+				}
+			}
+			return false
+		})
+	}
+
+	keep := 0
+	if limit > 0 {
+		keep = int(offset + limit)
+	}
+
+	// timestamps are sorted by the instant they represent rather than as formatted by the client
+	timestampFields := make([]bool, len(orderBy))
+
+	for k, clause := range orderBy {
+		col, err := table.GetColumnByName(clause.Field)
+		timestampFields[k] = err == nil && col.Type() == sql.TimestampType
+	}
+
+	var rows []sortableRow
+
+	for {
+		row, err := reader.readRow(ctx)
+		if errors.Is(err, ErrNoMoreDocuments) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		doc, err := unmarshalDocument(row.ValuesByPosition[0])
+		if err != nil {
+			return nil, err
+		}
+
+		values := make([]*structpb.Value, len(orderBy))
+
+		for k, clause := range orderBy {
+			val, err := e.structValueFromFieldPath(doc, clause.Field)
+			if err != nil && !errors.Is(err, ErrFieldDoesNotExist) && !errors.Is(err, ErrUnexpectedValue) {
+				return nil, err
+			}
+
+			if timestampFields[k] && val != nil {
+				ts, err := parseTimestampValue(val)
+				if err == nil {
+					val = structpb.NewStringValue(ts.UTC().Format(sortableTimestampFormat))
+				}
+			}
+
+			values[k] = val
+		}
+
+		rows = append(rows, sortableRow{row: row, values: values})
+
+		if keep > 0 && keep <= e.maxSortBufferSize {
+			if len(rows) == 2*keep {
+				// documents which can not make it into the result are discarded
+				sortRows(rows)
+				rows = rows[:keep]
+			}
+		} else if len(rows) > e.maxSortBufferSize {
+			return nil, fmt.Errorf("%w: only up to %d documents can be sorted by fields without an index", ErrSortBufferSizeExceeded, e.maxSortBufferSize)
+		}
+	}
+
+	sortRows(rows)
+
+	if offset >= int64(len(rows)) {
+		rows = nil
+	} else {
+		rows = rows[offset:]
+	}
+
+	if limit > 0 && limit < int64(len(rows)) {
+		rows = rows[:limit]
+	}
+
+	sortedRows := make([]*sql.Row, len(rows))
+	for i, r := range rows {
+		sortedRows[i] = r.row
+	}
+
+	return newSortedDocumentReader(sortedRows, decodeDocument, onCloseCallback), nil
+}
+
+// documentProjection returns the selectors of the query, the position of the document id among them and how
+// documents are built out of the selected values. Unless readBLOB is set, the document blob is not read when every
+// projected field is stored as a column, otherwise fields are extracted from it. The decoding function is nil when
+// documents are not projected, in which case the blob is the first selected value
+func (e *Engine) documentProjection(table *sql.Table, fields []string, readBLOB bool) ([]sql.Selector, int, func(row *sql.Row) (*structpb.Struct, error), error) {
+	collectionName := table.Name()
+	docIDField := docIDFieldName(table)
+
+	projectedFields := make([]string, 0, len(fields))
+	projected := make(map[string]struct{}, len(fields))
+	columnar := true
+
+	for _, field := range fields {
+		err := validateFieldName(field)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+
+		if _, ok := projected[field]; ok || field == docIDField {
+			continue
+		}
+
+		projected[field] = struct{}{}
+		projectedFields = append(projectedFields, field)
+
+		_, err = table.GetColumnByName(field)
+		if errors.Is(err, sql.ErrColumnDoesNotExist) {
+			columnar = false
+		} else if err != nil {
+			return nil, 0, nil, mayTranslateError(err)
+		}
+	}
+
+	if len(fields) == 0 || !columnar || readBLOB {
+		selectors := []sql.Selector{
+			sql.NewColSelector(collectionName, DocumentBLOBField),
+			sql.NewColSelector(collectionName, docIDField),
+		}
+
+		if len(fields) == 0 {
+			return selectors, 1, nil, nil
+		}
+
+		return selectors, 1, func(row *sql.Row) (*structpb.Struct, error) {
+			doc, err := unmarshalDocument(row.ValuesByPosition[0])
+			if err != nil {
+				return nil, err
+			}
+
+			docID := DocumentID(row.ValuesByPosition[1].RawValue().([]byte))
+
+			projectedDoc := &structpb.Struct{
+				Fields: map[string]*structpb.Value{docIDField: structpb.NewStringValue(docID.EncodeToHexString())},
+			}
+
+			for _, field := range projectedFields {
+				val, err := e.structValueFromFieldPath(doc, field)
+				if errors.Is(err, ErrFieldDoesNotExist) || errors.Is(err, ErrUnexpectedValue) {
+					continue
+				}
+				if err != nil {
+					return nil, err
+				}
+
+				e.setStructValueAtFieldPath(projectedDoc, field, val)
+			}
+
+			return projectedDoc, nil
+		}, nil
+	}
+
+	selectors := make([]sql.Selector, 1+len(projectedFields))
+	selectors[0] = sql.NewColSelector(collectionName, docIDField)
+
+	for i, field := range projectedFields {
+		selectors[1+i] = sql.NewColSelector(collectionName, field)
+	}
+
+	return selectors, 0, func(row *sql.Row) (*structpb.Struct, error) {
+		docID := DocumentID(row.ValuesByPosition[0].RawValue().([]byte))
+
+		projectedDoc := &structpb.Struct{
+			Fields: map[string]*structpb.Value{docIDField: structpb.NewStringValue(docID.EncodeToHexString())},
+		}
+
+		for i, field := range projectedFields {
+			val := row.ValuesByPosition[1+i]
+			if val.IsNull() {
+				// null values are not told apart from missing fields when stored as columns
+				continue
+			}
+
+			structVal, err := sqlValueToStructValue(val)
+			if err != nil {
+				return nil, fmt.Errorf("%w: field: %s", err, field)
+			}
+
+			e.setStructValueAtFieldPath(projectedDoc, field, structVal)
+		}
+
+		return projectedDoc, nil
+	}, nil
+}
+
+// setStructValueAtFieldPath sets the value of a possibly nested field, creating intermediate documents as needed
+func (e *Engine) setStructValueAtFieldPath(doc *structpb.Struct, fieldPath string, value *structpb.Value) {
+	nestedStruct := doc
+	nestedFields := strings.SplitN(fieldPath, documentFieldPathSeparator, e.maxNestedFields)
+
+	for _, field := range nestedFields[:len(nestedFields)-1] {
+		nestedVal, ok := nestedStruct.Fields[field]
+		if !ok || nestedVal.GetStructValue() == nil {
+			nestedVal = structpb.NewStructValue(&structpb.Struct{Fields: make(map[string]*structpb.Value)})
+			nestedStruct.Fields[field] = nestedVal
+		}
+
+		nestedStruct = nestedVal.GetStructValue()
+	}
+
+	nestedStruct.Fields[nestedFields[len(nestedFields)-1]] = value
+}
+
+// documentExpiration returns a function telling if the document read at the given position of a row has expired,
+// based on the timestamp of the transaction which wrote its current revision
+func (e *Engine) documentExpiration(sqlTx *sql.SQLTx, table *sql.Table, ttl time.Duration, docIDPos int) func(row *sql.Row) (bool, error) {
+	expiredBefore := time.Now().Add(-ttl)
+
+	// documents written within the same transaction share the timestamp
+	txTimestamps := make(map[uint64]time.Time)
+
+	return func(row *sql.Row) (bool, error) {
+		docID := row.ValuesByPosition[docIDPos].RawValue().([]byte)
+
+		key, err := e.documentKey(table, docID)
+		if err != nil {
+			return false, err
+		}
+
+		vref, err := sqlTx.Get(key)
+		if err != nil {
+			return false, mayTranslateError(err)
+		}
+
+		ts, ok := txTimestamps[vref.Tx()]
+		if !ok {
+			hdr, err := e.sqlEngine.GetStore().ReadTxHeader(vref.Tx(), true, false)
+			if err != nil {
+				return false, err
+			}
+
+			ts = time.Unix(hdr.Ts, 0)
+			txTimestamps[vref.Tx()] = ts
+		}
+
+		return !ts.After(expiredBefore), nil
+	}
+}
+
+func (e *Engine) CountDocuments(ctx context.Context, query *protomodel.Query, offset int64) (int64, error) {
+	if query == nil {
+		return 0, ErrIllegalArguments
+	}
+
+	sqlTx, err := e.sqlEngine.NewTx(ctx, sql.DefaultTxOptions().WithReadOnly(true))
+	if err != nil {
+		return 0, mayTranslateError(err)
+	}
+
+	defer sqlTx.Cancel()
+
+	table, err := getTableForCollection(sqlTx, query.CollectionName)
+	if err != nil {
+		return 0, err
+	}
+
+	ttl, err := e.collectionTTL(sqlTx, table)
+	if err != nil {
+		return 0, err
+	}
+
+	if ttl > 0 {
+		// expired documents can only be told apart by reading them
+		return e.countUnexpiredDocuments(ctx, sqlTx, query, offset)
+	}
+
+	queryCondition, err := generateSQLFilteringExpression(query.Expressions, table)
+	if err != nil {
+		return 0, err
+	}
+
+	// the order of the documents does not change how many of them are counted
+	op := sql.NewSelectStmt(
+		[]sql.Selector{sql.NewAggColSelector(sql.COUNT, query.CollectionName, "*")},
+		query.CollectionName,
+		queryCondition,
+		nil,
+		sql.NewInteger(int64(query.Limit)),
+		sql.NewInteger(offset),
+	)
+
+	r, err := e.sqlEngine.QueryPreparedStmt(ctx, sqlTx, op, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	defer r.Close()
+
+	row, err := r.Read(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return row.ValuesByPosition[0].RawValue().(int64), nil
+}
+
+// AggregateDocuments computes the aggregate function over the values of a field of the documents
+// matching the query, missing values are not taken into account. When a group field is specified,
+// a result is returned for each of its values and the group field must be indexed.
+func (e *Engine) AggregateDocuments(ctx context.Context, query *protomodel.Query, field string, fn protomodel.AggregateFunction, groupBy string) ([]*protomodel.AggregationResult, error) {
+	if query == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	aggFn, err := aggregateFunctionToSQL(fn)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlTx, err := e.sqlEngine.NewTx(ctx, sql.DefaultTxOptions().WithReadOnly(true))
+	if err != nil {
+		return nil, mayTranslateError(err)
+	}
+
+	defer sqlTx.Cancel()
+
+	table, err := getTableForCollection(sqlTx, query.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	ttl, err := e.collectionTTL(sqlTx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	if ttl > 0 {
+		return nil, fmt.Errorf("%w: documents of collection '%s' may expire and can not be aggregated", ErrIllegalArguments, query.CollectionName)
+	}
+
+	column, err := getColumnForField(table, field)
+	if err != nil {
+		return nil, err
+	}
+
+	if (aggFn == sql.SUM || aggFn == sql.AVG) && !sql.IsNumericType(column.Type()) {
+		return nil, fmt.Errorf("%w: field '%s' is not numeric", ErrIllegalArguments, field)
+	}
+
+	queryCondition, err := generateSQLFilteringExpression(query.Expressions, table)
+	if err != nil {
+		return nil, err
+	}
+
+	selectors := []sql.Selector{sql.NewAggColSelector(aggFn, query.CollectionName, field)}
+
+	var groupByCols []*sql.ColSelector
+	var orderBy []*sql.OrdCol
+
+	if groupBy != "" {
+		_, err := getColumnForField(table, groupBy)
+		if err != nil {
+			return nil, err
+		}
+
+		groupByCol := sql.NewColSelector(query.CollectionName, groupBy)
+
+		// the sql engine groups rows as they are read, thus they must be sorted by the group field
+		selectors = append([]sql.Selector{groupByCol}, selectors...)
+		groupByCols = []*sql.ColSelector{groupByCol}
+		orderBy = []*sql.OrdCol{sql.NewOrdCol(query.CollectionName, groupBy, false)}
+	}
+
+	op := sql.NewSelectStmt(
+		selectors,
+		query.CollectionName,
+		queryCondition,
+		orderBy,
+		nil,
+		nil,
+	).WithGroupBy(groupByCols)
+
+	r, err := e.sqlEngine.QueryPreparedStmt(ctx, sqlTx, op, nil)
+	if errors.Is(err, sql.ErrNoAvailableIndex) || errors.Is(err, sql.ErrLimitedOrderBy) {
+		return nil, fmt.Errorf("%w: grouping by field '%s' requires an index on it", ErrIllegalArguments, groupBy)
+	}
+	if err != nil {
+		return nil, mayTranslateError(err)
+	}
+
+	defer r.Close()
+
+	var results []*protomodel.AggregationResult
+
+	for {
+		row, err := r.Read(ctx)
+		if errors.Is(err, sql.ErrNoMoreRows) {
+			break
+		}
+		if err != nil {
+			return nil, mayTranslateError(err)
+		}
+
+		res := &protomodel.AggregationResult{}
+
+		if groupBy != "" {
+			res.Group, err = sqlValueToStructValue(row.ValuesByPosition[0])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		res.Value, err = sqlValueToStructValue(row.ValuesByPosition[len(row.ValuesByPosition)-1])
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, res)
+	}
+
+	return results, nil
+}
+
+func aggregateFunctionToSQL(fn protomodel.AggregateFunction) (sql.AggregateFn, error) {
+	switch fn {
+	case protomodel.AggregateFunction_SUM:
+		return sql.SUM, nil
+	case protomodel.AggregateFunction_AVG:
+		return sql.AVG, nil
+	case protomodel.AggregateFunction_MIN:
+		return sql.MIN, nil
+	case protomodel.AggregateFunction_MAX:
+		return sql.MAX, nil
+	}
+
+	return "", fmt.Errorf("%w: unsupported aggregate function '%s'", ErrIllegalArguments, fn)
+}
+
+func (e *Engine) countUnexpiredDocuments(ctx context.Context, sqlTx *sql.SQLTx, query *protomodel.Query, offset int64) (int64, error) {
+	// as with the sql count, neither the limit nor the order of the query are taken into account
+	unorderedQuery := &protomodel.Query{
+		CollectionName: query.CollectionName,
+		Expressions:    query.Expressions,
+	}
+
+	r, err := e.queryDocuments(ctx, sqlTx, unorderedQuery, nil, 0, offset, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+
+	var count int64
+
+	for {
+		_, err := r.Read(ctx)
+		if errors.Is(err, ErrNoMoreDocuments) {
+			return count, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		count++
+	}
+}
+
+func (e *Engine) GetEncodedDocument(ctx context.Context, collectionName string, docID DocumentID, txID uint64) (collectionID uint32, documentIdFieldName string, encodedDoc *EncodedDocument, err error) {
+	sqlTx, err := e.sqlEngine.NewTx(ctx, sql.DefaultTxOptions().WithReadOnly(true))
+	if err != nil {
+		return 0, "", nil, mayTranslateError(err)
+	}
+	defer sqlTx.Cancel()
+
+	table, err := getTableForCollection(sqlTx, collectionName)
+	if err != nil {
+		return 0, "", nil, err
+	}
+
+	searchKey, err := e.getKeyForDocument(ctx, sqlTx, collectionName, docID)
+	if err != nil {
+		return 0, "", nil, err
+	}
+
+	encodedDoc, err = e.getEncodedDocument(searchKey, txID, false)
+	if err != nil {
+		return 0, "", nil, err
+	}
+
+	return table.ID(), docIDFieldName(table), encodedDoc, nil
+}
+
+// GetEncodedDocuments returns up to count documents matching the query, skipping the first offset ones. Each document
+// is returned encoded as the entry backing it, which is read from the same snapshot the query is resolved with.
+func (e *Engine) GetEncodedDocuments(
+	ctx context.Context,
+	query *protomodel.Query,
+	offset int64,
+	count int,
+) (collectionID uint32, documentIdFieldName string, encodedDocs []*EncodedDocument, err error) {
+	if query == nil {
+		return 0, "", nil, ErrIllegalArguments
+	}
+
+	sqlTx, err := e.sqlEngine.NewTx(ctx, sql.DefaultTxOptions().WithReadOnly(true))
+	if err != nil {
+		return 0, "", nil, mayTranslateError(err)
+	}
+	defer sqlTx.Cancel()
+
+	table, err := getTableForCollection(sqlTx, query.CollectionName)
+	if err != nil {
+		return 0, "", nil, err
+	}
+
+	documentIdFieldName = docIDFieldName(table)
+
+	// only document ids are read, the entries backing the documents are then read one by one
+	r, err := e.queryDocuments(ctx, sqlTx, query, []string{documentIdFieldName}, int64(query.Limit), offset, nil)
+	if err != nil {
+		return 0, "", nil, err
+	}
+	defer r.Close()
+
+	revisions, err := r.ReadN(ctx, count)
+	if err != nil && !errors.Is(err, ErrNoMoreDocuments) {
+		return 0, "", nil, err
+	}
+
+	encodedDocs = make([]*EncodedDocument, len(revisions))
+
+	for i, revision := range revisions {
+		docID, err := NewDocumentIDFromHexEncodedString(revision.Document.Fields[documentIdFieldName].GetStringValue())
+		if err != nil {
+			return 0, "", nil, err
+		}
+
+		key, err := e.documentKey(table, docID)
+		if err != nil {
+			return 0, "", nil, err
+		}
+
+		valRef, err := sqlTx.Get(key)
+		if err != nil {
+			return 0, "", nil, mayTranslateError(err)
+		}
+
+		encodedDoc, err := valRef.Resolve()
+		if err != nil {
+			return 0, "", nil, mayTranslateError(err)
+		}
+
+		encodedDocs[i] = &EncodedDocument{
+			Key:             key,
+			TxID:            valRef.Tx(),
+			Revision:        valRef.HC(),
+			KVMetadata:      valRef.KVMetadata(),
+			EncodedDocument: encodedDoc,
+		}
+	}
+
+	return table.ID(), documentIdFieldName, encodedDocs, nil
+}
+
+// GetDocumentByKey returns the current revision of the document with the given primary key values.
+// The key of the document is built from the values and read with a single lookup, thus no query
+// is planned over the collection. The primary key of a collection consists of its document id field,
+// whose value is given as the hex-encoded string found in the documents.
+func (e *Engine) GetDocumentByKey(ctx context.Context, collectionName string, keyValues []*structpb.Value) (*protomodel.DocumentAtRevision, error) {
+	err := validateCollectionName(collectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlTx, err := e.sqlEngine.NewTx(ctx, sql.DefaultTxOptions().WithReadOnly(true))
+	if err != nil {
+		return nil, mayTranslateError(err)
+	}
+	defer sqlTx.Cancel()
+
+	table, err := getTableForCollection(sqlTx, collectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	readDocument, err := e.documentByKeyReader(sqlTx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	return readDocument(keyValues)
+}
+
+// GetDocumentsByKeys returns the current revision of the documents with the given primary key values, in the same
+// order as the keys are given. The entries of documents which are not found are nil. All documents are read from the
+// same snapshot of the collection, each one with a single lookup as done by GetDocumentByKey.
+func (e *Engine) GetDocumentsByKeys(ctx context.Context, collectionName string, keys [][]*structpb.Value) ([]*protomodel.DocumentAtRevision, error) {
+	err := validateCollectionName(collectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlTx, err := e.sqlEngine.NewTx(ctx, sql.DefaultTxOptions().WithReadOnly(true))
+	if err != nil {
+		return nil, mayTranslateError(err)
+	}
+	defer sqlTx.Cancel()
+
+	table, err := getTableForCollection(sqlTx, collectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	readDocument, err := e.documentByKeyReader(sqlTx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*protomodel.DocumentAtRevision, len(keys))
+
+	for i, keyValues := range keys {
+		revision, err := readDocument(keyValues)
+		if errors.Is(err, ErrDocumentNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		revisions[i] = revision
+	}
+
+	return revisions, nil
+}
+
+// documentByKeyReader returns a function reading documents of the collection by their primary key values within
+// the transaction, ErrDocumentNotFound is returned when the document does not exist or it has expired
+func (e *Engine) documentByKeyReader(sqlTx *sql.SQLTx, table *sql.Table) (func(keyValues []*structpb.Value) (*protomodel.DocumentAtRevision, error), error) {
+	ttl, err := e.collectionTTL(sqlTx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	expiredBefore := time.Now().Add(-ttl)
+
+	// documents written within the same transaction share the timestamp
+	txTimestamps := make(map[uint64]time.Time)
+
+	return func(keyValues []*structpb.Value) (*protomodel.DocumentAtRevision, error) {
+		docID, err := documentIDFromKeyValues(table, keyValues)
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := e.documentKey(table, docID)
+		if err != nil {
+			return nil, err
+		}
+
+		valRef, err := sqlTx.Get(key)
+		if errors.Is(err, store.ErrKeyNotFound) {
+			return nil, ErrDocumentNotFound
+		}
+		if err != nil {
+			return nil, mayTranslateError(err)
+		}
+
+		if ttl > 0 {
+			ts, ok := txTimestamps[valRef.Tx()]
+			if !ok {
+				hdr, err := e.sqlEngine.GetStore().ReadTxHeader(valRef.Tx(), true, false)
+				if err != nil {
+					return nil, err
+				}
+
+				ts = time.Unix(hdr.Ts, 0)
+				txTimestamps[valRef.Tx()] = ts
+			}
+
+			if !ts.After(expiredBefore) {
+				return nil, ErrDocumentNotFound
+			}
+		}
 
-func (e *Engine) CountDocuments(ctx context.Context, query *protomodel.Query, offset int64) (int64, error) {
-	if query == nil {
-		return 0, ErrIllegalArguments
-	}
+		encodedRow, err := valRef.Resolve()
+		if err != nil {
+			return nil, mayTranslateError(err)
+		}
 
-	sqlTx, err := e.sqlEngine.NewTx(ctx, sql.DefaultTxOptions().WithReadOnly(true))
-	if err != nil {
-		return 0, mayTranslateError(err)
-	}
+		doc, err := decodeDocument(encodedRow)
+		if err != nil {
+			return nil, err
+		}
 
-	defer sqlTx.Cancel()
+		return &protomodel.DocumentAtRevision{
+			TransactionId: valRef.Tx(),
+			DocumentId:    docID.EncodeToHexString(),
+			Revision:      valRef.HC(),
+			Metadata:      kvMetadataToProto(valRef.KVMetadata()),
+			Document:      doc,
+		}, nil
+	}, nil
+}
 
-	table, err := getTableForCollection(sqlTx, query.CollectionName)
-	if err != nil {
-		return 0, err
+// documentIDFromKeyValues returns the id of the document with the given primary key values
+func documentIDFromKeyValues(table *sql.Table, keyValues []*structpb.Value) (DocumentID, error) {
+	if len(keyValues) != len(table.PrimaryIndex().Cols()) {
+		return nil, fmt.Errorf("%w: expecting %d key values but %d were provided", ErrIllegalArguments, len(table.PrimaryIndex().Cols()), len(keyValues))
 	}
 
-	queryCondition, err := generateSQLFilteringExpression(query.Expressions, table)
-	if err != nil {
-		return 0, err
-	}
+	documentIdFieldName := docIDFieldName(table)
 
-	op := sql.NewSelectStmt(
-		[]sql.Selector{sql.NewAggColSelector(sql.COUNT, query.CollectionName, "*")},
-		query.CollectionName,
-		queryCondition,
-		generateSQLOrderByClauses(table, query.OrderBy),
-		sql.NewInteger(int64(query.Limit)),
-		sql.NewInteger(offset),
-	)
+	if _, isString := keyValues[0].GetKind().(*structpb.Value_StringValue); !isString {
+		return nil, fmt.Errorf("%w: expecting value of type %s: field: %s", ErrUnexpectedValue, sql.BLOBType, documentIdFieldName)
+	}
 
-	r, err := e.sqlEngine.QueryPreparedStmt(ctx, sqlTx, op, nil)
+	docID, err := NewDocumentIDFromHexEncodedString(keyValues[0].GetStringValue())
 	if err != nil {
-		return 0, err
+		return nil, fmt.Errorf("%w: field: %s", err, documentIdFieldName)
 	}
 
-	defer r.Close()
+	return docID, nil
+}
 
-	row, err := r.Read(ctx)
+// GetDocumentHistory returns the revisions of the document with the given primary key values, newest first.
+// Each revision includes the transaction it was written at along with the timestamp of the transaction,
+// deletions are included as revisions without document.
+func (e *Engine) GetDocumentHistory(ctx context.Context, collectionName string, keyValues []*structpb.Value, limit int, offset uint64) ([]*protomodel.DocumentAtRevision, error) {
+	err := validateCollectionName(collectionName)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	return row.ValuesByPosition[0].RawValue().(int64), nil
-}
-
-func (e *Engine) GetEncodedDocument(ctx context.Context, collectionName string, docID DocumentID, txID uint64) (collectionID uint32, documentIdFieldName string, encodedDoc *EncodedDocument, err error) {
 	sqlTx, err := e.sqlEngine.NewTx(ctx, sql.DefaultTxOptions().WithReadOnly(true))
 	if err != nil {
-		return 0, "", nil, mayTranslateError(err)
+		return nil, mayTranslateError(err)
 	}
 	defer sqlTx.Cancel()
 
 	table, err := getTableForCollection(sqlTx, collectionName)
 	if err != nil {
-		return 0, "", nil, err
+		return nil, err
 	}
 
-	searchKey, err := e.getKeyForDocument(ctx, sqlTx, collectionName, docID)
+	docID, err := documentIDFromKeyValues(table, keyValues)
 	if err != nil {
-		return 0, "", nil, err
+		return nil, err
 	}
 
-	encodedDoc, err = e.getEncodedDocument(searchKey, txID, false)
+	searchKey, err := e.documentKey(table, docID)
 	if err != nil {
-		return 0, "", nil, err
+		return nil, err
 	}
 
-	return table.ID(), docIDFieldName(table), encodedDoc, nil
+	revisions, err := e.documentHistory(searchKey, docID, true, offset, limit)
+	if errors.Is(err, store.ErrKeyNotFound) {
+		return nil, ErrDocumentNotFound
+	}
+
+	return revisions, err
 }
 
 // AuditDocument returns the audit history of a document.
@@ -990,7 +2728,11 @@ func (e *Engine) AuditDocument(ctx context.Context, collectionName string, docID
 		return nil, err
 	}
 
-	txIDs, hCount, err := e.sqlEngine.GetStore().History(searchKey, uint64(offset), desc, limit)
+	return e.documentHistory(searchKey, docID, desc, offset, limit)
+}
+
+func (e *Engine) documentHistory(searchKey []byte, docID DocumentID, desc bool, offset uint64, limit int) ([]*protomodel.DocumentAtRevision, error) {
+	txIDs, hCount, err := e.sqlEngine.GetStore().History(searchKey, offset, desc, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -1008,8 +2750,14 @@ func (e *Engine) AuditDocument(ctx context.Context, collectionName string, docID
 			return nil, err
 		}
 
+		hdr, err := e.sqlEngine.GetStore().ReadTxHeader(txID, true, false)
+		if err != nil {
+			return nil, err
+		}
+
 		docAtRevision.DocumentId = docID.EncodeToHexString()
 		docAtRevision.Revision = revision
+		docAtRevision.Timestamp = hdr.Ts
 
 		results = append(results, docAtRevision)
 
@@ -1023,113 +2771,22 @@ func (e *Engine) AuditDocument(ctx context.Context, collectionName string, docID
 	return results, nil
 }
 
-// generateSQLFilteringExpression generates a boolean expression in Disjunctive Normal Form from a list of expressions
-func generateSQLFilteringExpression(expressions []*protomodel.QueryExpression, table *sql.Table) (sql.ValueExp, error) {
-	var outerExp sql.ValueExp
-
-	for i, exp := range expressions {
-		if len(exp.FieldComparisons) == 0 {
-			return nil, fmt.Errorf("%w: query expression without any field comparisson", ErrIllegalArguments)
-		}
-
-		var innerExp sql.ValueExp
-
-		for i, exp := range exp.FieldComparisons {
-			column, err := getColumnForField(table, exp.Field)
-			if err != nil {
-				return nil, err
-			}
-
-			value, err := structValueToSqlValue(exp.Value, column.Type())
-			if err != nil {
-				return nil, err
-			}
-
-			colSelector := sql.NewColSelector(table.Name(), exp.Field)
-
-			var fieldExp sql.ValueExp
-
-			switch exp.Operator {
-			case protomodel.ComparisonOperator_LIKE:
-				{
-					fieldExp = sql.NewLikeBoolExp(colSelector, false, value)
-				}
-			case protomodel.ComparisonOperator_NOT_LIKE:
-				{
-					fieldExp = sql.NewLikeBoolExp(colSelector, true, value)
-				}
-			default:
-				{
-					sqlCmpOp, err := sqlCmpOperatorFor(exp.Operator)
-					if err != nil {
-						return nil, err
-					}
-
-					fieldExp = sql.NewCmpBoolExp(sqlCmpOp, colSelector, value)
-				}
-			}
-
-			if i == 0 {
-				innerExp = fieldExp
-			} else {
-				innerExp = sql.NewBinBoolExp(sql.AND, innerExp, fieldExp)
-			}
-		}
-
-		if i == 0 {
-			outerExp = innerExp
-		} else {
-			outerExp = sql.NewBinBoolExp(sql.OR, outerExp, innerExp)
-		}
-	}
-
-	return outerExp, nil
-}
-
-func sqlCmpOperatorFor(op protomodel.ComparisonOperator) (sql.CmpOperator, error) {
-	switch op {
-	case protomodel.ComparisonOperator_EQ:
-		{
-			return sql.EQ, nil
-		}
-	case protomodel.ComparisonOperator_NE:
-		{
-			return sql.NE, nil
-		}
-	case protomodel.ComparisonOperator_LT:
-		{
-			return sql.LT, nil
-		}
-	case protomodel.ComparisonOperator_LE:
-		{
-			return sql.LE, nil
-		}
-	case protomodel.ComparisonOperator_GT:
-		{
-			return sql.GT, nil
-		}
-	case protomodel.ComparisonOperator_GE:
-		{
-			return sql.GE, nil
-		}
-	default:
-		{
-			return 0, fmt.Errorf("%w: unsupported operator ('%s')", ErrIllegalArguments, op)
-		}
-	}
-}
-
 func (e *Engine) getKeyForDocument(ctx context.Context, sqlTx *sql.SQLTx, collectionName string, documentID DocumentID) ([]byte, error) {
 	table, err := getTableForCollection(sqlTx, collectionName)
 	if err != nil {
 		return nil, err
 	}
 
+	return e.documentKey(table, documentID[:])
+}
+
+// documentKey returns the key of the document in the primary index of the collection
+func (e *Engine) documentKey(table *sql.Table, documentID []byte) ([]byte, error) {
 	var searchKey []byte
 
 	valbuf := bytes.Buffer{}
 
-	rval := sql.NewBlob(documentID[:])
+	rval := sql.NewBlob(documentID)
 	encVal, _, err := sql.EncodeRawValueAsKey(rval.RawValue(), sql.BLOBType, MaxDocumentIDLength)
 	if err != nil {
 		return nil, err
@@ -1170,10 +2827,24 @@ func (e *Engine) getDocumentAtTransaction(
 		}, nil
 	}
 
+	doc, err := decodeDocument(encDoc.EncodedDocument)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protomodel.DocumentAtRevision{
+		TransactionId: encDoc.TxID,
+		Metadata:      kvMetadataToProto(encDoc.KVMetadata),
+		Document:      doc,
+	}, err
+}
+
+// decodeDocument decodes the document held by an encoded row of a collection
+func decodeDocument(encodedRow []byte) (*structpb.Struct, error) {
 	voff := sql.EncLenLen + sql.EncIDLen
 
 	// DocumentIDField
-	_, n, err := sql.DecodeValue(encDoc.EncodedDocument[voff:], sql.BLOBType)
+	_, n, err := sql.DecodeValue(encodedRow[voff:], sql.BLOBType)
 	if err != nil {
 		return nil, mayTranslateError(err)
 	}
@@ -1181,7 +2852,7 @@ func (e *Engine) getDocumentAtTransaction(
 	voff += n + sql.EncIDLen
 
 	// DocumentBLOBField
-	encodedDoc, _, err := sql.DecodeValue(encDoc.EncodedDocument[voff:], sql.BLOBType)
+	encodedDoc, _, err := sql.DecodeValue(encodedRow[voff:], sql.BLOBType)
 	if err != nil {
 		return nil, mayTranslateError(err)
 	}
@@ -1194,11 +2865,7 @@ func (e *Engine) getDocumentAtTransaction(
 		return nil, err
 	}
 
-	return &protomodel.DocumentAtRevision{
-		TransactionId: encDoc.TxID,
-		Metadata:      kvMetadataToProto(encDoc.KVMetadata),
-		Document:      doc,
-	}, err
+	return doc, nil
 }
 
 func (e *Engine) getEncodedDocument(
@@ -1239,6 +2906,7 @@ func (e *Engine) getEncodedDocument(
 	}
 
 	return &EncodedDocument{
+		Key:             key,
 		TxID:            txID,
 		Revision:        revision,
 		KVMetadata:      md,
@@ -1261,26 +2929,26 @@ func (e *Engine) readMetadataAndValue(key []byte, atTx uint64, skipIntegrityChec
 	return entry.Metadata(), v, nil
 }
 
-// DeleteDocuments deletes documents matching the query
-func (e *Engine) DeleteDocuments(ctx context.Context, query *protomodel.Query) error {
+// DeleteDocuments deletes documents matching the query and returns the number of deleted documents
+func (e *Engine) DeleteDocuments(ctx context.Context, query *protomodel.Query) (count int64, err error) {
 	if query == nil {
-		return ErrIllegalArguments
+		return 0, ErrIllegalArguments
 	}
 
 	sqlTx, err := e.sqlEngine.NewTx(ctx, sql.DefaultTxOptions())
 	if err != nil {
-		return mayTranslateError(err)
+		return 0, mayTranslateError(err)
 	}
 	defer sqlTx.Cancel()
 
 	table, err := getTableForCollection(sqlTx, query.CollectionName)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	queryCondition, err := generateSQLFilteringExpression(query.Expressions, table)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	// Delete a single document matching the query
@@ -1291,22 +2959,192 @@ func (e *Engine) DeleteDocuments(ctx context.Context, query *protomodel.Query) e
 		sql.NewInteger(int64(query.Limit)),
 	)
 
-	_, _, err = e.sqlEngine.ExecPreparedStmts(
+	_, ctxs, err := e.sqlEngine.ExecPreparedStmts(
 		ctx,
 		sqlTx,
 		[]sql.SQLStmt{deleteStmt},
 		nil,
 	)
 	if err != nil {
-		return mayTranslateError(err)
+		return 0, mayTranslateError(err)
 	}
 
-	return nil
+	return int64(ctxs[0].UpdatedRows()), nil
+}
+
+// DeleteExpiredDocuments deletes the expired documents of the collection and returns the number of deleted documents.
+// Documents are deleted in batches, each one within its own transaction, so to stay within the limits of the store.
+func (e *Engine) DeleteExpiredDocuments(ctx context.Context, collectionName string) (count int64, err error) {
+	var lastDocID DocumentID
+
+	for {
+		deleted, lastScannedDocID, err := e.DeleteExpiredDocumentsBatch(ctx, collectionName, lastDocID)
+		count += deleted
+
+		if err != nil || lastScannedDocID == nil {
+			return count, err
+		}
+
+		lastDocID = lastScannedDocID
+	}
+}
+
+// DeleteExpiredDocumentsBatch deletes the expired documents found while scanning the collection, starting after the
+// document afterDocID or from the first one when nil, until as many as fit into a single transaction are found.
+// It returns the number of deleted documents and the id of the last scanned one, the next batch is resumed from,
+// which is nil once the end of the collection is reached.
+func (e *Engine) DeleteExpiredDocumentsBatch(
+	ctx context.Context,
+	collectionName string,
+	afterDocID DocumentID,
+) (count int64, lastDocID DocumentID, err error) {
+	sqlTx, err := e.sqlEngine.NewTx(ctx, sql.DefaultTxOptions().WithExplicitClose(true))
+	if err != nil {
+		return 0, nil, mayTranslateError(err)
+	}
+	defer sqlTx.Cancel()
+
+	table, err := getTableForCollection(sqlTx, collectionName)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	ttl, err := e.collectionTTL(sqlTx, table)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if ttl == 0 {
+		return 0, nil, nil
+	}
+
+	// deleting a document writes an entry per index
+	batchSize := e.sqlEngine.GetStore().MaxTxEntries() / len(table.GetIndexes())
+
+	docIDFieldName := docIDFieldName(table)
+
+	var afterDocIDCondition sql.ValueExp
+
+	if afterDocID != nil {
+		// documents are scanned in the order of their ids
+		afterDocIDCondition = sql.NewCmpBoolExp(sql.GT, sql.NewColSelector(collectionName, docIDFieldName), sql.NewBlob(afterDocID))
+	}
+
+	op := sql.NewSelectStmt(
+		[]sql.Selector{sql.NewColSelector(collectionName, docIDFieldName)},
+		collectionName,
+		afterDocIDCondition,
+		nil,
+		sql.NewInteger(0),
+		sql.NewInteger(0),
+	)
+
+	r, err := e.sqlEngine.QueryPreparedStmt(ctx, sqlTx, op, nil)
+	if err != nil {
+		return 0, nil, mayTranslateError(err)
+	}
+
+	isExpired := e.documentExpiration(sqlTx, table, ttl, 0)
+
+	var deleteStmts []sql.SQLStmt
+
+	for len(deleteStmts) < batchSize {
+		row, err := r.Read(ctx)
+		if errors.Is(err, sql.ErrNoMoreRows) {
+			lastDocID = nil
+			break
+		}
+		if err != nil {
+			r.Close()
+			return 0, nil, mayTranslateError(err)
+		}
+
+		lastDocID = row.ValuesByPosition[0].RawValue().([]byte)
+
+		expired, err := isExpired(row)
+		if err != nil {
+			r.Close()
+			return 0, nil, err
+		}
+
+		if !expired {
+			continue
+		}
+
+		deleteStmts = append(deleteStmts, sql.NewDeleteFromStmt(
+			collectionName,
+			sql.NewCmpBoolExp(sql.EQ, sql.NewColSelector(collectionName, docIDFieldName), sql.NewBlob(lastDocID)),
+			nil,
+			sql.NewInteger(1),
+		))
+	}
+
+	r.Close()
+
+	if len(deleteStmts) == 0 {
+		return 0, lastDocID, nil
+	}
+
+	_, _, err = e.sqlEngine.ExecPreparedStmts(ctx, sqlTx, deleteStmts, nil)
+	if err != nil {
+		return 0, nil, mayTranslateError(err)
+	}
+
+	err = sqlTx.Commit(ctx)
+	if err != nil {
+		return 0, nil, mayTranslateError(err)
+	}
+
+	return int64(len(deleteStmts)), lastDocID, nil
 }
 
-// CopyCatalogToTx copies the current sql catalog to the ongoing transaction.
+// CopyCatalogToTx copies the current sql catalog to the ongoing transaction,
+// along with the ttl and the id generator of the collections which are kept alongside it.
 func (e *Engine) CopyCatalogToTx(ctx context.Context, tx *store.OngoingTx) error {
-	return e.sqlEngine.CopyCatalogToTx(ctx, tx)
+	err := e.sqlEngine.CopyCatalogToTx(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	for _, prefix := range []string{collectionTTLPrefix, collectionIDGeneratorPrefix, collectionLimitsPrefix} {
+		err = e.copyEntriesToTx(tx, sql.MapKey(e.sqlEngine.GetPrefix(), prefix))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *Engine) copyEntriesToTx(tx *store.OngoingTx, prefix []byte) error {
+	reader, err := tx.NewKeyReader(store.KeyReaderSpec{
+		Prefix:  prefix,
+		Filters: []store.FilterFn{store.IgnoreExpired, store.IgnoreDeleted},
+	})
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	for {
+		key, vref, err := reader.Read()
+		if errors.Is(err, store.ErrNoMoreEntries) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		v, err := vref.Resolve()
+		if err != nil {
+			return err
+		}
+
+		err = tx.Set(key, nil, v)
+		if err != nil {
+			return err
+		}
+	}
 }
 
 func generateSQLOrderByClauses(table *sql.Table, orderBy []*protomodel.OrderByClause) (ordCols []*sql.OrdCol) {
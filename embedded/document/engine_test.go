@@ -18,8 +18,13 @@ package document
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/pkg/api/protomodel"
 	"github.com/stretchr/testify/require"
@@ -352,6 +357,23 @@ func TestListCollections(t *testing.T) {
 	collectionList, err := engine.GetCollections(context.Background())
 	require.NoError(t, err)
 	require.Equal(t, len(collections), len(collectionList))
+
+	for i, collection := range collectionList {
+		require.Equal(t, collections[i], collection.Name)
+		require.Equal(t, DefaultDocumentIDField, collection.DocumentIdFieldName)
+
+		require.Len(t, collection.Fields, 6)
+		require.Equal(t, DefaultDocumentIDField, collection.Fields[0].Name)
+		require.Equal(t, protomodel.FieldType_STRING, collection.Fields[0].Type)
+		require.Equal(t, "number", collection.Fields[1].Name)
+		require.Equal(t, protomodel.FieldType_INTEGER, collection.Fields[1].Type)
+
+		require.Len(t, collection.Indexes, 6)
+		require.Equal(t, []string{DefaultDocumentIDField}, collection.Indexes[0].Fields)
+		require.True(t, collection.Indexes[0].IsUnique)
+		require.Equal(t, []string{"number"}, collection.Indexes[1].Fields)
+		require.False(t, collection.Indexes[1].IsUnique)
+	}
 }
 
 func TestGetDocument(t *testing.T) {
@@ -534,6 +556,28 @@ func TestDocumentAudit(t *testing.T) {
 		require.Equal(t, uint64(2), doc.Revision)
 	})
 
+	t.Run("get encoded documents should return the matching documents", func(t *testing.T) {
+		_, _, _, err := engine.GetEncodedDocuments(context.Background(), nil, 0, 1)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		query := &protomodel.Query{CollectionName: collectionName}
+
+		_, field, docs, err := engine.GetEncodedDocuments(context.Background(), query, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, DefaultDocumentIDField, field)
+		require.Len(t, docs, 1)
+		require.Equal(t, txID+1, docs[0].TxID)
+		require.Equal(t, uint64(2), docs[0].Revision)
+
+		_, _, encDoc, err := engine.GetEncodedDocument(context.Background(), collectionName, docID, 0)
+		require.NoError(t, err)
+		require.Equal(t, encDoc, docs[0])
+
+		_, _, docs, err = engine.GetEncodedDocuments(context.Background(), query, 1, 10)
+		require.NoError(t, err)
+		require.Empty(t, docs)
+	})
+
 	// get document audit
 	res, err := engine.AuditDocument(context.Background(), collectionName, docID, false, 0, 10)
 	require.NoError(t, err)
@@ -549,7 +593,7 @@ func TestDocumentAudit(t *testing.T) {
 		require.Equal(t, uint64(i+1), docAudit.Revision)
 	}
 
-	err = engine.DeleteDocuments(context.Background(), &protomodel.Query{
+	count, err := engine.DeleteDocuments(context.Background(), &protomodel.Query{
 		CollectionName: collectionName,
 		Expressions: []*protomodel.QueryExpression{
 			{
@@ -560,6 +604,7 @@ func TestDocumentAudit(t *testing.T) {
 		Limit: 1,
 	})
 	require.NoError(t, err)
+	require.EqualValues(t, 1, count)
 
 	res, err = engine.AuditDocument(context.Background(), collectionName, docID, false, 0, 10)
 	require.NoError(t, err)
@@ -893,6 +938,9 @@ func TestQueryDocuments(t *testing.T) {
 
 		_, err := engine.GetDocuments(ctx, query, 0)
 		require.ErrorIs(t, err, ErrFieldDoesNotExist)
+
+		_, err = engine.CountDocuments(ctx, query, 0)
+		require.ErrorIs(t, err, ErrFieldDoesNotExist)
 	})
 
 	t.Run("test group query with > operator", func(t *testing.T) {
@@ -929,6 +977,87 @@ func TestQueryDocuments(t *testing.T) {
 		require.EqualValues(t, 5, count)
 	})
 
+	t.Run("test disjunction of grouped comparisons", func(t *testing.T) {
+		// (country = 'country-1' OR country = 'country-2') AND pincode >= 2
+		// is expressed in disjunctive normal form
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:    "country",
+							Operator: protomodel.ComparisonOperator_EQ,
+							Value:    structpb.NewStringValue("country-1"),
+						},
+						{
+							Field:    "pincode",
+							Operator: protomodel.ComparisonOperator_GE,
+							Value:    structpb.NewNumberValue(2),
+						},
+					},
+				},
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:    "country",
+							Operator: protomodel.ComparisonOperator_EQ,
+							Value:    structpb.NewStringValue("country-2"),
+						},
+						{
+							Field:    "pincode",
+							Operator: protomodel.ComparisonOperator_GE,
+							Value:    structpb.NewNumberValue(2),
+						},
+					},
+				},
+			},
+		}
+
+		reader, err := engine.GetDocuments(ctx, query, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		docs, err := reader.ReadN(ctx, 10)
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+		require.Len(t, docs, 1)
+		require.Equal(t, "country-2", docs[0].Document.Fields["country"].GetStringValue())
+
+		count, err := engine.CountDocuments(ctx, query, 0)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, count)
+	})
+
+	t.Run("count should not be bounded by the query limit", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:    "pincode",
+							Operator: protomodel.ComparisonOperator_GE,
+							Value:    structpb.NewNumberValue(6),
+						},
+					},
+				},
+			},
+			Limit: 2,
+		}
+
+		reader, err := engine.GetDocuments(ctx, query, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		docs, err := reader.ReadN(ctx, 10)
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+		require.Len(t, docs, 2)
+
+		count, err := engine.CountDocuments(ctx, query, 0)
+		require.NoError(t, err)
+		require.EqualValues(t, 5, count)
+	})
+
 	t.Run("test group query with IS NULL operator", func(t *testing.T) {
 		query := &protomodel.Query{
 			CollectionName: collectionName,
@@ -1215,6 +1344,185 @@ func TestDocumentUpdate(t *testing.T) {
 		require.NoError(t, err)
 		require.Len(t, revisions, 1)
 	})
+
+	t.Run("upsert document should insert when no document is found", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:    "name",
+							Operator: protomodel.ComparisonOperator_EQ,
+							Value:    structpb.NewStringValue("Bob"),
+						},
+					},
+				},
+			},
+		}
+
+		toUpsertDoc := &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"name": structpb.NewStringValue("Bob"),
+				"age":  structpb.NewNumberValue(40),
+			},
+		}
+
+		revisions, err := engine.UpsertDocuments(ctx, query, toUpsertDoc)
+		require.NoError(t, err)
+		require.Len(t, revisions, 1)
+		require.EqualValues(t, 1, revisions[0].Revision)
+		require.NotEqual(t, docID.EncodeToHexString(), revisions[0].DocumentId)
+
+		// a second upsert should replace the inserted document
+		toUpsertDoc.Fields["age"] = structpb.NewNumberValue(41)
+
+		updatedRevisions, err := engine.UpsertDocuments(ctx, query, toUpsertDoc)
+		require.NoError(t, err)
+		require.Len(t, updatedRevisions, 1)
+		require.EqualValues(t, 2, updatedRevisions[0].Revision)
+		require.Equal(t, revisions[0].DocumentId, updatedRevisions[0].DocumentId)
+	})
+
+	t.Run("upsert document should insert with the provisioned docID", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+		}
+
+		newDocID := NewDocumentIDFromTx(txID + 100)
+
+		toUpsertDoc := &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				DefaultDocumentIDField: structpb.NewStringValue(newDocID.EncodeToHexString()),
+				"name":                 structpb.NewStringValue("Charlie"),
+				"age":                  structpb.NewNumberValue(50),
+			},
+		}
+
+		revisions, err := engine.UpsertDocuments(ctx, query, toUpsertDoc)
+		require.NoError(t, err)
+		require.Len(t, revisions, 1)
+		require.Equal(t, newDocID.EncodeToHexString(), revisions[0].DocumentId)
+		require.EqualValues(t, 1, revisions[0].Revision)
+	})
+
+	t.Run("upsert document should not overwrite an existing document not matching the query", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:    "name",
+							Operator: protomodel.ComparisonOperator_EQ,
+							Value:    structpb.NewStringValue("Dave"),
+						},
+					},
+				},
+			},
+		}
+
+		toUpsertDoc := &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				DefaultDocumentIDField: structpb.NewStringValue(docID.EncodeToHexString()),
+				"name":                 structpb.NewStringValue("Dave"),
+				"age":                  structpb.NewNumberValue(60),
+			},
+		}
+
+		_, err := engine.UpsertDocuments(ctx, query, toUpsertDoc)
+		require.ErrorIs(t, err, ErrConflict)
+
+		doc, err := engine.GetDocumentByKey(ctx, collectionName, []*structpb.Value{structpb.NewStringValue(docID.EncodeToHexString())})
+		require.NoError(t, err)
+		require.NotEqual(t, "Dave", doc.Document.Fields["name"].GetStringValue())
+	})
+}
+
+func TestNestedFieldQueries(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	collectionName := "mycollection"
+
+	err := engine.CreateCollection(
+		context.Background(),
+		collectionName,
+		"",
+		[]*protomodel.Field{
+			{Name: "address.location.zip", Type: protomodel.FieldType_STRING},
+		},
+		[]*protomodel.Index{
+			{Fields: []string{"address.location.zip"}},
+		},
+	)
+	require.NoError(t, err)
+
+	for _, zip := range []string{"10001", "20002"} {
+		_, _, err = engine.InsertDocument(context.Background(), collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"address": structpb.NewStructValue(&structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						"location": structpb.NewStructValue(&structpb.Struct{
+							Fields: map[string]*structpb.Value{
+								"zip":  structpb.NewStringValue(zip),
+								"city": structpb.NewStringValue("city"),
+							},
+						}),
+					},
+				}),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	t.Run("query by nested field path", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:    "address.location.zip",
+							Operator: protomodel.ComparisonOperator_EQ,
+							Value:    structpb.NewStringValue("20002"),
+						},
+					},
+				},
+			},
+		}
+
+		reader, err := engine.GetDocuments(ctx, query, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		docs, err := reader.ReadN(ctx, 10)
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+		require.Len(t, docs, 1)
+
+		location := docs[0].Document.Fields["address"].GetStructValue().Fields["location"].GetStructValue()
+		require.Equal(t, "20002", location.Fields["zip"].GetStringValue())
+	})
+
+	t.Run("query by undeclared nested field path should fail", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:    "address.location.city",
+							Operator: protomodel.ComparisonOperator_EQ,
+							Value:    structpb.NewStringValue("city"),
+						},
+					},
+				},
+			},
+		}
+
+		_, err := engine.GetDocuments(ctx, query, 0)
+		require.ErrorIs(t, err, ErrFieldDoesNotExist)
+	})
 }
 
 func TestFloatSupport(t *testing.T) {
@@ -1270,10 +1578,10 @@ func TestFloatSupport(t *testing.T) {
 	require.Equal(t, 3.1, doc.Document.Fields["number"].GetNumberValue())
 }
 
-func TestDeleteCollection(t *testing.T) {
+func TestStringSupport(t *testing.T) {
+	ctx := context.Background()
 	engine := makeEngine(t)
 
-	// create collection
 	collectionName := "mycollection"
 
 	err := engine.CreateCollection(
@@ -1281,46 +1589,233 @@ func TestDeleteCollection(t *testing.T) {
 		collectionName,
 		"",
 		[]*protomodel.Field{
-			{Name: "number", Type: protomodel.FieldType_INTEGER},
+			{Name: "email", Type: protomodel.FieldType_STRING},
 		},
 		[]*protomodel.Index{
-			{Fields: []string{"number"}},
+			{Fields: []string{"email"}, IsUnique: true},
 		},
 	)
 	require.NoError(t, err)
 
-	// add documents to collection
-	for i := 1.0; i <= 10; i++ {
+	for _, email := range []string{"alice@example.com", "bob@example.com", "carol@example.com"} {
 		_, _, err = engine.InsertDocument(context.Background(), collectionName, &structpb.Struct{
 			Fields: map[string]*structpb.Value{
-				"number": structpb.NewNumberValue(i),
+				"email": structpb.NewStringValue(email),
 			},
 		})
 		require.NoError(t, err)
 	}
 
-	t.Run("delete collection and check if it is empty", func(t *testing.T) {
-		err = engine.DeleteCollection(context.Background(), collectionName)
-		require.NoError(t, err)
+	t.Run("uniqueness should be enforced over string fields", func(t *testing.T) {
+		_, _, err = engine.InsertDocument(context.Background(), collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"email": structpb.NewStringValue("bob@example.com"),
+			},
+		})
+		require.ErrorIs(t, err, ErrConflict)
+		require.ErrorIs(t, err, ErrUniqueConstraintViolated)
 
-		collectionList, err := engine.GetCollections(context.Background())
-		require.NoError(t, err)
-		require.Empty(t, collectionList)
+		var violation *UniqueConstraintViolation
+		require.ErrorAs(t, err, &violation)
+		require.Equal(t, collectionName, violation.Collection)
+		require.Equal(t, []string{"email"}, violation.Fields)
 	})
-}
 
-func TestUpdateCollection(t *testing.T) {
-	engine := makeEngine(t)
-
-	collectionName := "mycollection"
+	t.Run("non-string values should be rejected", func(t *testing.T) {
+		_, _, err = engine.InsertDocument(context.Background(), collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"email": structpb.NewNumberValue(1),
+			},
+		})
+		require.ErrorIs(t, err, ErrUnexpectedValue)
+	})
 
-	t.Run("create collection and add index", func(t *testing.T) {
-		err := engine.CreateCollection(
-			context.Background(),
-			collectionName,
-			"",
-			[]*protomodel.Field{
-				{Name: "number", Type: protomodel.FieldType_DOUBLE},
+	t.Run("query by string equality", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:    "email",
+							Operator: protomodel.ComparisonOperator_EQ,
+							Value:    structpb.NewStringValue("bob@example.com"),
+						},
+					},
+				},
+			},
+		}
+
+		reader, err := engine.GetDocuments(ctx, query, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		doc, err := reader.Read(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "bob@example.com", doc.Document.Fields["email"].GetStringValue())
+
+		_, err = reader.Read(ctx)
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+	})
+
+	t.Run("query by string range", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:    "email",
+							Operator: protomodel.ComparisonOperator_GT,
+							Value:    structpb.NewStringValue("alice@example.com"),
+						},
+					},
+				},
+			},
+			OrderBy: []*protomodel.OrderByClause{
+				{Field: "email"},
+			},
+		}
+
+		reader, err := engine.GetDocuments(ctx, query, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		docs, err := reader.ReadN(ctx, 10)
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+		require.Len(t, docs, 2)
+		require.Equal(t, "bob@example.com", docs[0].Document.Fields["email"].GetStringValue())
+		require.Equal(t, "carol@example.com", docs[1].Document.Fields["email"].GetStringValue())
+	})
+}
+
+func TestUniqueIndexConcurrentInsertions(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	collectionName := "mycollection"
+
+	err := engine.CreateCollection(
+		ctx,
+		collectionName,
+		"",
+		[]*protomodel.Field{
+			{Name: "email", Type: protomodel.FieldType_STRING},
+		},
+		[]*protomodel.Index{
+			{Fields: []string{"email"}, IsUnique: true},
+		},
+	)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	var inserted int32
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, _, err := engine.InsertDocument(ctx, collectionName, &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					"email": structpb.NewStringValue("alice@example.com"),
+				},
+			})
+			if err == nil {
+				atomic.AddInt32(&inserted, 1)
+				return
+			}
+			require.ErrorIs(t, err, ErrConflict)
+		}()
+	}
+
+	wg.Wait()
+
+	require.Equal(t, int32(1), inserted)
+
+	count, err := engine.CountDocuments(ctx, &protomodel.Query{CollectionName: collectionName}, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+}
+
+func TestDeleteCollection(t *testing.T) {
+	engine := makeEngine(t)
+
+	// create collection
+	collectionName := "mycollection"
+
+	err := engine.CreateCollection(
+		context.Background(),
+		collectionName,
+		"",
+		[]*protomodel.Field{
+			{Name: "number", Type: protomodel.FieldType_INTEGER},
+		},
+		[]*protomodel.Index{
+			{Fields: []string{"number"}},
+		},
+	)
+	require.NoError(t, err)
+
+	// add documents to collection
+	for i := 1.0; i <= 10; i++ {
+		_, _, err = engine.InsertDocument(context.Background(), collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"number": structpb.NewNumberValue(i),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	t.Run("delete collection and check if it is empty", func(t *testing.T) {
+		err = engine.DeleteCollection(context.Background(), collectionName)
+		require.NoError(t, err)
+
+		collectionList, err := engine.GetCollections(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, collectionList)
+	})
+
+	t.Run("deleting an already deleted collection should pass", func(t *testing.T) {
+		err = engine.DeleteCollection(context.Background(), collectionName)
+		require.NoError(t, err)
+	})
+
+	t.Run("documents of a deleted collection should not be accessible", func(t *testing.T) {
+		_, err := engine.GetDocuments(context.Background(), &protomodel.Query{CollectionName: collectionName}, 0)
+		require.ErrorIs(t, err, ErrCollectionDoesNotExist)
+
+		_, _, err = engine.InsertDocument(context.Background(), collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"number": structpb.NewNumberValue(1),
+			},
+		})
+		require.ErrorIs(t, err, ErrCollectionDoesNotExist)
+	})
+
+	t.Run("collection should be created again after deletion", func(t *testing.T) {
+		err := engine.CreateCollection(context.Background(), collectionName, "", nil, nil)
+		require.NoError(t, err)
+
+		count, err := engine.CountDocuments(context.Background(), &protomodel.Query{CollectionName: collectionName}, 0)
+		require.NoError(t, err)
+		require.Zero(t, count)
+	})
+}
+
+func TestUpdateCollection(t *testing.T) {
+	engine := makeEngine(t)
+
+	collectionName := "mycollection"
+
+	t.Run("create collection and add index", func(t *testing.T) {
+		err := engine.CreateCollection(
+			context.Background(),
+			collectionName,
+			"",
+			[]*protomodel.Field{
+				{Name: "number", Type: protomodel.FieldType_DOUBLE},
 				{Name: "name", Type: protomodel.FieldType_STRING},
 				{Name: "country", Type: protomodel.FieldType_STRING},
 				{Name: "pin", Type: protomodel.FieldType_INTEGER},
@@ -1533,6 +2028,103 @@ func TestCollectionUpdateWithDeletedIndex(t *testing.T) {
 	})
 }
 
+func TestCreateIndexOnCollectionWithDocuments(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	collectionName := "mycollection"
+
+	err := engine.CreateCollection(
+		context.Background(),
+		collectionName,
+		"",
+		[]*protomodel.Field{
+			{Name: "name", Type: protomodel.FieldType_STRING},
+			{Name: "age", Type: protomodel.FieldType_INTEGER},
+		},
+		nil,
+	)
+	require.NoError(t, err)
+
+	for _, age := range []float64{30, 10, 20} {
+		_, _, err = engine.InsertDocument(context.Background(), collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"name": structpb.NewStringValue("name"),
+				"age":  structpb.NewNumberValue(age),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	t.Run("unique index creation should fail when documents violate the constraint", func(t *testing.T) {
+		err := engine.CreateIndex(ctx, collectionName, []string{"name"}, true)
+		require.ErrorIs(t, err, ErrConflict)
+
+		collection, err := engine.GetCollection(ctx, collectionName)
+		require.NoError(t, err)
+		require.Len(t, collection.Indexes, 1)
+	})
+
+	err = engine.CreateIndex(ctx, collectionName, []string{"age"}, false)
+	require.NoError(t, err)
+
+	collection, err := engine.GetCollection(ctx, collectionName)
+	require.NoError(t, err)
+	require.Len(t, collection.Indexes, 2)
+	require.Equal(t, []string{"age"}, collection.Indexes[1].Fields)
+
+	query := &protomodel.Query{
+		CollectionName: collectionName,
+		OrderBy: []*protomodel.OrderByClause{
+			{Field: "age"},
+		},
+	}
+
+	reader, err := engine.GetDocuments(ctx, query, 0)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	docs, err := reader.ReadN(ctx, 10)
+	require.ErrorIs(t, err, ErrNoMoreDocuments)
+	require.Len(t, docs, 3)
+
+	for i, age := range []float64{10, 20, 30} {
+		require.Equal(t, age, docs[i].Document.Fields["age"].GetNumberValue())
+	}
+}
+
+func TestCreateIndexExceedingMaxTxEntries(t *testing.T) {
+	ctx := context.Background()
+
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMaxTxEntries(20))
+	require.NoError(t, err)
+	defer st.Close()
+
+	engine, err := NewEngine(st, DefaultOptions())
+	require.NoError(t, err)
+
+	collectionName := "mycollection"
+
+	err = engine.CreateCollection(ctx, collectionName, "", []*protomodel.Field{{Name: "age", Type: protomodel.FieldType_INTEGER}}, nil)
+	require.NoError(t, err)
+
+	for i := 0; i < 30; i++ {
+		_, _, err = engine.InsertDocument(ctx, collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"age": structpb.NewNumberValue(float64(i)),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	err = engine.CreateIndex(ctx, collectionName, []string{"age"}, false)
+	require.ErrorIs(t, err, ErrTooManyDocumentsToIndex)
+
+	collection, err := engine.GetCollection(ctx, collectionName)
+	require.NoError(t, err)
+	require.Len(t, collection.Indexes, 1)
+}
+
 func TestBulkInsert(t *testing.T) {
 	ctx := context.Background()
 	engine := makeEngine(t)
@@ -1586,56 +2178,203 @@ func TestBulkInsert(t *testing.T) {
 	}
 }
 
-func TestPaginationOnReader(t *testing.T) {
+func TestDocumentValidation(t *testing.T) {
 	ctx := context.Background()
 	engine := makeEngine(t)
 
-	// create collection
 	collectionName := "mycollection"
 
 	err := engine.CreateCollection(
-		context.Background(),
+		ctx,
 		collectionName,
 		"",
 		[]*protomodel.Field{
-			{Name: "country", Type: protomodel.FieldType_STRING},
 			{Name: "pincode", Type: protomodel.FieldType_INTEGER},
+			{Name: "address.street", Type: protomodel.FieldType_STRING},
 		},
-		[]*protomodel.Index{
-			{Fields: []string{"country"}},
-			{Fields: []string{"pincode"}},
-		},
+		nil,
 	)
 	require.NoError(t, err)
 
-	// add documents to collection
-	for i := 1.0; i <= 20; i++ {
-		_, _, err = engine.InsertDocument(ctx, collectionName, &structpb.Struct{
-			Fields: map[string]*structpb.Value{
-				"country": structpb.NewStringValue(fmt.Sprintf("country-%d", int(i))),
-				"pincode": structpb.NewNumberValue(i),
+	t.Run("insertion should fail when a field has an unexpected type", func(t *testing.T) {
+		_, _, err := engine.InsertDocuments(ctx, collectionName, []*structpb.Struct{
+			{
+				Fields: map[string]*structpb.Value{
+					"pincode": structpb.NewNumberValue(1),
+				},
+			},
+			{
+				Fields: map[string]*structpb.Value{
+					"pincode": structpb.NewStringValue("2"),
+				},
 			},
 		})
+		require.ErrorIs(t, err, ErrUnexpectedValue)
+		require.Contains(t, err.Error(), "pincode")
+
+		count, err := engine.CountDocuments(ctx, &protomodel.Query{CollectionName: collectionName}, 0)
 		require.NoError(t, err)
-	}
+		require.Zero(t, count)
+	})
 
-	t.Run("test reader for multiple reads", func(t *testing.T) {
-		query := &protomodel.Query{
-			CollectionName: collectionName,
-			Expressions: []*protomodel.QueryExpression{
-				{
-					FieldComparisons: []*protomodel.FieldComparison{
-						{
-							Field:    "pincode",
-							Operator: protomodel.ComparisonOperator_GE,
-							Value:    structpb.NewNumberValue(0),
-						},
-					},
-				},
+	t.Run("insertion should fail when a nested field path does not hold a document", func(t *testing.T) {
+		_, _, err := engine.InsertDocument(ctx, collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"address": structpb.NewStringValue("mainstreet"),
 			},
-		}
+		})
+		require.ErrorIs(t, err, ErrUnexpectedValue)
+		require.Contains(t, err.Error(), "address.street")
+	})
 
-		reader, err := engine.GetDocuments(ctx, query, 0)
+	t.Run("insertion should succeed when a nested document is null", func(t *testing.T) {
+		_, _, err := engine.InsertDocument(ctx, collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"address": structpb.NewNullValue(),
+			},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("replacement should fail when the document id has an unexpected type", func(t *testing.T) {
+		_, err := engine.ReplaceDocuments(ctx, &protomodel.Query{CollectionName: collectionName}, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				DefaultDocumentIDField: structpb.NewNumberValue(1),
+			},
+		})
+		require.ErrorIs(t, err, ErrUnexpectedValue)
+		require.Contains(t, err.Error(), DefaultDocumentIDField)
+	})
+
+	t.Run("replacement should fail when the document id is invalid", func(t *testing.T) {
+		_, err := engine.ReplaceDocuments(ctx, &protomodel.Query{CollectionName: collectionName}, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				DefaultDocumentIDField: structpb.NewStringValue("invalid"),
+			},
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+		require.Contains(t, err.Error(), DefaultDocumentIDField)
+	})
+}
+
+func TestBulkInsertAtomicity(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	collectionName := "mycollection"
+
+	err := engine.CreateCollection(
+		ctx,
+		collectionName,
+		"",
+		[]*protomodel.Field{
+			{Name: "email", Type: protomodel.FieldType_STRING},
+		},
+		[]*protomodel.Index{
+			{Fields: []string{"email"}, IsUnique: true},
+		},
+	)
+	require.NoError(t, err)
+
+	_, _, err = engine.InsertDocument(ctx, collectionName, &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"email": structpb.NewStringValue("alice@example.com"),
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("no document should be inserted when one violates a uniqueness constraint", func(t *testing.T) {
+		_, _, err := engine.InsertDocuments(ctx, collectionName, []*structpb.Struct{
+			{
+				Fields: map[string]*structpb.Value{
+					"email": structpb.NewStringValue("bob@example.com"),
+				},
+			},
+			{
+				Fields: map[string]*structpb.Value{
+					"email": structpb.NewStringValue("alice@example.com"),
+				},
+			},
+		})
+		require.ErrorIs(t, err, ErrConflict)
+	})
+
+	t.Run("no document should be inserted when documents of the batch conflict with each other", func(t *testing.T) {
+		_, _, err := engine.InsertDocuments(ctx, collectionName, []*structpb.Struct{
+			{
+				Fields: map[string]*structpb.Value{
+					"email": structpb.NewStringValue("bob@example.com"),
+				},
+			},
+			{
+				Fields: map[string]*structpb.Value{
+					"email": structpb.NewStringValue("bob@example.com"),
+				},
+			},
+		})
+		require.ErrorIs(t, err, ErrConflict)
+	})
+
+	reader, err := engine.GetDocuments(ctx, &protomodel.Query{CollectionName: collectionName}, 0)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	docs, err := reader.ReadN(ctx, 10)
+	require.ErrorIs(t, err, ErrNoMoreDocuments)
+	require.Len(t, docs, 1)
+	require.Equal(t, "alice@example.com", docs[0].Document.Fields["email"].GetStringValue())
+}
+
+func TestPaginationOnReader(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	// create collection
+	collectionName := "mycollection"
+
+	err := engine.CreateCollection(
+		context.Background(),
+		collectionName,
+		"",
+		[]*protomodel.Field{
+			{Name: "country", Type: protomodel.FieldType_STRING},
+			{Name: "pincode", Type: protomodel.FieldType_INTEGER},
+		},
+		[]*protomodel.Index{
+			{Fields: []string{"country"}},
+			{Fields: []string{"pincode"}},
+		},
+	)
+	require.NoError(t, err)
+
+	// add documents to collection
+	for i := 1.0; i <= 20; i++ {
+		_, _, err = engine.InsertDocument(ctx, collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"country": structpb.NewStringValue(fmt.Sprintf("country-%d", int(i))),
+				"pincode": structpb.NewNumberValue(i),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	t.Run("test reader for multiple reads", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:    "pincode",
+							Operator: protomodel.ComparisonOperator_GE,
+							Value:    structpb.NewNumberValue(0),
+						},
+					},
+				},
+			},
+		}
+
+		reader, err := engine.GetDocuments(ctx, query, 0)
 		require.NoError(t, err)
 		defer reader.Close()
 
@@ -1653,6 +2392,43 @@ func TestPaginationOnReader(t *testing.T) {
 			require.Equal(t, i, doc.Document.Fields["pincode"].GetNumberValue())
 		}
 	})
+
+	t.Run("test paginated queries using limit and offset", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			OrderBy: []*protomodel.OrderByClause{{
+				Field: "pincode",
+				Desc:  true,
+			}},
+			Limit: 6,
+		}
+
+		results := make([]*protomodel.DocumentAtRevision, 0)
+
+		for offset := int64(0); ; offset += int64(query.Limit) {
+			reader, err := engine.GetDocuments(ctx, query, offset)
+			require.NoError(t, err)
+
+			docs, err := reader.ReadN(ctx, int(query.Limit)+1)
+			require.ErrorIs(t, err, ErrNoMoreDocuments)
+			require.LessOrEqual(t, len(docs), int(query.Limit))
+
+			err = reader.Close()
+			require.NoError(t, err)
+
+			if len(docs) == 0 {
+				break
+			}
+
+			results = append(results, docs...)
+		}
+
+		require.Len(t, results, 20)
+
+		for i, doc := range results {
+			require.Equal(t, float64(20-i), doc.Document.Fields["pincode"].GetNumberValue())
+		}
+	})
 }
 
 func TestDeleteDocument(t *testing.T) {
@@ -1704,11 +2480,12 @@ func TestDeleteDocument(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, docs, 1)
 
-	err = engine.DeleteDocuments(ctx, nil)
+	_, err = engine.DeleteDocuments(ctx, nil)
 	require.ErrorIs(t, err, ErrIllegalArguments)
 
-	err = engine.DeleteDocuments(ctx, query)
+	count, err := engine.DeleteDocuments(ctx, query)
 	require.NoError(t, err)
+	require.EqualValues(t, 1, count)
 
 	reader, err = engine.GetDocuments(ctx, query, 0)
 	require.NoError(t, err)
@@ -1716,6 +2493,11 @@ func TestDeleteDocument(t *testing.T) {
 
 	_, err = reader.Read(ctx)
 	require.ErrorIs(t, ErrNoMoreDocuments, err)
+
+	// deleting when no document matches the query should not fail
+	count, err = engine.DeleteDocuments(ctx, query)
+	require.NoError(t, err)
+	require.Zero(t, count)
 }
 
 func TestGetCollection(t *testing.T) {
@@ -1827,3 +2609,1829 @@ func TestGetDocuments_WithOrderBy(t *testing.T) {
 		}
 	})
 }
+
+func TestGenerateSQLFilteringExpression(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	collectionName := "mycollection"
+
+	err := engine.CreateCollection(
+		ctx,
+		collectionName,
+		"",
+		[]*protomodel.Field{
+			{Name: "pincode", Type: protomodel.FieldType_INTEGER},
+		},
+		[]*protomodel.Index{
+			{Fields: []string{"pincode"}},
+		},
+	)
+	require.NoError(t, err)
+
+	sqlTx, err := engine.sqlEngine.NewTx(ctx, sql.DefaultTxOptions().WithReadOnly(true))
+	require.NoError(t, err)
+	defer sqlTx.Cancel()
+
+	table, err := getTableForCollection(sqlTx, collectionName)
+	require.NoError(t, err)
+
+	colSelector := sql.NewColSelector(collectionName, "pincode")
+
+	for _, c := range []struct {
+		op    protomodel.ComparisonOperator
+		sqlOp sql.CmpOperator
+	}{
+		{protomodel.ComparisonOperator_EQ, sql.EQ},
+		{protomodel.ComparisonOperator_NE, sql.NE},
+		{protomodel.ComparisonOperator_LT, sql.LT},
+		{protomodel.ComparisonOperator_LE, sql.LE},
+		{protomodel.ComparisonOperator_GT, sql.GT},
+		{protomodel.ComparisonOperator_GE, sql.GE},
+	} {
+		t.Run(fmt.Sprintf("operator %s", c.op), func(t *testing.T) {
+			exp, err := generateSQLFilteringExpression([]*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{Field: "pincode", Operator: c.op, Value: structpb.NewNumberValue(10)},
+					},
+				},
+			}, table)
+			require.NoError(t, err)
+			require.Equal(t, sql.NewCmpBoolExp(c.sqlOp, colSelector, sql.NewInteger(10)), exp)
+		})
+	}
+
+	t.Run("range over a numeric field", func(t *testing.T) {
+		exp, err := generateSQLFilteringExpression([]*protomodel.QueryExpression{
+			{
+				FieldComparisons: []*protomodel.FieldComparison{
+					{Field: "pincode", Operator: protomodel.ComparisonOperator_GE, Value: structpb.NewNumberValue(5)},
+					{Field: "pincode", Operator: protomodel.ComparisonOperator_LT, Value: structpb.NewNumberValue(10)},
+				},
+			},
+		}, table)
+		require.NoError(t, err)
+		require.Equal(t,
+			sql.NewBinBoolExp(
+				sql.AND,
+				sql.NewCmpBoolExp(sql.GE, colSelector, sql.NewInteger(5)),
+				sql.NewCmpBoolExp(sql.LT, colSelector, sql.NewInteger(10)),
+			), exp)
+	})
+
+	t.Run("disjunction of grouped comparisons", func(t *testing.T) {
+		exp, err := generateSQLFilteringExpression([]*protomodel.QueryExpression{
+			{
+				FieldComparisons: []*protomodel.FieldComparison{
+					{Field: "pincode", Operator: protomodel.ComparisonOperator_EQ, Value: structpb.NewNumberValue(1)},
+				},
+			},
+			{
+				FieldComparisons: []*protomodel.FieldComparison{
+					{Field: "pincode", Operator: protomodel.ComparisonOperator_GT, Value: structpb.NewNumberValue(5)},
+					{Field: "pincode", Operator: protomodel.ComparisonOperator_NE, Value: structpb.NewNumberValue(7)},
+				},
+			},
+		}, table)
+		require.NoError(t, err)
+		require.Equal(t,
+			sql.NewBinBoolExp(
+				sql.OR,
+				sql.NewCmpBoolExp(sql.EQ, colSelector, sql.NewInteger(1)),
+				sql.NewBinBoolExp(
+					sql.AND,
+					sql.NewCmpBoolExp(sql.GT, colSelector, sql.NewInteger(5)),
+					sql.NewCmpBoolExp(sql.NE, colSelector, sql.NewInteger(7)),
+				),
+			), exp)
+	})
+
+	t.Run("comparison over undeclared field", func(t *testing.T) {
+		_, err := generateSQLFilteringExpression([]*protomodel.QueryExpression{
+			{
+				FieldComparisons: []*protomodel.FieldComparison{
+					{Field: "address.zip", Operator: protomodel.ComparisonOperator_EQ, Value: structpb.NewNumberValue(10)},
+				},
+			},
+		}, table)
+		require.ErrorIs(t, err, ErrFieldDoesNotExist)
+	})
+
+	t.Run("expression without field comparisons", func(t *testing.T) {
+		_, err := generateSQLFilteringExpression([]*protomodel.QueryExpression{{}}, table)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	t.Run("unsupported operator", func(t *testing.T) {
+		_, err := generateSQLFilteringExpression([]*protomodel.QueryExpression{
+			{
+				FieldComparisons: []*protomodel.FieldComparison{
+					{Field: "pincode", Operator: protomodel.ComparisonOperator(100), Value: structpb.NewNumberValue(10)},
+				},
+			},
+		}, table)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+}
+
+func TestCollectionTTL(t *testing.T) {
+	var now time.Time
+
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithTimeFunc(func() time.Time { return now }))
+	require.NoError(t, err)
+	defer st.Close()
+
+	engine, err := NewEngine(st, DefaultOptions())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	err = engine.CreateCollectionWithOptions(ctx, "sessions", "", nil, nil, DefaultCollectionOptions().WithTTL(-time.Second))
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = engine.CreateCollectionWithOptions(
+		ctx,
+		"sessions",
+		"",
+		[]*protomodel.Field{{Name: "user", Type: protomodel.FieldType_STRING}},
+		[]*protomodel.Index{{Fields: []string{"user"}}},
+		DefaultCollectionOptions().WithTTL(time.Hour),
+	)
+	require.NoError(t, err)
+
+	collection, err := engine.GetCollection(ctx, "sessions")
+	require.NoError(t, err)
+	require.Equal(t, int64(3600), collection.Ttl)
+
+	insertSession := func(user string) {
+		_, _, err := engine.InsertDocument(ctx, "sessions", &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"user": structpb.NewStringValue(user),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	now = time.Now().Add(-2 * time.Hour)
+
+	insertSession("alice")
+	insertSession("bob")
+	insertSession("charlie")
+
+	now = time.Now()
+
+	insertSession("dave")
+	insertSession("eve")
+
+	query := &protomodel.Query{
+		CollectionName: "sessions",
+		OrderBy:        []*protomodel.OrderByClause{{Field: "user"}},
+	}
+
+	readUsers := func(query *protomodel.Query, offset int64) []string {
+		reader, err := engine.GetDocuments(ctx, query, offset)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		revisions, err := reader.ReadN(ctx, 10)
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+
+		users := make([]string, len(revisions))
+		for i, rev := range revisions {
+			users[i] = rev.Document.Fields["user"].GetStringValue()
+		}
+
+		return users
+	}
+
+	t.Run("expired documents should be excluded from queries", func(t *testing.T) {
+		require.Equal(t, []string{"dave", "eve"}, readUsers(query, 0))
+
+		count, err := engine.CountDocuments(ctx, query, 0)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), count)
+	})
+
+	t.Run("offset and limit should only take unexpired documents into account", func(t *testing.T) {
+		require.Equal(t, []string{"eve"}, readUsers(query, 1))
+
+		limitedQuery := &protomodel.Query{
+			CollectionName: "sessions",
+			OrderBy:        []*protomodel.OrderByClause{{Field: "user"}},
+			Limit:          1,
+		}
+
+		require.Equal(t, []string{"dave"}, readUsers(limitedQuery, 0))
+	})
+
+	t.Run("expired documents should be excluded from projected queries", func(t *testing.T) {
+		reader, err := engine.GetDocumentsWithProjection(ctx, query, 0, []string{"user"})
+		require.NoError(t, err)
+		defer reader.Close()
+
+		revisions, err := reader.ReadN(ctx, 10)
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+		require.Len(t, revisions, 2)
+		require.Equal(t, "dave", revisions[0].Document.Fields["user"].GetStringValue())
+		require.Len(t, revisions[0].Document.Fields, 2)
+	})
+
+	t.Run("expired documents should be deleted", func(t *testing.T) {
+		count, err := engine.DeleteExpiredDocuments(ctx, "sessions")
+		require.NoError(t, err)
+		require.Equal(t, int64(3), count)
+
+		count, err = engine.DeleteExpiredDocuments(ctx, "sessions")
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		require.Equal(t, []string{"dave", "eve"}, readUsers(query, 0))
+	})
+
+	t.Run("documents of collections without ttl should never expire", func(t *testing.T) {
+		err := engine.CreateCollection(ctx, "users", "", nil, nil)
+		require.NoError(t, err)
+
+		now = time.Now().Add(-24 * time.Hour)
+
+		_, _, err = engine.InsertDocument(ctx, "users", &structpb.Struct{})
+		require.NoError(t, err)
+
+		now = time.Now()
+
+		count, err := engine.DeleteExpiredDocuments(ctx, "users")
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		count, err = engine.CountDocuments(ctx, &protomodel.Query{CollectionName: "users"}, 0)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), count)
+	})
+
+	t.Run("ttl should be removed along with the collection", func(t *testing.T) {
+		err := engine.DeleteCollection(ctx, "sessions")
+		require.NoError(t, err)
+
+		err = engine.CreateCollection(ctx, "sessions", "", nil, nil)
+		require.NoError(t, err)
+
+		collection, err := engine.GetCollection(ctx, "sessions")
+		require.NoError(t, err)
+		require.Zero(t, collection.Ttl)
+	})
+}
+
+func TestDeleteExpiredDocumentsInBatches(t *testing.T) {
+	var now time.Time
+
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMaxTxEntries(10).WithTimeFunc(func() time.Time { return now }))
+	require.NoError(t, err)
+	defer st.Close()
+
+	engine, err := NewEngine(st, DefaultOptions())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	err = engine.CreateCollectionWithOptions(ctx, "sessions", "", nil, nil, DefaultCollectionOptions().WithTTL(time.Hour))
+	require.NoError(t, err)
+
+	// expired and live documents are interleaved
+	for i := 0; i < 50; i++ {
+		if i%2 == 0 {
+			now = time.Now().Add(-2 * time.Hour)
+		} else {
+			now = time.Now()
+		}
+
+		_, _, err := engine.InsertDocument(ctx, "sessions", &structpb.Struct{})
+		require.NoError(t, err)
+	}
+
+	now = time.Now()
+
+	var lastDocID DocumentID
+	var batches int
+	var deleted int64
+
+	for {
+		count, lastScannedDocID, err := engine.DeleteExpiredDocumentsBatch(ctx, "sessions", lastDocID)
+		require.NoError(t, err)
+		require.LessOrEqual(t, count, int64(10))
+
+		deleted += count
+		batches++
+
+		if lastScannedDocID == nil {
+			break
+		}
+
+		// each batch is resumed from the last scanned document
+		require.Greater(t, string(lastScannedDocID), string(lastDocID))
+		lastDocID = lastScannedDocID
+	}
+
+	require.Equal(t, int64(25), deleted)
+	require.Greater(t, batches, 2)
+
+	count, err := engine.CountDocuments(ctx, &protomodel.Query{CollectionName: "sessions"}, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(25), count)
+
+	count, err = engine.DeleteExpiredDocuments(ctx, "sessions")
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestGetDocumentsWithProjection(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	collectionName := "mycollection"
+
+	err := engine.CreateCollection(
+		ctx,
+		collectionName,
+		"",
+		[]*protomodel.Field{
+			{Name: "name", Type: protomodel.FieldType_STRING},
+			{Name: "age", Type: protomodel.FieldType_INTEGER},
+			{Name: "address.city", Type: protomodel.FieldType_STRING},
+		},
+		[]*protomodel.Index{
+			{Fields: []string{"name"}},
+			{Fields: []string{"age"}},
+			{Fields: []string{"address.city"}},
+		},
+	)
+	require.NoError(t, err)
+
+	_, docID, err := engine.InsertDocument(ctx, collectionName, &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"name": structpb.NewStringValue("alice"),
+			"age":  structpb.NewNumberValue(30),
+			"bio":  structpb.NewStringValue("a rather long biography"),
+			"address": structpb.NewStructValue(&structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					"city": structpb.NewStringValue("rome"),
+					"zip":  structpb.NewStringValue("00100"),
+				},
+			}),
+		},
+	})
+	require.NoError(t, err)
+
+	query := &protomodel.Query{CollectionName: collectionName}
+
+	readDocument := func(t *testing.T, fields []string) *structpb.Struct {
+		reader, err := engine.GetDocumentsWithProjection(ctx, query, 0, fields)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		revision, err := reader.Read(ctx)
+		require.NoError(t, err)
+
+		return revision.Document
+	}
+
+	t.Run("without projection documents should be returned whole", func(t *testing.T) {
+		doc := readDocument(t, nil)
+		require.Len(t, doc.Fields, 5)
+	})
+
+	t.Run("projection of fields stored as columns", func(t *testing.T) {
+		doc := readDocument(t, []string{"name", "address.city", "name"})
+		require.Len(t, doc.Fields, 3)
+		require.Equal(t, docID.EncodeToHexString(), doc.Fields[DefaultDocumentIDField].GetStringValue())
+		require.Equal(t, "alice", doc.Fields["name"].GetStringValue())
+
+		address := doc.Fields["address"].GetStructValue()
+		require.NotNil(t, address)
+		require.Len(t, address.Fields, 1)
+		require.Equal(t, "rome", address.Fields["city"].GetStringValue())
+	})
+
+	t.Run("projection of fields extracted from the document", func(t *testing.T) {
+		doc := readDocument(t, []string{"age", "address.zip", "missing", "bio.nested"})
+		require.Len(t, doc.Fields, 3)
+		require.Equal(t, docID.EncodeToHexString(), doc.Fields[DefaultDocumentIDField].GetStringValue())
+		require.Equal(t, float64(30), doc.Fields["age"].GetNumberValue())
+		require.Equal(t, "00100", doc.Fields["address"].GetStructValue().Fields["zip"].GetStringValue())
+	})
+
+	t.Run("projection of reserved fields should fail", func(t *testing.T) {
+		_, err := engine.GetDocumentsWithProjection(ctx, query, 0, []string{DocumentBLOBField})
+		require.ErrorIs(t, err, ErrReservedName)
+	})
+}
+
+func TestGetDocuments_WithOrderByWithoutIndex(t *testing.T) {
+	ctx := context.Background()
+
+	st, err := store.Open(t.TempDir(), store.DefaultOptions())
+	require.NoError(t, err)
+	defer st.Close()
+
+	engine, err := NewEngine(st, DefaultOptions().WithMaxSortBufferSize(8))
+	require.NoError(t, err)
+
+	collectionName := "orders"
+
+	err = engine.CreateCollection(
+		ctx,
+		collectionName,
+		"",
+		[]*protomodel.Field{
+			{Name: "customer", Type: protomodel.FieldType_STRING},
+			{Name: "amount", Type: protomodel.FieldType_INTEGER},
+		},
+		[]*protomodel.Index{
+			{Fields: []string{"customer", "amount"}},
+		},
+	)
+	require.NoError(t, err)
+
+	orders := []struct {
+		customer string
+		amount   int
+		placedAt int
+	}{
+		{"bob", 30, 4},
+		{"alice", 10, 2},
+		{"carol", 20, 9},
+		{"alice", 40, 1},
+		{"bob", 50, 7},
+		{"carol", 60, 3},
+		{"alice", 70, 8},
+		{"bob", 80, 6},
+		{"carol", 90, 5},
+	}
+
+	for _, order := range orders {
+		_, _, err = engine.InsertDocument(ctx, collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"customer": structpb.NewStringValue(order.customer),
+				"amount":   structpb.NewNumberValue(float64(order.amount)),
+				"details": structpb.NewStructValue(&structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						"placedAt": structpb.NewNumberValue(float64(order.placedAt)),
+					},
+				}),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	readAmounts := func(t *testing.T, query *protomodel.Query, offset int64) []int {
+		reader, err := engine.GetDocuments(ctx, query, offset)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		revisions, err := reader.ReadN(ctx, 20)
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+
+		amounts := make([]int, len(revisions))
+		for i, rev := range revisions {
+			amounts[i] = int(rev.Document.Fields["amount"].GetNumberValue())
+		}
+
+		return amounts
+	}
+
+	t.Run("order by a field not served by any index", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			OrderBy:        []*protomodel.OrderByClause{{Field: "amount", Desc: true}},
+			Limit:          3,
+		}
+
+		require.Equal(t, []int{90, 80, 70}, readAmounts(t, query, 0))
+		require.Equal(t, []int{60, 50, 40}, readAmounts(t, query, 3))
+
+		query.Limit = 2
+		require.Equal(t, []int{30, 20}, readAmounts(t, query, 6))
+	})
+
+	t.Run("order by a nested field not stored as a column", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			OrderBy:        []*protomodel.OrderByClause{{Field: "details.placedAt", Desc: true}},
+			Limit:          2,
+		}
+
+		require.Equal(t, []int{20, 70}, readAmounts(t, query, 0))
+	})
+
+	t.Run("order by multiple fields", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:    "customer",
+							Operator: protomodel.ComparisonOperator_NE,
+							Value:    structpb.NewStringValue("carol"),
+						},
+					},
+				},
+			},
+			OrderBy: []*protomodel.OrderByClause{
+				{Field: "customer", Desc: true},
+				{Field: "amount"},
+			},
+		}
+
+		require.Equal(t, []int{30, 50, 80, 10, 40, 70}, readAmounts(t, query, 0))
+
+		count, err := engine.CountDocuments(ctx, query, 0)
+		require.NoError(t, err)
+		require.Equal(t, int64(6), count)
+	})
+
+	t.Run("order by a missing field should keep the scan order", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			OrderBy:        []*protomodel.OrderByClause{{Field: "missing"}},
+			Limit:          3,
+		}
+
+		require.Equal(t, []int{30, 10, 20}, readAmounts(t, query, 0))
+	})
+
+	t.Run("sorted documents should be projected", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			OrderBy:        []*protomodel.OrderByClause{{Field: "amount"}},
+			Limit:          1,
+		}
+
+		reader, err := engine.GetDocumentsWithProjection(ctx, query, 0, []string{"customer"})
+		require.NoError(t, err)
+		defer reader.Close()
+
+		rev, err := reader.Read(ctx)
+		require.NoError(t, err)
+		require.Len(t, rev.Document.Fields, 2)
+		require.Equal(t, "alice", rev.Document.Fields["customer"].GetStringValue())
+
+		_, err = reader.Read(ctx)
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+	})
+
+	t.Run("sorting more documents than the sort buffer size should fail", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			OrderBy:        []*protomodel.OrderByClause{{Field: "amount"}},
+		}
+
+		_, err := engine.GetDocuments(ctx, query, 0)
+		require.ErrorIs(t, err, ErrSortBufferSizeExceeded)
+	})
+
+	t.Run("order by reserved fields should fail", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			OrderBy:        []*protomodel.OrderByClause{{Field: DocumentBLOBField}},
+		}
+
+		_, err := engine.GetDocuments(ctx, query, 0)
+		require.ErrorIs(t, err, ErrReservedName)
+	})
+}
+
+func TestAggregateDocuments(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	collectionName := "orders"
+
+	err := engine.CreateCollection(
+		ctx,
+		collectionName,
+		"",
+		[]*protomodel.Field{
+			{Name: "customer", Type: protomodel.FieldType_STRING},
+			{Name: "amount", Type: protomodel.FieldType_INTEGER},
+			{Name: "discount", Type: protomodel.FieldType_DOUBLE},
+			{Name: "country", Type: protomodel.FieldType_STRING},
+		},
+		[]*protomodel.Index{
+			{Fields: []string{"customer"}},
+		},
+	)
+	require.NoError(t, err)
+
+	orders := []struct {
+		customer string
+		amount   int
+		discount float64
+	}{
+		{"bob", 30, 0.5},
+		{"alice", 10, 1.5},
+		{"carol", 20, 2},
+		{"alice", 40, 0},
+		{"bob", 50, 3.5},
+	}
+
+	for _, order := range orders {
+		_, _, err = engine.InsertDocument(ctx, collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"customer": structpb.NewStringValue(order.customer),
+				"amount":   structpb.NewNumberValue(float64(order.amount)),
+				"discount": structpb.NewNumberValue(order.discount),
+				"country":  structpb.NewStringValue("US"),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	query := &protomodel.Query{CollectionName: collectionName}
+
+	t.Run("aggregate without grouping", func(t *testing.T) {
+		testCases := []struct {
+			field    string
+			fn       protomodel.AggregateFunction
+			expected *structpb.Value
+		}{
+			{"amount", protomodel.AggregateFunction_SUM, structpb.NewNumberValue(150)},
+			{"amount", protomodel.AggregateFunction_AVG, structpb.NewNumberValue(30)},
+			{"amount", protomodel.AggregateFunction_MIN, structpb.NewNumberValue(10)},
+			{"amount", protomodel.AggregateFunction_MAX, structpb.NewNumberValue(50)},
+			{"discount", protomodel.AggregateFunction_SUM, structpb.NewNumberValue(7.5)},
+			{"discount", protomodel.AggregateFunction_AVG, structpb.NewNumberValue(1.5)},
+			{"customer", protomodel.AggregateFunction_MAX, structpb.NewStringValue("carol")},
+		}
+
+		for _, tc := range testCases {
+			results, err := engine.AggregateDocuments(ctx, query, tc.field, tc.fn, "")
+			require.NoError(t, err)
+			require.Len(t, results, 1)
+			require.Nil(t, results[0].Group)
+			require.Equal(t, tc.expected.AsInterface(), results[0].Value.AsInterface(), "%s(%s)", tc.fn, tc.field)
+		}
+	})
+
+	t.Run("aggregate with filtering", func(t *testing.T) {
+		results, err := engine.AggregateDocuments(ctx, &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:    "amount",
+							Operator: protomodel.ComparisonOperator_GT,
+							Value:    structpb.NewNumberValue(20),
+						},
+					},
+				},
+			},
+		}, "amount", protomodel.AggregateFunction_SUM, "")
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Equal(t, float64(120), results[0].Value.GetNumberValue())
+	})
+
+	t.Run("aggregate with grouping", func(t *testing.T) {
+		results, err := engine.AggregateDocuments(ctx, query, "amount", protomodel.AggregateFunction_SUM, "customer")
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+
+		expected := map[string]float64{"alice": 50, "bob": 80, "carol": 20}
+
+		for i, res := range results {
+			customer := []string{"alice", "bob", "carol"}[i]
+
+			require.Equal(t, customer, res.Group.GetStringValue())
+			require.Equal(t, expected[customer], res.Value.GetNumberValue())
+		}
+	})
+
+	t.Run("aggregate with grouping by a field without index", func(t *testing.T) {
+		_, err := engine.AggregateDocuments(ctx, query, "amount", protomodel.AggregateFunction_SUM, "country")
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	t.Run("aggregate with invalid arguments", func(t *testing.T) {
+		_, err := engine.AggregateDocuments(ctx, nil, "amount", protomodel.AggregateFunction_SUM, "")
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = engine.AggregateDocuments(ctx, query, "amount", protomodel.AggregateFunction(99), "")
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = engine.AggregateDocuments(ctx, query, "customer", protomodel.AggregateFunction_AVG, "")
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = engine.AggregateDocuments(ctx, query, "unknown", protomodel.AggregateFunction_SUM, "")
+		require.ErrorIs(t, err, ErrFieldDoesNotExist)
+
+		_, err = engine.AggregateDocuments(ctx, query, "amount", protomodel.AggregateFunction_SUM, "unknown")
+		require.ErrorIs(t, err, ErrFieldDoesNotExist)
+
+		_, err = engine.AggregateDocuments(ctx, &protomodel.Query{CollectionName: "unknown"}, "amount", protomodel.AggregateFunction_SUM, "")
+		require.ErrorIs(t, err, ErrCollectionDoesNotExist)
+	})
+
+	t.Run("aggregate over expiring documents", func(t *testing.T) {
+		err := engine.CreateCollectionWithOptions(ctx, "expiring", "", []*protomodel.Field{
+			{Name: "amount", Type: protomodel.FieldType_INTEGER},
+		}, nil, DefaultCollectionOptions().WithTTL(time.Hour))
+		require.NoError(t, err)
+
+		_, err = engine.AggregateDocuments(ctx, &protomodel.Query{CollectionName: "expiring"}, "amount", protomodel.AggregateFunction_SUM, "")
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+}
+
+func TestAlterCollection(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	collectionName := "people"
+
+	err := engine.CreateCollection(
+		ctx,
+		collectionName,
+		"",
+		[]*protomodel.Field{
+			{Name: "name", Type: protomodel.FieldType_STRING},
+		},
+		nil,
+	)
+	require.NoError(t, err)
+
+	people := []*structpb.Struct{
+		{
+			Fields: map[string]*structpb.Value{
+				"name": structpb.NewStringValue("alice"),
+				"age":  structpb.NewNumberValue(35),
+			},
+		},
+		{
+			Fields: map[string]*structpb.Value{
+				"name": structpb.NewStringValue("bob"),
+				"age":  structpb.NewNumberValue(25),
+			},
+		},
+		{
+			Fields: map[string]*structpb.Value{
+				"name": structpb.NewStringValue("carol"),
+				"nick": structpb.NewStringValue("caz"),
+			},
+		},
+	}
+
+	_, _, err = engine.InsertDocuments(ctx, collectionName, people)
+	require.NoError(t, err)
+
+	readNames := func(t *testing.T, query *protomodel.Query) []string {
+		reader, err := engine.GetDocuments(ctx, query, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		revisions, err := reader.ReadN(ctx, 10)
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+
+		names := make([]string, len(revisions))
+		for i, rev := range revisions {
+			names[i] = rev.Document.Fields["name"].GetStringValue()
+		}
+
+		return names
+	}
+
+	t.Run("alter collection with invalid arguments", func(t *testing.T) {
+		err := engine.AlterCollection(ctx, collectionName, nil, nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = engine.AlterCollection(ctx, "unknown", []*protomodel.Field{{Name: "age", Type: protomodel.FieldType_INTEGER}}, nil)
+		require.ErrorIs(t, err, ErrCollectionDoesNotExist)
+
+		err = engine.AlterCollection(ctx, collectionName, []*protomodel.Field{{Name: "name", Type: protomodel.FieldType_STRING}}, nil)
+		require.ErrorIs(t, err, ErrFieldAlreadyExists)
+
+		err = engine.AlterCollection(ctx, collectionName, []*protomodel.Field{{Name: DefaultDocumentIDField, Type: protomodel.FieldType_STRING}}, nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = engine.AlterCollection(ctx, collectionName, nil, []*protomodel.Index{{Fields: []string{DefaultDocumentIDField}, IsUnique: true}})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = engine.AlterCollection(ctx, collectionName, nil, []*protomodel.Index{{}})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = engine.AlterCollection(ctx, collectionName, nil, []*protomodel.Index{{Fields: []string{"unknown"}}})
+		require.ErrorIs(t, err, ErrFieldDoesNotExist)
+	})
+
+	t.Run("alter collection with a field not matching existing documents", func(t *testing.T) {
+		err := engine.AlterCollection(ctx, collectionName, []*protomodel.Field{{Name: "age", Type: protomodel.FieldType_STRING}}, nil)
+		require.ErrorIs(t, err, ErrUnexpectedValue)
+
+		collection, err := engine.GetCollection(ctx, collectionName)
+		require.NoError(t, err)
+		require.Len(t, collection.Fields, 2)
+	})
+
+	t.Run("alter collection adding an indexed field", func(t *testing.T) {
+		err := engine.AlterCollection(
+			ctx,
+			collectionName,
+			[]*protomodel.Field{{Name: "age", Type: protomodel.FieldType_INTEGER}},
+			[]*protomodel.Index{{Fields: []string{"age"}}},
+		)
+		require.NoError(t, err)
+
+		collection, err := engine.GetCollection(ctx, collectionName)
+		require.NoError(t, err)
+		require.Len(t, collection.Fields, 3)
+		require.Equal(t, "age", collection.Fields[2].Name)
+		require.Len(t, collection.Indexes, 2)
+
+		require.Equal(t, []string{"alice", "bob", "carol"}, readNames(t, &protomodel.Query{CollectionName: collectionName}))
+
+		require.Equal(t, []string{"alice"}, readNames(t, &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{Field: "age", Operator: protomodel.ComparisonOperator_GT, Value: structpb.NewNumberValue(30)},
+					},
+				},
+			},
+		}))
+
+		_, _, err = engine.InsertDocument(ctx, collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"name": structpb.NewStringValue("dave"),
+				"age":  structpb.NewNumberValue(30),
+			},
+		})
+		require.NoError(t, err)
+
+		// missing values are sorted first when served by the index
+		require.Equal(t, []string{"carol", "bob", "dave", "alice"}, readNames(t, &protomodel.Query{
+			CollectionName: collectionName,
+			OrderBy:        []*protomodel.OrderByClause{{Field: "age"}},
+		}))
+	})
+
+	t.Run("alter collection after renaming the id field", func(t *testing.T) {
+		err := engine.UpdateCollection(ctx, collectionName, "pid")
+		require.NoError(t, err)
+
+		err = engine.AlterCollection(ctx, collectionName, []*protomodel.Field{{Name: "nick", Type: protomodel.FieldType_STRING}}, nil)
+		require.NoError(t, err)
+
+		reader, err := engine.GetDocuments(ctx, &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{Field: "nick", Operator: protomodel.ComparisonOperator_EQ, Value: structpb.NewStringValue("caz")},
+					},
+				},
+			},
+		}, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		rev, err := reader.Read(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "carol", rev.Document.Fields["name"].GetStringValue())
+		require.Contains(t, rev.Document.Fields, "pid")
+
+		_, err = reader.Read(ctx)
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+	})
+}
+
+func TestQueryDocumentsWithInOperator(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	collectionName := "mycollection"
+
+	err := engine.CreateCollection(
+		ctx,
+		collectionName,
+		"",
+		[]*protomodel.Field{
+			{Name: "country", Type: protomodel.FieldType_STRING},
+		},
+		nil,
+	)
+	require.NoError(t, err)
+
+	countries := []string{"ar", "br", "cl", "uy", "py"}
+
+	docs := make([]*structpb.Struct, len(countries))
+	for i, country := range countries {
+		docs[i] = &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"country": structpb.NewStringValue(country),
+			},
+		}
+	}
+
+	_, docIDs, err := engine.InsertDocuments(ctx, collectionName, docs)
+	require.NoError(t, err)
+
+	readCountries := func(t *testing.T, field string, op protomodel.ComparisonOperator, values ...*structpb.Value) []string {
+		reader, err := engine.GetDocuments(ctx, &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:    field,
+							Operator: op,
+							Value:    structpb.NewListValue(&structpb.ListValue{Values: values}),
+						},
+					},
+				},
+			},
+		}, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		revisions, err := reader.ReadN(ctx, 10)
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+
+		countries := make([]string, len(revisions))
+		for i, rev := range revisions {
+			countries[i] = rev.Document.Fields["country"].GetStringValue()
+		}
+
+		return countries
+	}
+
+	t.Run("query documents by a list of ids", func(t *testing.T) {
+		countries := readCountries(t,
+			DefaultDocumentIDField,
+			protomodel.ComparisonOperator_IN,
+			structpb.NewStringValue(docIDs[3].EncodeToHexString()),
+			structpb.NewStringValue(docIDs[1].EncodeToHexString()),
+		)
+		require.Equal(t, []string{"br", "uy"}, countries)
+	})
+
+	t.Run("query documents not in a list of values", func(t *testing.T) {
+		countries := readCountries(t,
+			"country",
+			protomodel.ComparisonOperator_NOT_IN,
+			structpb.NewStringValue("ar"),
+			structpb.NewStringValue("py"),
+		)
+		require.Equal(t, []string{"br", "cl", "uy"}, countries)
+	})
+
+	t.Run("query documents with an empty list", func(t *testing.T) {
+		require.Empty(t, readCountries(t, DefaultDocumentIDField, protomodel.ComparisonOperator_IN))
+		require.Len(t, readCountries(t, "country", protomodel.ComparisonOperator_NOT_IN), len(countries))
+	})
+}
+
+func TestQueryDocumentsByPrefix(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	collectionName := "people"
+
+	err := engine.CreateCollection(
+		ctx,
+		collectionName,
+		"",
+		[]*protomodel.Field{
+			{Name: "name", Type: protomodel.FieldType_STRING},
+		},
+		[]*protomodel.Index{
+			{Fields: []string{"name"}},
+		},
+	)
+	require.NoError(t, err)
+
+	for _, name := range []string{"Jorge", "Ann", "john", "Joanna", "Jp", "Jo", "Bob"} {
+		_, _, err = engine.InsertDocument(ctx, collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"name": structpb.NewStringValue(name),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	readNames := func(t *testing.T, prefix string, caseInsensitive bool) []string {
+		reader, err := engine.GetDocuments(ctx, &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:           "name",
+							Operator:        protomodel.ComparisonOperator_STARTS_WITH,
+							Value:           structpb.NewStringValue(prefix),
+							CaseInsensitive: caseInsensitive,
+						},
+					},
+				},
+			},
+			OrderBy: []*protomodel.OrderByClause{{Field: "name"}},
+		}, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		revisions, err := reader.ReadN(ctx, 10)
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+
+		names := make([]string, len(revisions))
+		for i, rev := range revisions {
+			names[i] = rev.Document.Fields["name"].GetStringValue()
+		}
+
+		return names
+	}
+
+	require.Equal(t, []string{"Jo", "Joanna", "Jorge"}, readNames(t, "Jo", false))
+	require.Equal(t, []string{"Jo", "Joanna", "Jorge", "john"}, readNames(t, "jo", true))
+	require.Empty(t, readNames(t, "Jon", false))
+	require.Len(t, readNames(t, "", false), 7)
+}
+
+func TestCollectionIDGenerators(t *testing.T) {
+	dir := t.TempDir()
+
+	st, err := store.Open(dir, store.DefaultOptions())
+	require.NoError(t, err)
+
+	engine, err := NewEngine(st, DefaultOptions())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	err = engine.CreateCollectionWithOptions(ctx, "invalid", "", nil, nil, DefaultCollectionOptions().WithIDGenerator(protomodel.DocumentIdGenerator(10)))
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = engine.CreateCollectionWithOptions(ctx, "orders", "number", nil, nil, DefaultCollectionOptions().WithIDGenerator(protomodel.DocumentIdGenerator_SEQUENCE))
+	require.NoError(t, err)
+
+	err = engine.CreateCollectionWithOptions(ctx, "tokens", "", nil, nil, DefaultCollectionOptions().WithIDGenerator(protomodel.DocumentIdGenerator_UUID))
+	require.NoError(t, err)
+
+	err = engine.CreateCollection(ctx, "events", "", nil, nil)
+	require.NoError(t, err)
+
+	for name, idGenerator := range map[string]protomodel.DocumentIdGenerator{
+		"orders": protomodel.DocumentIdGenerator_SEQUENCE,
+		"tokens": protomodel.DocumentIdGenerator_UUID,
+		"events": protomodel.DocumentIdGenerator_OBJECT_ID,
+	} {
+		collection, err := engine.GetCollection(ctx, name)
+		require.NoError(t, err)
+		require.Equal(t, idGenerator, collection.DocumentIdGenerator)
+	}
+
+	t.Run("sequential ids", func(t *testing.T) {
+		_, docIDs, err := engine.InsertDocuments(ctx, "orders", []*structpb.Struct{{}, {}, {}})
+		require.NoError(t, err)
+		require.Equal(t, []DocumentID{NewSequenceDocumentID(1), NewSequenceDocumentID(2), NewSequenceDocumentID(3)}, docIDs)
+
+		_, docIDs, err = engine.InsertDocuments(ctx, "orders", []*structpb.Struct{{}})
+		require.NoError(t, err)
+		require.Equal(t, []DocumentID{NewSequenceDocumentID(4)}, docIDs)
+
+		// ids are also assigned to documents inserted when upserting
+		revisions, err := engine.UpsertDocuments(ctx, &protomodel.Query{
+			CollectionName: "orders",
+			Expressions: []*protomodel.QueryExpression{{
+				FieldComparisons: []*protomodel.FieldComparison{{
+					Field:    "number",
+					Operator: protomodel.ComparisonOperator_EQ,
+					Value:    structpb.NewStringValue(NewSequenceDocumentID(100).EncodeToHexString()),
+				}},
+			}},
+		}, &structpb.Struct{})
+		require.NoError(t, err)
+		require.Len(t, revisions, 1)
+		require.Equal(t, NewSequenceDocumentID(5).EncodeToHexString(), revisions[0].DocumentId)
+
+		// sequential ids are only assigned by the engine
+		_, err = engine.UpsertDocuments(ctx, &protomodel.Query{CollectionName: "orders"}, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"number": structpb.NewStringValue(NewSequenceDocumentID(100).EncodeToHexString()),
+			},
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		reader, err := engine.GetDocuments(ctx, &protomodel.Query{CollectionName: "orders"}, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		docs, err := reader.ReadN(ctx, 10)
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+		require.Len(t, docs, 5)
+
+		for i, doc := range docs {
+			require.Equal(t, NewSequenceDocumentID(uint64(i+1)).EncodeToHexString(), doc.Document.Fields["number"].GetStringValue())
+		}
+	})
+
+	t.Run("concurrent sequential ids", func(t *testing.T) {
+		const workers = 10
+
+		var wg sync.WaitGroup
+		errs := make(chan error, workers)
+
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				_, _, err := engine.InsertDocuments(ctx, "orders", []*structpb.Struct{{}, {}})
+				errs <- err
+			}()
+		}
+
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			require.NoError(t, err)
+		}
+
+		count, err := engine.CountDocuments(ctx, &protomodel.Query{CollectionName: "orders"}, 0)
+		require.NoError(t, err)
+		require.Equal(t, int64(5+2*workers), count)
+	})
+
+	t.Run("uuid ids", func(t *testing.T) {
+		_, docIDs, err := engine.InsertDocuments(ctx, "tokens", []*structpb.Struct{{}, {}})
+		require.NoError(t, err)
+		require.Len(t, docIDs, 2)
+		require.NotEqual(t, docIDs[0], docIDs[1])
+
+		for _, docID := range docIDs {
+			require.Len(t, docID, 16)
+			require.Equal(t, byte(0x40), docID[6]&0xf0)
+			require.Equal(t, byte(0x80), docID[8]&0xc0)
+		}
+	})
+
+	// ids of deleted documents are not assigned again
+	_, err = engine.DeleteDocuments(ctx, &protomodel.Query{CollectionName: "orders"})
+	require.NoError(t, err)
+
+	err = st.Close()
+	require.NoError(t, err)
+
+	st, err = store.Open(dir, store.DefaultOptions())
+	require.NoError(t, err)
+	defer st.Close()
+
+	engine, err = NewEngine(st, DefaultOptions())
+	require.NoError(t, err)
+
+	_, docIDs, err := engine.InsertDocuments(ctx, "orders", []*structpb.Struct{{}})
+	require.NoError(t, err)
+	require.Equal(t, []DocumentID{NewSequenceDocumentID(5 + 2*10 + 1)}, docIDs)
+
+	err = engine.DeleteCollection(ctx, "orders")
+	require.NoError(t, err)
+
+	// a new collection starts a new sequence
+	err = engine.CreateCollectionWithOptions(ctx, "orders", "number", nil, nil, DefaultCollectionOptions().WithIDGenerator(protomodel.DocumentIdGenerator_SEQUENCE))
+	require.NoError(t, err)
+
+	_, docIDs, err = engine.InsertDocuments(ctx, "orders", []*structpb.Struct{{}})
+	require.NoError(t, err)
+	require.Equal(t, []DocumentID{NewSequenceDocumentID(1)}, docIDs)
+}
+
+func TestGetDocumentByKey(t *testing.T) {
+	var now time.Time
+
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithTimeFunc(func() time.Time { return now }))
+	require.NoError(t, err)
+	defer st.Close()
+
+	engine, err := NewEngine(st, DefaultOptions())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	now = time.Now()
+
+	err = engine.CreateCollection(ctx, "users", "", []*protomodel.Field{{Name: "name", Type: protomodel.FieldType_STRING}}, nil)
+	require.NoError(t, err)
+
+	txID, docID, err := engine.InsertDocument(ctx, "users", &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"name": structpb.NewStringValue("alice"),
+		},
+	})
+	require.NoError(t, err)
+
+	key := []*structpb.Value{structpb.NewStringValue(docID.EncodeToHexString())}
+
+	t.Run("invalid lookups should fail", func(t *testing.T) {
+		_, err := engine.GetDocumentByKey(ctx, "", key)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = engine.GetDocumentByKey(ctx, "unknown", key)
+		require.ErrorIs(t, err, ErrCollectionDoesNotExist)
+
+		_, err = engine.GetDocumentByKey(ctx, "users", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = engine.GetDocumentByKey(ctx, "users", append(key, structpb.NewStringValue("alice")))
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = engine.GetDocumentByKey(ctx, "users", []*structpb.Value{structpb.NewNumberValue(1)})
+		require.ErrorIs(t, err, ErrUnexpectedValue)
+
+		_, err = engine.GetDocumentByKey(ctx, "users", []*structpb.Value{structpb.NewStringValue("not-hex")})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	t.Run("unknown documents should not be found", func(t *testing.T) {
+		_, err := engine.GetDocumentByKey(ctx, "users", []*structpb.Value{
+			structpb.NewStringValue(NewDocumentIDFromTx(0).EncodeToHexString()),
+		})
+		require.ErrorIs(t, err, ErrDocumentNotFound)
+	})
+
+	t.Run("the current revision of the document should be returned", func(t *testing.T) {
+		rev, err := engine.GetDocumentByKey(ctx, "users", key)
+		require.NoError(t, err)
+		require.Equal(t, txID, rev.TransactionId)
+		require.Equal(t, docID.EncodeToHexString(), rev.DocumentId)
+		require.EqualValues(t, 1, rev.Revision)
+		require.Equal(t, "alice", rev.Document.Fields["name"].GetStringValue())
+		require.Equal(t, docID.EncodeToHexString(), rev.Document.Fields[DefaultDocumentIDField].GetStringValue())
+
+		query := &protomodel.Query{
+			CollectionName: "users",
+			Expressions: []*protomodel.QueryExpression{{
+				FieldComparisons: []*protomodel.FieldComparison{{
+					Field:    DefaultDocumentIDField,
+					Operator: protomodel.ComparisonOperator_EQ,
+					Value:    key[0],
+				}},
+			}},
+		}
+
+		revisions, err := engine.ReplaceDocuments(ctx, query, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"name": structpb.NewStringValue("alicia"),
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, revisions, 1)
+
+		rev, err = engine.GetDocumentByKey(ctx, "users", key)
+		require.NoError(t, err)
+		require.Equal(t, revisions[0].TransactionId, rev.TransactionId)
+		require.EqualValues(t, 2, rev.Revision)
+		require.Equal(t, "alicia", rev.Document.Fields["name"].GetStringValue())
+
+		_, err = engine.DeleteDocuments(ctx, query)
+		require.NoError(t, err)
+
+		_, err = engine.GetDocumentByKey(ctx, "users", key)
+		require.ErrorIs(t, err, ErrDocumentNotFound)
+	})
+
+	t.Run("expired documents should not be found", func(t *testing.T) {
+		err := engine.CreateCollectionWithOptions(ctx, "sessions", "", nil, nil, DefaultCollectionOptions().WithTTL(time.Hour))
+		require.NoError(t, err)
+
+		now = time.Now().Add(-2 * time.Hour)
+
+		_, expiredID, err := engine.InsertDocument(ctx, "sessions", &structpb.Struct{})
+		require.NoError(t, err)
+
+		now = time.Now()
+
+		_, liveID, err := engine.InsertDocument(ctx, "sessions", &structpb.Struct{})
+		require.NoError(t, err)
+
+		_, err = engine.GetDocumentByKey(ctx, "sessions", []*structpb.Value{structpb.NewStringValue(expiredID.EncodeToHexString())})
+		require.ErrorIs(t, err, ErrDocumentNotFound)
+
+		rev, err := engine.GetDocumentByKey(ctx, "sessions", []*structpb.Value{structpb.NewStringValue(liveID.EncodeToHexString())})
+		require.NoError(t, err)
+		require.Equal(t, liveID.EncodeToHexString(), rev.DocumentId)
+	})
+}
+
+func TestGetDocumentHistory(t *testing.T) {
+	var now time.Time
+
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithTimeFunc(func() time.Time { return now }))
+	require.NoError(t, err)
+	defer st.Close()
+
+	engine, err := NewEngine(st, DefaultOptions())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	err = engine.CreateCollection(ctx, "accounts", "", []*protomodel.Field{{Name: "balance", Type: protomodel.FieldType_INTEGER}}, nil)
+	require.NoError(t, err)
+
+	start := time.Now().Truncate(time.Second)
+	now = start
+
+	_, docID, err := engine.InsertDocument(ctx, "accounts", &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"balance": structpb.NewNumberValue(0),
+		},
+	})
+	require.NoError(t, err)
+
+	key := []*structpb.Value{structpb.NewStringValue(docID.EncodeToHexString())}
+
+	query := &protomodel.Query{
+		CollectionName: "accounts",
+		Expressions: []*protomodel.QueryExpression{{
+			FieldComparisons: []*protomodel.FieldComparison{{
+				Field:    DefaultDocumentIDField,
+				Operator: protomodel.ComparisonOperator_EQ,
+				Value:    key[0],
+			}},
+		}},
+	}
+
+	for i := 1; i <= 3; i++ {
+		now = start.Add(time.Duration(i) * time.Minute)
+
+		_, err = engine.ReplaceDocuments(ctx, query, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"balance": structpb.NewNumberValue(float64(i * 10)),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	now = start.Add(time.Hour)
+
+	_, err = engine.DeleteDocuments(ctx, query)
+	require.NoError(t, err)
+
+	t.Run("invalid requests should fail", func(t *testing.T) {
+		_, err := engine.GetDocumentHistory(ctx, "", key, 10, 0)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = engine.GetDocumentHistory(ctx, "unknown", key, 10, 0)
+		require.ErrorIs(t, err, ErrCollectionDoesNotExist)
+
+		_, err = engine.GetDocumentHistory(ctx, "accounts", nil, 10, 0)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = engine.GetDocumentHistory(ctx, "accounts", key, 0, 0)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = engine.GetDocumentHistory(ctx, "accounts", []*structpb.Value{
+			structpb.NewStringValue(NewDocumentIDFromTx(0).EncodeToHexString()),
+		}, 10, 0)
+		require.ErrorIs(t, err, ErrDocumentNotFound)
+	})
+
+	t.Run("revisions should be returned newest first", func(t *testing.T) {
+		revisions, err := engine.GetDocumentHistory(ctx, "accounts", key, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, revisions, 5)
+
+		require.EqualValues(t, 5, revisions[0].Revision)
+		require.True(t, revisions[0].Metadata.Deleted)
+		require.Nil(t, revisions[0].Document)
+		require.Equal(t, start.Add(time.Hour).Unix(), revisions[0].Timestamp)
+
+		for i, rev := range revisions[1:] {
+			require.EqualValues(t, 4-i, rev.Revision)
+			require.Equal(t, docID.EncodeToHexString(), rev.DocumentId)
+			require.Equal(t, float64((3-i)*10), rev.Document.Fields["balance"].GetNumberValue())
+			require.Equal(t, start.Add(time.Duration(3-i)*time.Minute).Unix(), rev.Timestamp)
+			require.Greater(t, revisions[i].TransactionId, rev.TransactionId)
+		}
+	})
+
+	t.Run("revisions should be paginated", func(t *testing.T) {
+		revisions, err := engine.GetDocumentHistory(ctx, "accounts", key, 2, 2)
+		require.NoError(t, err)
+		require.Len(t, revisions, 2)
+		require.EqualValues(t, 3, revisions[0].Revision)
+		require.EqualValues(t, 2, revisions[1].Revision)
+	})
+}
+
+func TestDocumentLimits(t *testing.T) {
+	st, err := store.Open(t.TempDir(), store.DefaultOptions())
+	require.NoError(t, err)
+	defer st.Close()
+
+	engine, err := NewEngine(st, DefaultOptions().WithMaxDocumentSize(256).WithMaxDocumentFields(8))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	err = engine.CreateCollectionWithOptions(ctx, "invalid", "", nil, nil, DefaultCollectionOptions().WithMaxDocumentSize(-1))
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = engine.CreateCollectionWithOptions(ctx, "invalid", "", nil, nil, DefaultCollectionOptions().WithMaxDocumentFields(-1))
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = engine.CreateCollection(ctx, "unrestricted", "", nil, nil)
+	require.NoError(t, err)
+
+	err = engine.CreateCollectionWithOptions(ctx, "restricted", "", nil, nil, DefaultCollectionOptions().
+		WithMaxDocumentSize(128).
+		WithMaxDocumentFields(1000),
+	)
+	require.NoError(t, err)
+
+	collection, err := engine.GetCollection(ctx, "restricted")
+	require.NoError(t, err)
+	require.EqualValues(t, 128, collection.MaxDocumentSize)
+	require.EqualValues(t, 1000, collection.MaxDocumentFields)
+
+	collection, err = engine.GetCollection(ctx, "unrestricted")
+	require.NoError(t, err)
+	require.Zero(t, collection.MaxDocumentSize)
+	require.Zero(t, collection.MaxDocumentFields)
+
+	docWithText := func(size int) *structpb.Struct {
+		return &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"text": structpb.NewStringValue(strings.Repeat("a", size)),
+			},
+		}
+	}
+
+	t.Run("engine limits should apply to every collection", func(t *testing.T) {
+		_, _, err := engine.InsertDocument(ctx, "unrestricted", docWithText(100))
+		require.NoError(t, err)
+
+		_, _, err = engine.InsertDocument(ctx, "unrestricted", docWithText(300))
+		require.ErrorIs(t, err, ErrMaxDocumentSizeExceeded)
+
+		nested := &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"address": structpb.NewStructValue(&structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						"street": structpb.NewStringValue("main"),
+						"city":   structpb.NewStringValue("metropolis"),
+					},
+				}),
+				"tags": structpb.NewListValue(&structpb.ListValue{
+					Values: []*structpb.Value{
+						structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"a": structpb.NewNumberValue(1)}}),
+						structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"b": structpb.NewNumberValue(2)}}),
+					},
+				}),
+			},
+		}
+
+		// address, street, city, tags, a and b, the id field is added when inserting the document
+		require.Equal(t, 6, countDocumentFields(nested))
+
+		_, _, err = engine.InsertDocument(ctx, "unrestricted", nested)
+		require.NoError(t, err)
+
+		nested.Fields["x"] = structpb.NewBoolValue(true)
+		nested.Fields["y"] = structpb.NewBoolValue(true)
+		delete(nested.Fields, DefaultDocumentIDField)
+
+		_, _, err = engine.InsertDocument(ctx, "unrestricted", nested)
+		require.ErrorIs(t, err, ErrMaxFieldsExceeded)
+	})
+
+	t.Run("collection limits should only apply when lower", func(t *testing.T) {
+		_, _, err := engine.InsertDocument(ctx, "restricted", docWithText(50))
+		require.NoError(t, err)
+
+		_, _, err = engine.InsertDocument(ctx, "restricted", docWithText(200))
+		require.ErrorIs(t, err, ErrMaxDocumentSizeExceeded)
+
+		fields := make(map[string]*structpb.Value)
+		for i := 0; i < 10; i++ {
+			fields[fmt.Sprintf("f%d", i)] = structpb.NewBoolValue(true)
+		}
+
+		_, _, err = engine.InsertDocument(ctx, "restricted", &structpb.Struct{Fields: fields})
+		require.ErrorIs(t, err, ErrMaxFieldsExceeded)
+	})
+
+	t.Run("limits should be enforced on replacements", func(t *testing.T) {
+		query := &protomodel.Query{CollectionName: "restricted"}
+
+		_, err := engine.ReplaceDocuments(ctx, query, docWithText(200))
+		require.ErrorIs(t, err, ErrMaxDocumentSizeExceeded)
+
+		reader, err := engine.GetDocuments(ctx, query, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		revisions, err := reader.ReadN(ctx, 10)
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+		require.Len(t, revisions, 1)
+		require.Len(t, revisions[0].Document.Fields["text"].GetStringValue(), 50)
+	})
+
+	t.Run("limits should be removed along with the collection", func(t *testing.T) {
+		err := engine.DeleteCollection(ctx, "restricted")
+		require.NoError(t, err)
+
+		err = engine.CreateCollection(ctx, "restricted", "", nil, nil)
+		require.NoError(t, err)
+
+		collection, err := engine.GetCollection(ctx, "restricted")
+		require.NoError(t, err)
+		require.Zero(t, collection.MaxDocumentSize)
+
+		_, _, err = engine.InsertDocument(ctx, "restricted", docWithText(150))
+		require.NoError(t, err)
+	})
+}
+
+func TestBooleanAndTimestampFields(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	err := engine.CreateCollection(
+		ctx,
+		"readings",
+		"",
+		[]*protomodel.Field{
+			{Name: "sensor", Type: protomodel.FieldType_STRING},
+			{Name: "valid", Type: protomodel.FieldType_BOOLEAN},
+			{Name: "at", Type: protomodel.FieldType_TIMESTAMP},
+			{Name: "calibrated_at", Type: protomodel.FieldType_TIMESTAMP},
+		},
+		[]*protomodel.Index{
+			{Fields: []string{"at"}},
+		},
+	)
+	require.NoError(t, err)
+
+	collection, err := engine.GetCollection(ctx, "readings")
+	require.NoError(t, err)
+	require.Equal(t, protomodel.FieldType_BOOLEAN, collection.Fields[2].Type)
+	require.Equal(t, protomodel.FieldType_TIMESTAMP, collection.Fields[3].Type)
+	require.Equal(t, protomodel.FieldType_TIMESTAMP, collection.Fields[4].Type)
+
+	readings := []struct {
+		sensor       string
+		valid        bool
+		at           string
+		calibratedAt string
+	}{
+		{"s1", true, "2023-08-01T10:00:00Z", "2023-07-01T00:00:00.5Z"},
+		{"s2", false, "2023-08-01T12:30:00+02:00", "2023-07-01T00:00:00Z"},
+		{"s3", true, "2023-08-01T11:00:00.250Z", "2023-06-30T23:00:00-02:00"},
+		{"s4", true, "2023-08-02T00:00:00Z", "2023-06-01T00:00:00Z"},
+	}
+
+	for _, r := range readings {
+		_, _, err := engine.InsertDocument(ctx, "readings", &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"sensor":        structpb.NewStringValue(r.sensor),
+				"valid":         structpb.NewBoolValue(r.valid),
+				"at":            structpb.NewStringValue(r.at),
+				"calibrated_at": structpb.NewStringValue(r.calibratedAt),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	readSensors := func(query *protomodel.Query) []string {
+		reader, err := engine.GetDocuments(ctx, query, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		revisions, err := reader.ReadN(ctx, 10)
+		require.ErrorIs(t, err, ErrNoMoreDocuments)
+
+		sensors := make([]string, len(revisions))
+		for i, rev := range revisions {
+			sensors[i] = rev.Document.Fields["sensor"].GetStringValue()
+		}
+
+		return sensors
+	}
+
+	t.Run("values not formatted as RFC 3339 should be rejected", func(t *testing.T) {
+		_, _, err := engine.InsertDocument(ctx, "readings", &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"at": structpb.NewStringValue("01/08/2023"),
+			},
+		})
+		require.ErrorIs(t, err, ErrUnexpectedValue)
+
+		_, _, err = engine.InsertDocument(ctx, "readings", &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"at": structpb.NewNumberValue(1690884000),
+			},
+		})
+		require.ErrorIs(t, err, ErrUnexpectedValue)
+	})
+
+	t.Run("query by timestamp range", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: "readings",
+			Expressions: []*protomodel.QueryExpression{{
+				FieldComparisons: []*protomodel.FieldComparison{
+					{
+						Field:    "at",
+						Operator: protomodel.ComparisonOperator_GE,
+						Value:    structpb.NewStringValue("2023-08-01T12:00:00+02:00"),
+					},
+					{
+						Field:    "at",
+						Operator: protomodel.ComparisonOperator_LT,
+						Value:    structpb.NewStringValue("2023-08-02T00:00:00Z"),
+					},
+				},
+			}},
+			OrderBy: []*protomodel.OrderByClause{{Field: "at"}},
+		}
+
+		// s2 was written with a different offset but it's the same instant as the lower bound
+		require.Equal(t, []string{"s1", "s2", "s3"}, readSensors(query))
+	})
+
+	t.Run("query by boolean and timestamp fields", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: "readings",
+			Expressions: []*protomodel.QueryExpression{{
+				FieldComparisons: []*protomodel.FieldComparison{
+					{
+						Field:    "valid",
+						Operator: protomodel.ComparisonOperator_EQ,
+						Value:    structpb.NewBoolValue(true),
+					},
+					{
+						Field:    "at",
+						Operator: protomodel.ComparisonOperator_GT,
+						Value:    structpb.NewStringValue("2023-08-01T10:00:00Z"),
+					},
+				},
+			}},
+			OrderBy: []*protomodel.OrderByClause{{Field: "at", Desc: true}},
+		}
+
+		require.Equal(t, []string{"s4", "s3"}, readSensors(query))
+	})
+
+	t.Run("timestamps should be sorted as instants when not indexed", func(t *testing.T) {
+		query := &protomodel.Query{
+			CollectionName: "readings",
+			OrderBy:        []*protomodel.OrderByClause{{Field: "calibrated_at"}},
+		}
+
+		require.Equal(t, []string{"s4", "s2", "s1", "s3"}, readSensors(query))
+	})
+
+	t.Run("aggregate timestamps", func(t *testing.T) {
+		results, err := engine.AggregateDocuments(ctx, &protomodel.Query{CollectionName: "readings"}, "at", protomodel.AggregateFunction_MIN, "")
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Equal(t, "2023-08-01T10:00:00Z", results[0].Value.GetStringValue())
+	})
+}
+
+func TestGetDocumentsByKeys(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	err := engine.CreateCollection(ctx, "products", "", []*protomodel.Field{{Name: "name", Type: protomodel.FieldType_STRING}}, nil)
+	require.NoError(t, err)
+
+	_, docIDs, err := engine.InsertDocuments(ctx, "products", []*structpb.Struct{
+		{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("chair")}},
+		{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("desk")}},
+		{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("lamp")}},
+	})
+	require.NoError(t, err)
+
+	keyOf := func(docID DocumentID) []*structpb.Value {
+		return []*structpb.Value{structpb.NewStringValue(docID.EncodeToHexString())}
+	}
+
+	t.Run("invalid keys should fail", func(t *testing.T) {
+		_, err := engine.GetDocumentsByKeys(ctx, "unknown", [][]*structpb.Value{keyOf(docIDs[0])})
+		require.ErrorIs(t, err, ErrCollectionDoesNotExist)
+
+		_, err = engine.GetDocumentsByKeys(ctx, "products", [][]*structpb.Value{keyOf(docIDs[0]), nil})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = engine.GetDocumentsByKeys(ctx, "products", [][]*structpb.Value{{structpb.NewBoolValue(true)}})
+		require.ErrorIs(t, err, ErrUnexpectedValue)
+	})
+
+	t.Run("no keys should return no documents", func(t *testing.T) {
+		revisions, err := engine.GetDocumentsByKeys(ctx, "products", nil)
+		require.NoError(t, err)
+		require.Empty(t, revisions)
+	})
+
+	t.Run("documents should be returned in request order", func(t *testing.T) {
+		missingID := NewDocumentIDFromTx(0)
+
+		revisions, err := engine.GetDocumentsByKeys(ctx, "products", [][]*structpb.Value{
+			keyOf(docIDs[2]),
+			keyOf(missingID),
+			keyOf(docIDs[0]),
+			keyOf(docIDs[2]),
+		})
+		require.NoError(t, err)
+		require.Len(t, revisions, 4)
+
+		require.Equal(t, "lamp", revisions[0].Document.Fields["name"].GetStringValue())
+		require.Nil(t, revisions[1])
+		require.Equal(t, "chair", revisions[2].Document.Fields["name"].GetStringValue())
+		require.Equal(t, docIDs[0].EncodeToHexString(), revisions[2].DocumentId)
+		require.Equal(t, revisions[0], revisions[3])
+	})
+
+	t.Run("deleted documents should not be found", func(t *testing.T) {
+		_, err := engine.DeleteDocuments(ctx, &protomodel.Query{
+			CollectionName: "products",
+			Expressions: []*protomodel.QueryExpression{{
+				FieldComparisons: []*protomodel.FieldComparison{{
+					Field:    "name",
+					Operator: protomodel.ComparisonOperator_EQ,
+					Value:    structpb.NewStringValue("desk"),
+				}},
+			}},
+		})
+		require.NoError(t, err)
+
+		revisions, err := engine.GetDocumentsByKeys(ctx, "products", [][]*structpb.Value{keyOf(docIDs[1]), keyOf(docIDs[0])})
+		require.NoError(t, err)
+		require.Nil(t, revisions[0])
+		require.NotNil(t, revisions[1])
+	})
+}
+
+func TestCollectionStats(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	_, err := engine.CollectionStats(ctx, "unknown")
+	require.ErrorIs(t, err, ErrCollectionDoesNotExist)
+
+	err = engine.CreateCollection(ctx, "products", "", []*protomodel.Field{
+		{Name: "name", Type: protomodel.FieldType_STRING},
+		{Name: "price", Type: protomodel.FieldType_DOUBLE},
+	}, []*protomodel.Index{
+		{Fields: []string{"name"}, IsUnique: true},
+	})
+	require.NoError(t, err)
+
+	stats, err := engine.CollectionStats(ctx, "products")
+	require.NoError(t, err)
+	require.Zero(t, stats.DocumentCount)
+	require.Equal(t, 2, stats.IndexCount)
+	require.Zero(t, stats.Size)
+
+	_, _, err = engine.InsertDocuments(ctx, "products", []*structpb.Struct{
+		{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("chair"), "price": structpb.NewNumberValue(50)}},
+		{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("desk"), "price": structpb.NewNumberValue(150)}},
+	})
+	require.NoError(t, err)
+
+	stats, err = engine.CollectionStats(ctx, "products")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), stats.DocumentCount)
+	require.Equal(t, 2, stats.IndexCount)
+	require.Positive(t, stats.Size)
+
+	sizeWithTwoDocuments := stats.Size
+
+	err = engine.CreateIndex(ctx, "products", []string{"price"}, false)
+	require.NoError(t, err)
+
+	stats, err = engine.CollectionStats(ctx, "products")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), stats.DocumentCount)
+	require.Equal(t, 3, stats.IndexCount)
+	require.Greater(t, stats.Size, sizeWithTwoDocuments)
+
+	sizeWithNewIndex := stats.Size
+
+	count, err := engine.DeleteDocuments(ctx, &protomodel.Query{
+		CollectionName: "products",
+		Expressions: []*protomodel.QueryExpression{{
+			FieldComparisons: []*protomodel.FieldComparison{
+				{Field: "name", Operator: protomodel.ComparisonOperator_EQ, Value: structpb.NewStringValue("chair")},
+			},
+		}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	stats, err = engine.CollectionStats(ctx, "products")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stats.DocumentCount)
+	require.Less(t, stats.Size, sizeWithNewIndex)
+}
@@ -17,6 +17,8 @@ package document
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/embedded/store"
@@ -24,6 +26,7 @@ import (
 
 var (
 	ErrIllegalArguments        = store.ErrIllegalArguments
+	ErrAlreadyClosed           = store.ErrAlreadyClosed
 	ErrUnsupportedType         = errors.New("unsupported type")
 	ErrUnexpectedValue         = errors.New("unexpected value")
 	ErrCollectionAlreadyExists = errors.New("collection already exists")
@@ -35,10 +38,30 @@ var (
 	ErrFieldAlreadyExists      = errors.New("field already exists")
 	ErrFieldDoesNotExist       = errors.New("field does not exist")
 	ErrReservedName            = errors.New("reserved name")
-	ErrLimitedIndexCreation    = errors.New("index creation is only supported on empty collections")
+	ErrTooManyDocumentsToIndex = errors.New("too many documents to be indexed within a single transaction")
+	ErrSortBufferSizeExceeded  = errors.New("sort buffer size exceeded")
+	ErrMaxDocumentSizeExceeded = errors.New("max document size exceeded")
+	ErrMaxFieldsExceeded       = errors.New("max number of document fields exceeded")
 	ErrConflict                = errors.New("conflict due to uniqueness contraint violation or read document was updated by another transaction")
 )
 
+var ErrUniqueConstraintViolated = fmt.Errorf("%w: unique constraint violated", ErrConflict)
+
+// UniqueConstraintViolation is returned when a document would hold the same values as another document
+// in the fields of a unique index, it matches ErrUniqueConstraintViolated and ErrConflict when using errors.Is
+type UniqueConstraintViolation struct {
+	Collection string
+	Fields     []string
+}
+
+func (v *UniqueConstraintViolation) Error() string {
+	return fmt.Sprintf("%s: collection '%s' and fields (%s)", ErrUniqueConstraintViolated, v.Collection, strings.Join(v.Fields, ", "))
+}
+
+func (v *UniqueConstraintViolation) Unwrap() error {
+	return ErrUniqueConstraintViolated
+}
+
 func mayTranslateError(err error) error {
 	if err == nil {
 		return nil
@@ -64,14 +87,22 @@ func mayTranslateError(err error) error {
 		return ErrFieldDoesNotExist
 	}
 
-	if errors.Is(err, sql.ErrLimitedIndexCreation) {
-		return ErrLimitedIndexCreation
+	if errors.Is(err, sql.ErrTooManyRowsToIndex) {
+		return ErrTooManyDocumentsToIndex
 	}
 
 	if errors.Is(err, store.ErrTxReadConflict) {
 		return ErrConflict
 	}
 
+	var violation *sql.UniqueConstraintViolation
+	if errors.As(err, &violation) {
+		return &UniqueConstraintViolation{
+			Collection: violation.Table,
+			Fields:     violation.Columns,
+		}
+	}
+
 	if errors.Is(err, store.ErrKeyAlreadyExists) {
 		return ErrConflict
 	}
@@ -17,10 +17,12 @@ package document
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/embedded/store"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMayTranslateError(t *testing.T) {
@@ -36,7 +38,7 @@ func TestMayTranslateError(t *testing.T) {
 		{sql.ErrNoMoreRows, ErrNoMoreDocuments},
 		{sql.ErrColumnAlreadyExists, ErrFieldAlreadyExists},
 		{sql.ErrColumnDoesNotExist, ErrFieldDoesNotExist},
-		{sql.ErrLimitedIndexCreation, ErrLimitedIndexCreation},
+		{sql.ErrTooManyRowsToIndex, ErrTooManyDocumentsToIndex},
 		{store.ErrTxReadConflict, ErrConflict},
 		{store.ErrKeyAlreadyExists, ErrConflict},
 		{errCustom, errCustom},
@@ -50,3 +52,19 @@ func TestMayTranslateError(t *testing.T) {
 		}
 	}
 }
+
+func TestMayTranslateUniqueConstraintViolation(t *testing.T) {
+	err := mayTranslateError(fmt.Errorf("%w: while inserting", &sql.UniqueConstraintViolation{
+		Table:   "mycollection",
+		Index:   "mycollection[email]",
+		Columns: []string{"email"},
+	}))
+	require.ErrorIs(t, err, ErrUniqueConstraintViolated)
+	require.ErrorIs(t, err, ErrConflict)
+
+	var violation *UniqueConstraintViolation
+	require.ErrorAs(t, err, &violation)
+	require.Equal(t, "mycollection", violation.Collection)
+	require.Equal(t, []string{"email"}, violation.Fields)
+	require.Contains(t, err.Error(), "mycollection")
+}
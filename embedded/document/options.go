@@ -17,20 +17,31 @@ package document
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/protomodel"
 )
 
 const DefaultDocumentMaxNestedFields = 3
+const DefaultDocumentMaxSortBufferSize = 10_000
 
 type Options struct {
 	prefix          []byte
 	maxNestedFields int
+
+	// maximum number of documents sorted in memory when no index provides the requested order
+	maxSortBufferSize int
+
+	// maximum size and number of fields of the documents written into any collection, not limited when zero
+	maxDocumentSize   int
+	maxDocumentFields int
 }
 
 func DefaultOptions() *Options {
 	return &Options{
-		maxNestedFields: DefaultDocumentMaxNestedFields,
+		maxNestedFields:   DefaultDocumentMaxNestedFields,
+		maxSortBufferSize: DefaultDocumentMaxSortBufferSize,
 	}
 }
 
@@ -39,6 +50,14 @@ func (opts *Options) Validate() error {
 		return fmt.Errorf("%w: nil options", store.ErrInvalidOptions)
 	}
 
+	if opts.maxDocumentSize < 0 {
+		return fmt.Errorf("%w: invalid max document size", store.ErrInvalidOptions)
+	}
+
+	if opts.maxDocumentFields < 0 {
+		return fmt.Errorf("%w: invalid max document fields", store.ErrInvalidOptions)
+	}
+
 	return nil
 }
 
@@ -51,3 +70,93 @@ func (opts *Options) WithMaxNestedFields(maxNestedFields int) *Options {
 	opts.maxNestedFields = maxNestedFields
 	return opts
 }
+
+func (opts *Options) WithMaxSortBufferSize(maxSortBufferSize int) *Options {
+	opts.maxSortBufferSize = maxSortBufferSize
+	return opts
+}
+
+// WithMaxDocumentSize sets the maximum size in bytes of the serialized documents written into any collection,
+// collections may be created with a lower limit. Document size is not limited when set to zero
+func (opts *Options) WithMaxDocumentSize(maxDocumentSize int) *Options {
+	opts.maxDocumentSize = maxDocumentSize
+	return opts
+}
+
+// WithMaxDocumentFields sets the maximum number of fields, including the ones of nested documents, of the documents
+// written into any collection, collections may be created with a lower limit. Fields are not limited when set to zero
+func (opts *Options) WithMaxDocumentFields(maxDocumentFields int) *Options {
+	opts.maxDocumentFields = maxDocumentFields
+	return opts
+}
+
+// CollectionOptions are set when creating a collection
+type CollectionOptions struct {
+	idGenerator protomodel.DocumentIdGenerator
+	ttl         time.Duration
+	limits      documentLimits
+}
+
+// DefaultCollectionOptions returns the options of collections whose documents are identified
+// by object ids, never expire and are only checked against the limits of the engine
+func DefaultCollectionOptions() *CollectionOptions {
+	return &CollectionOptions{
+		idGenerator: protomodel.DocumentIdGenerator_OBJECT_ID,
+	}
+}
+
+func (opts *CollectionOptions) Validate() error {
+	if opts == nil {
+		return fmt.Errorf("%w: nil collection options", ErrIllegalArguments)
+	}
+
+	_, validIDGenerator := protomodel.DocumentIdGenerator_name[int32(opts.idGenerator)]
+	if !validIDGenerator {
+		return fmt.Errorf("%w: invalid id generator '%d'", ErrIllegalArguments, opts.idGenerator)
+	}
+
+	if opts.ttl < 0 {
+		return fmt.Errorf("%w: invalid ttl '%s'", ErrIllegalArguments, opts.ttl)
+	}
+
+	if opts.limits.MaxDocumentSize < 0 {
+		return fmt.Errorf("%w: invalid max document size '%d'", ErrIllegalArguments, opts.limits.MaxDocumentSize)
+	}
+
+	if opts.limits.MaxDocumentFields < 0 {
+		return fmt.Errorf("%w: invalid max document fields '%d'", ErrIllegalArguments, opts.limits.MaxDocumentFields)
+	}
+
+	return nil
+}
+
+// WithIDGenerator sets how document ids are generated when documents are inserted. Sequential ids are assigned
+// in increasing order, starting from one, but there may be gaps in the sequence, e.g. ids assigned in transactions
+// which were not committed are not assigned again.
+func (opts *CollectionOptions) WithIDGenerator(idGenerator protomodel.DocumentIdGenerator) *CollectionOptions {
+	opts.idGenerator = idGenerator
+	return opts
+}
+
+// WithTTL sets the time after which documents expire, counted since the transaction which wrote their current
+// revision. Expired documents are excluded from queries, but they are kept until removed by DeleteExpiredDocuments.
+// Documents never expire when the ttl is zero.
+func (opts *CollectionOptions) WithTTL(ttl time.Duration) *CollectionOptions {
+	opts.ttl = ttl
+	return opts
+}
+
+// WithMaxDocumentSize sets the maximum size in bytes of the serialized documents written into the collection.
+// Limits set when creating the engine still apply, thus it's only effective when lower. Not limited when zero
+func (opts *CollectionOptions) WithMaxDocumentSize(maxDocumentSize int) *CollectionOptions {
+	opts.limits.MaxDocumentSize = maxDocumentSize
+	return opts
+}
+
+// WithMaxDocumentFields sets the maximum number of fields, including the ones of nested documents, of the documents
+// written into the collection. Limits set when creating the engine still apply, thus it's only effective when lower.
+// Not limited when zero
+func (opts *CollectionOptions) WithMaxDocumentFields(maxDocumentFields int) *CollectionOptions {
+	opts.limits.MaxDocumentFields = maxDocumentFields
+	return opts
+}
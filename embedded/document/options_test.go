@@ -17,8 +17,10 @@ package document
 
 import (
 	"testing"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/protomodel"
 	"github.com/stretchr/testify/require"
 )
 
@@ -30,6 +32,7 @@ func TestDefaultOptions(t *testing.T) {
 	require.NotNil(t, opts)
 
 	require.Equal(t, DefaultDocumentMaxNestedFields, opts.maxNestedFields)
+	require.Equal(t, DefaultDocumentMaxSortBufferSize, opts.maxSortBufferSize)
 }
 
 func TestOptionsValidate(t *testing.T) {
@@ -63,3 +66,48 @@ func TestOptionsWithMaxNestedFields(t *testing.T) {
 
 	require.Equal(t, 20, opts.maxNestedFields)
 }
+
+func TestOptionsWithMaxSortBufferSize(t *testing.T) {
+	opts := DefaultOptions().WithMaxSortBufferSize(100)
+
+	require.Equal(t, 100, opts.maxSortBufferSize)
+}
+
+func TestOptionsWithDocumentLimits(t *testing.T) {
+	opts := DefaultOptions().WithMaxDocumentSize(1024).WithMaxDocumentFields(16)
+
+	require.Equal(t, 1024, opts.maxDocumentSize)
+	require.Equal(t, 16, opts.maxDocumentFields)
+	require.NoError(t, opts.Validate())
+
+	err := DefaultOptions().WithMaxDocumentSize(-1).Validate()
+	require.ErrorIs(t, err, store.ErrInvalidOptions)
+
+	err = DefaultOptions().WithMaxDocumentFields(-1).Validate()
+	require.ErrorIs(t, err, store.ErrInvalidOptions)
+}
+
+func TestCollectionOptions(t *testing.T) {
+	opts := DefaultCollectionOptions()
+	require.Equal(t, protomodel.DocumentIdGenerator_OBJECT_ID, opts.idGenerator)
+	require.Zero(t, opts.ttl)
+	require.Equal(t, documentLimits{}, opts.limits)
+	require.NoError(t, opts.Validate())
+
+	opts = DefaultCollectionOptions().
+		WithIDGenerator(protomodel.DocumentIdGenerator_SEQUENCE).
+		WithTTL(time.Hour).
+		WithMaxDocumentSize(1024).
+		WithMaxDocumentFields(16)
+
+	require.Equal(t, protomodel.DocumentIdGenerator_SEQUENCE, opts.idGenerator)
+	require.Equal(t, time.Hour, opts.ttl)
+	require.Equal(t, documentLimits{MaxDocumentSize: 1024, MaxDocumentFields: 16}, opts.limits)
+	require.NoError(t, opts.Validate())
+
+	var nilOpts *CollectionOptions
+	require.ErrorIs(t, nilOpts.Validate(), ErrIllegalArguments)
+
+	require.ErrorIs(t, DefaultCollectionOptions().WithIDGenerator(protomodel.DocumentIdGenerator(10)).Validate(), ErrIllegalArguments)
+	require.ErrorIs(t, DefaultCollectionOptions().WithTTL(-time.Second).Validate(), ErrIllegalArguments)
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package document
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/pkg/api/protomodel"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// QueryBuilder translates the expressions of document queries over a collection into sql expressions
+type QueryBuilder struct {
+	tableName  string
+	fieldTypes map[string]sql.SQLValueType
+}
+
+// NewQueryBuilder creates a builder for queries over the collection described by the schema,
+// as returned by the engine. The document id field is compared as a hex-encoded document id.
+func NewQueryBuilder(collection *protomodel.Collection) (*QueryBuilder, error) {
+	if collection == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	err := validateCollectionName(collection.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldTypes := make(map[string]sql.SQLValueType, len(collection.Fields))
+
+	for _, field := range collection.Fields {
+		if field.Name == collection.DocumentIdFieldName {
+			fieldTypes[field.Name] = sql.BLOBType
+			continue
+		}
+
+		sqlType, err := protomodelValueTypeToSQLValueType(field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%w: field: %s", err, field.Name)
+		}
+
+		fieldTypes[field.Name] = sqlType
+	}
+
+	return &QueryBuilder{
+		tableName:  collection.Name,
+		fieldTypes: fieldTypes,
+	}, nil
+}
+
+func newQueryBuilderForTable(table *sql.Table) *QueryBuilder {
+	fieldTypes := make(map[string]sql.SQLValueType, len(table.Cols()))
+
+	for _, col := range table.Cols() {
+		fieldTypes[col.Name()] = col.Type()
+	}
+
+	return &QueryBuilder{
+		tableName:  table.Name(),
+		fieldTypes: fieldTypes,
+	}
+}
+
+// FilteringExpression generates a boolean expression in Disjunctive Normal Form from a list of expressions,
+// field comparisons within an expression are combined by AND while expressions are combined by OR.
+// The returned expression is nil when no expression is specified.
+func (b *QueryBuilder) FilteringExpression(expressions []*protomodel.QueryExpression) (sql.ValueExp, error) {
+	var outerExp sql.ValueExp
+
+	for i, exp := range expressions {
+		if exp == nil || len(exp.FieldComparisons) == 0 {
+			return nil, fmt.Errorf("%w: query expression without any field comparisson", ErrIllegalArguments)
+		}
+
+		var innerExp sql.ValueExp
+
+		for i, exp := range exp.FieldComparisons {
+			fieldExp, err := b.FieldComparison(exp)
+			if err != nil {
+				return nil, err
+			}
+
+			if i == 0 {
+				innerExp = fieldExp
+			} else {
+				innerExp = sql.NewBinBoolExp(sql.AND, innerExp, fieldExp)
+			}
+		}
+
+		if i == 0 {
+			outerExp = innerExp
+		} else {
+			outerExp = sql.NewBinBoolExp(sql.OR, outerExp, innerExp)
+		}
+	}
+
+	return outerExp, nil
+}
+
+// FieldComparison generates the boolean expression of a single field comparison,
+// field paths must be declared in the collection, as only declared fields are stored as columns.
+// IN and NOT IN operators take a list of values, an empty list is not matched by any document
+// with IN, and by every document with NOT IN.
+// Case insensitive comparisons are only supported by LIKE, NOT LIKE and STARTS WITH operators.
+func (b *QueryBuilder) FieldComparison(exp *protomodel.FieldComparison) (sql.ValueExp, error) {
+	if exp == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	err := validateFieldName(exp.Field)
+	if err != nil {
+		return nil, err
+	}
+
+	if exp.CaseInsensitive &&
+		exp.Operator != protomodel.ComparisonOperator_LIKE &&
+		exp.Operator != protomodel.ComparisonOperator_NOT_LIKE &&
+		exp.Operator != protomodel.ComparisonOperator_STARTS_WITH {
+		return nil, fmt.Errorf("%w: case insensitive comparison not supported by operator '%s'", ErrIllegalArguments, exp.Operator)
+	}
+
+	fieldType, ok := b.fieldTypes[exp.Field]
+	if !ok {
+		return nil, fmt.Errorf("%w('%s')", ErrFieldDoesNotExist, exp.Field)
+	}
+
+	colSelector := sql.NewColSelector(b.tableName, exp.Field)
+
+	if exp.Operator == protomodel.ComparisonOperator_IN || exp.Operator == protomodel.ComparisonOperator_NOT_IN {
+		return inListExp(colSelector, exp, fieldType)
+	}
+
+	if exp.Operator == protomodel.ComparisonOperator_STARTS_WITH {
+		return startsWithExp(colSelector, exp, fieldType)
+	}
+
+	value, err := structValueToSqlValue(exp.Value, fieldType)
+	if err != nil {
+		return nil, fmt.Errorf("%w: field: %s", err, exp.Field)
+	}
+
+	switch exp.Operator {
+	case protomodel.ComparisonOperator_LIKE, protomodel.ComparisonOperator_NOT_LIKE:
+		{
+			pattern := value
+
+			if exp.CaseInsensitive {
+				pattern = sql.NewVarchar(caseInsensitiveRegexpFlag + exp.Value.GetStringValue())
+			}
+
+			return sql.NewLikeBoolExp(colSelector, exp.Operator == protomodel.ComparisonOperator_NOT_LIKE, pattern), nil
+		}
+	default:
+		{
+			sqlCmpOp, err := sqlCmpOperatorFor(exp.Operator)
+			if err != nil {
+				return nil, err
+			}
+
+			return sql.NewCmpBoolExp(sqlCmpOp, colSelector, value), nil
+		}
+	}
+}
+
+const caseInsensitiveRegexpFlag = "(?i)"
+
+// startsWithExp matches the values of the field starting with the prefix. Case sensitive matching
+// is expressed as a range over the values of the field, thus it can be resolved using an index.
+func startsWithExp(colSelector *sql.ColSelector, exp *protomodel.FieldComparison, fieldType sql.SQLValueType) (sql.ValueExp, error) {
+	if fieldType != sql.VarcharType {
+		return nil, fmt.Errorf("%w: prefix matching requires a field of type %s: field: %s", ErrIllegalArguments, sql.VarcharType, exp.Field)
+	}
+
+	_, isString := exp.Value.GetKind().(*structpb.Value_StringValue)
+	if !isString {
+		return nil, fmt.Errorf("%w: expecting value of type %s: field: %s", ErrUnexpectedValue, sql.VarcharType, exp.Field)
+	}
+
+	prefix := exp.Value.GetStringValue()
+
+	if exp.CaseInsensitive {
+		pattern := caseInsensitiveRegexpFlag + "^" + regexp.QuoteMeta(prefix)
+		return sql.NewLikeBoolExp(colSelector, false, sql.NewVarchar(pattern)), nil
+	}
+
+	lowerBound := sql.NewCmpBoolExp(sql.GE, colSelector, sql.NewVarchar(prefix))
+
+	upperBound, bounded := prefixUpperBound(prefix)
+	if !bounded {
+		return lowerBound, nil
+	}
+
+	return sql.NewBinBoolExp(
+		sql.AND,
+		lowerBound,
+		sql.NewCmpBoolExp(sql.LT, colSelector, sql.NewVarchar(upperBound)),
+	), nil
+}
+
+// prefixUpperBound returns the smallest string greater than every string starting with the prefix,
+// there is no such string when the prefix is empty or made only of 0xFF bytes
+func prefixUpperBound(prefix string) (string, bool) {
+	bs := []byte(prefix)
+
+	for i := len(bs) - 1; i >= 0; i-- {
+		if bs[i] < 0xFF {
+			bs[i]++
+			return string(bs[:i+1]), true
+		}
+	}
+
+	return "", false
+}
+
+func inListExp(colSelector *sql.ColSelector, exp *protomodel.FieldComparison, fieldType sql.SQLValueType) (sql.ValueExp, error) {
+	listValue, ok := exp.Value.GetKind().(*structpb.Value_ListValue)
+	if !ok {
+		return nil, fmt.Errorf("%w: expecting a list of values: field: %s", ErrUnexpectedValue, exp.Field)
+	}
+
+	values := make([]sql.ValueExp, len(listValue.ListValue.GetValues()))
+
+	for i, v := range listValue.ListValue.GetValues() {
+		value, err := structValueToSqlValue(v, fieldType)
+		if err != nil {
+			return nil, fmt.Errorf("%w: field: %s", err, exp.Field)
+		}
+
+		values[i] = value
+	}
+
+	return sql.NewInListExp(colSelector, exp.Operator == protomodel.ComparisonOperator_NOT_IN, values), nil
+}
+
+func generateSQLFilteringExpression(expressions []*protomodel.QueryExpression, table *sql.Table) (sql.ValueExp, error) {
+	return newQueryBuilderForTable(table).FilteringExpression(expressions)
+}
+
+func sqlCmpOperatorFor(op protomodel.ComparisonOperator) (sql.CmpOperator, error) {
+	switch op {
+	case protomodel.ComparisonOperator_EQ:
+		{
+			return sql.EQ, nil
+		}
+	case protomodel.ComparisonOperator_NE:
+		{
+			return sql.NE, nil
+		}
+	case protomodel.ComparisonOperator_LT:
+		{
+			return sql.LT, nil
+		}
+	case protomodel.ComparisonOperator_LE:
+		{
+			return sql.LE, nil
+		}
+	case protomodel.ComparisonOperator_GT:
+		{
+			return sql.GT, nil
+		}
+	case protomodel.ComparisonOperator_GE:
+		{
+			return sql.GE, nil
+		}
+	default:
+		{
+			return 0, fmt.Errorf("%w: unsupported operator ('%s')", ErrIllegalArguments, op)
+		}
+	}
+}
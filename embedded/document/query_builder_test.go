@@ -0,0 +1,317 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package document
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/pkg/api/protomodel"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestNewQueryBuilder(t *testing.T) {
+	_, err := NewQueryBuilder(nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = NewQueryBuilder(&protomodel.Collection{Name: "1invalid"})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = NewQueryBuilder(&protomodel.Collection{
+		Name:   "mycollection",
+		Fields: []*protomodel.Field{{Name: "country", Type: protomodel.FieldType(99)}},
+	})
+	require.ErrorIs(t, err, ErrUnsupportedType)
+}
+
+func TestQueryBuilderFilteringExpression(t *testing.T) {
+	collection := &protomodel.Collection{
+		Name:                "mycollection",
+		DocumentIdFieldName: "_id",
+		Fields: []*protomodel.Field{
+			{Name: "_id", Type: protomodel.FieldType_STRING},
+			{Name: "country", Type: protomodel.FieldType_STRING},
+			{Name: "pincode", Type: protomodel.FieldType_INTEGER},
+		},
+	}
+
+	qb, err := NewQueryBuilder(collection)
+	require.NoError(t, err)
+
+	t.Run("no expressions", func(t *testing.T) {
+		exp, err := qb.FilteringExpression(nil)
+		require.NoError(t, err)
+		require.Nil(t, exp)
+	})
+
+	t.Run("disjunction of grouped comparisons", func(t *testing.T) {
+		exp, err := qb.FilteringExpression([]*protomodel.QueryExpression{
+			{
+				FieldComparisons: []*protomodel.FieldComparison{
+					{Field: "country", Operator: protomodel.ComparisonOperator_LIKE, Value: structpb.NewStringValue("US.*")},
+					{Field: "pincode", Operator: protomodel.ComparisonOperator_GE, Value: structpb.NewNumberValue(5)},
+				},
+			},
+			{
+				FieldComparisons: []*protomodel.FieldComparison{
+					{Field: "pincode", Operator: protomodel.ComparisonOperator_EQ, Value: structpb.NewNumberValue(1)},
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t,
+			sql.NewBinBoolExp(
+				sql.OR,
+				sql.NewBinBoolExp(
+					sql.AND,
+					sql.NewLikeBoolExp(sql.NewColSelector("mycollection", "country"), false, sql.NewVarchar("US.*")),
+					sql.NewCmpBoolExp(sql.GE, sql.NewColSelector("mycollection", "pincode"), sql.NewInteger(5)),
+				),
+				sql.NewCmpBoolExp(sql.EQ, sql.NewColSelector("mycollection", "pincode"), sql.NewInteger(1)),
+			), exp)
+	})
+
+	t.Run("comparison over the document id", func(t *testing.T) {
+		docID := NewDocumentIDFromTx(1)
+
+		exp, err := qb.FieldComparison(&protomodel.FieldComparison{
+			Field:    "_id",
+			Operator: protomodel.ComparisonOperator_EQ,
+			Value:    structpb.NewStringValue(docID.EncodeToHexString()),
+		})
+		require.NoError(t, err)
+		require.Equal(t, sql.NewCmpBoolExp(sql.EQ, sql.NewColSelector("mycollection", "_id"), sql.NewBlob(docID[:])), exp)
+	})
+
+	t.Run("comparison against a list of values", func(t *testing.T) {
+		pincodes := structpb.NewListValue(&structpb.ListValue{
+			Values: []*structpb.Value{structpb.NewNumberValue(1), structpb.NewNumberValue(3)},
+		})
+
+		exp, err := qb.FieldComparison(&protomodel.FieldComparison{
+			Field:    "pincode",
+			Operator: protomodel.ComparisonOperator_IN,
+			Value:    pincodes,
+		})
+		require.NoError(t, err)
+		require.Equal(t, sql.NewInListExp(
+			sql.NewColSelector("mycollection", "pincode"),
+			false,
+			[]sql.ValueExp{sql.NewInteger(1), sql.NewInteger(3)},
+		), exp)
+
+		exp, err = qb.FieldComparison(&protomodel.FieldComparison{
+			Field:    "pincode",
+			Operator: protomodel.ComparisonOperator_NOT_IN,
+			Value:    structpb.NewListValue(&structpb.ListValue{}),
+		})
+		require.NoError(t, err)
+		require.Equal(t, sql.NewInListExp(sql.NewColSelector("mycollection", "pincode"), true, []sql.ValueExp{}), exp)
+
+		_, err = qb.FieldComparison(&protomodel.FieldComparison{
+			Field:    "pincode",
+			Operator: protomodel.ComparisonOperator_IN,
+			Value:    structpb.NewNumberValue(1),
+		})
+		require.ErrorIs(t, err, ErrUnexpectedValue)
+
+		_, err = qb.FieldComparison(&protomodel.FieldComparison{
+			Field:    "pincode",
+			Operator: protomodel.ComparisonOperator_IN,
+			Value: structpb.NewListValue(&structpb.ListValue{
+				Values: []*structpb.Value{structpb.NewStringValue("1")},
+			}),
+		})
+		require.ErrorIs(t, err, ErrUnexpectedValue)
+	})
+
+	t.Run("prefix matching", func(t *testing.T) {
+		countrySelector := sql.NewColSelector("mycollection", "country")
+
+		exp, err := qb.FieldComparison(&protomodel.FieldComparison{
+			Field:    "country",
+			Operator: protomodel.ComparisonOperator_STARTS_WITH,
+			Value:    structpb.NewStringValue("Jo"),
+		})
+		require.NoError(t, err)
+		require.Equal(t,
+			sql.NewBinBoolExp(
+				sql.AND,
+				sql.NewCmpBoolExp(sql.GE, countrySelector, sql.NewVarchar("Jo")),
+				sql.NewCmpBoolExp(sql.LT, countrySelector, sql.NewVarchar("Jp")),
+			), exp)
+
+		exp, err = qb.FieldComparison(&protomodel.FieldComparison{
+			Field:    "country",
+			Operator: protomodel.ComparisonOperator_STARTS_WITH,
+			Value:    structpb.NewStringValue(""),
+		})
+		require.NoError(t, err)
+		require.Equal(t, sql.NewCmpBoolExp(sql.GE, countrySelector, sql.NewVarchar("")), exp)
+
+		exp, err = qb.FieldComparison(&protomodel.FieldComparison{
+			Field:           "country",
+			Operator:        protomodel.ComparisonOperator_STARTS_WITH,
+			Value:           structpb.NewStringValue("J.o"),
+			CaseInsensitive: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, sql.NewLikeBoolExp(countrySelector, false, sql.NewVarchar(`(?i)^J\.o`)), exp)
+
+		_, err = qb.FieldComparison(&protomodel.FieldComparison{
+			Field:    "pincode",
+			Operator: protomodel.ComparisonOperator_STARTS_WITH,
+			Value:    structpb.NewStringValue("1"),
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = qb.FieldComparison(&protomodel.FieldComparison{
+			Field:    "country",
+			Operator: protomodel.ComparisonOperator_STARTS_WITH,
+			Value:    structpb.NewNumberValue(1),
+		})
+		require.ErrorIs(t, err, ErrUnexpectedValue)
+	})
+
+	t.Run("case insensitive comparisons", func(t *testing.T) {
+		exp, err := qb.FieldComparison(&protomodel.FieldComparison{
+			Field:           "country",
+			Operator:        protomodel.ComparisonOperator_NOT_LIKE,
+			Value:           structpb.NewStringValue("us"),
+			CaseInsensitive: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, sql.NewLikeBoolExp(sql.NewColSelector("mycollection", "country"), true, sql.NewVarchar("(?i)us")), exp)
+
+		_, err = qb.FieldComparison(&protomodel.FieldComparison{
+			Field:           "country",
+			Operator:        protomodel.ComparisonOperator_EQ,
+			Value:           structpb.NewStringValue("us"),
+			CaseInsensitive: true,
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	t.Run("comparison over an undeclared field", func(t *testing.T) {
+		_, err := qb.FieldComparison(&protomodel.FieldComparison{
+			Field:    "address.street",
+			Operator: protomodel.ComparisonOperator_EQ,
+			Value:    structpb.NewStringValue("main"),
+		})
+		require.ErrorIs(t, err, ErrFieldDoesNotExist)
+	})
+
+	t.Run("invalid comparisons", func(t *testing.T) {
+		_, err := qb.FieldComparison(nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = qb.FilteringExpression([]*protomodel.QueryExpression{{}})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = qb.FieldComparison(&protomodel.FieldComparison{
+			Field:    DocumentBLOBField,
+			Operator: protomodel.ComparisonOperator_EQ,
+			Value:    structpb.NewStringValue("doc"),
+		})
+		require.ErrorIs(t, err, ErrReservedName)
+
+		_, err = qb.FieldComparison(&protomodel.FieldComparison{
+			Field:    "pincode",
+			Operator: protomodel.ComparisonOperator_EQ,
+			Value:    structpb.NewStringValue("1"),
+		})
+		require.ErrorIs(t, err, ErrUnexpectedValue)
+
+		_, err = qb.FieldComparison(&protomodel.FieldComparison{
+			Field:    "pincode",
+			Operator: protomodel.ComparisonOperator(99),
+			Value:    structpb.NewNumberValue(1),
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+}
+
+func TestQueryBuilderWithCollectionSchema(t *testing.T) {
+	ctx := context.Background()
+	engine := makeEngine(t)
+
+	collectionName := "mycollection"
+
+	err := engine.CreateCollection(
+		ctx,
+		collectionName,
+		"",
+		[]*protomodel.Field{
+			{Name: "pincode", Type: protomodel.FieldType_INTEGER},
+			{Name: "country", Type: protomodel.FieldType_STRING},
+		},
+		nil,
+	)
+	require.NoError(t, err)
+
+	collection, err := engine.GetCollection(ctx, collectionName)
+	require.NoError(t, err)
+
+	qb, err := NewQueryBuilder(collection)
+	require.NoError(t, err)
+
+	sqlTx, err := engine.sqlEngine.NewTx(ctx, sql.DefaultTxOptions().WithReadOnly(true))
+	require.NoError(t, err)
+	defer sqlTx.Cancel()
+
+	table, err := getTableForCollection(sqlTx, collectionName)
+	require.NoError(t, err)
+
+	expressions := []*protomodel.QueryExpression{
+		{
+			FieldComparisons: []*protomodel.FieldComparison{
+				{Field: "country", Operator: protomodel.ComparisonOperator_NE, Value: structpb.NewStringValue("US")},
+				{Field: "pincode", Operator: protomodel.ComparisonOperator_LT, Value: structpb.NewNumberValue(10)},
+				{Field: DefaultDocumentIDField, Operator: protomodel.ComparisonOperator_GT, Value: structpb.NewStringValue(NewDocumentIDFromTx(0).EncodeToHexString())},
+			},
+		},
+	}
+
+	exp, err := qb.FilteringExpression(expressions)
+	require.NoError(t, err)
+
+	expectedExp, err := generateSQLFilteringExpression(expressions, table)
+	require.NoError(t, err)
+
+	// the expression built from the schema of the collection matches the one used by the engine
+	require.Equal(t, expectedExp, exp)
+}
+
+func TestPrefixUpperBound(t *testing.T) {
+	for _, c := range []struct {
+		prefix     string
+		upperBound string
+		bounded    bool
+	}{
+		{"", "", false},
+		{"a", "b", true},
+		{"Jo", "Jp", true},
+		{"a\xff", "b", true},
+		{"\xff\xff", "", false},
+	} {
+		upperBound, bounded := prefixUpperBound(c.prefix)
+		require.Equal(t, c.bounded, bounded, "prefix %q", c.prefix)
+		require.Equal(t, c.upperBound, upperBound, "prefix %q", c.prefix)
+	}
+}
@@ -17,6 +17,8 @@ package document
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/embedded/store"
@@ -68,11 +70,55 @@ var structValueToSqlValue = func(value *structpb.Value, sqlType sql.SQLValueType
 			return nil, fmt.Errorf("%w: expecting value of type %s", ErrUnexpectedValue, sqlType)
 		}
 		return sql.NewBool(value.GetBoolValue()), nil
+	case sql.TimestampType:
+		ts, err := parseTimestampValue(value)
+		if err != nil {
+			return nil, err
+		}
+		return sql.NewTimestamp(ts), nil
 	}
 
 	return nil, fmt.Errorf("%w(%s)", ErrUnsupportedType, sqlType)
 }
 
+// parseTimestampValue parses the value of a timestamp field, which is expected to be an RFC 3339 formatted string
+func parseTimestampValue(value *structpb.Value) (time.Time, error) {
+	_, ok := value.GetKind().(*structpb.Value_StringValue)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%w: expecting value of type %s as an RFC 3339 formatted string", ErrUnexpectedValue, sql.TimestampType)
+	}
+
+	ts, err := time.Parse(time.RFC3339Nano, value.GetStringValue())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: expecting value of type %s as an RFC 3339 formatted string: %v", ErrUnexpectedValue, sql.TimestampType, err)
+	}
+
+	return ts, nil
+}
+
+var sqlValueToStructValue = func(value sql.TypedValue) (*structpb.Value, error) {
+	if value.IsNull() {
+		return structpb.NewNullValue(), nil
+	}
+
+	switch value.Type() {
+	case sql.VarcharType:
+		return structpb.NewStringValue(value.RawValue().(string)), nil
+	case sql.IntegerType:
+		return structpb.NewNumberValue(float64(value.RawValue().(int64))), nil
+	case sql.BLOBType:
+		return structpb.NewStringValue(DocumentID(value.RawValue().([]byte)).EncodeToHexString()), nil
+	case sql.Float64Type:
+		return structpb.NewNumberValue(value.RawValue().(float64)), nil
+	case sql.BooleanType:
+		return structpb.NewBoolValue(value.RawValue().(bool)), nil
+	case sql.TimestampType:
+		return structpb.NewStringValue(value.RawValue().(time.Time).Format(time.RFC3339Nano)), nil
+	}
+
+	return nil, fmt.Errorf("%w(%s)", ErrUnsupportedType, value.Type())
+}
+
 var protomodelValueTypeToSQLValueType = func(stype protomodel.FieldType) (sql.SQLValueType, error) {
 	switch stype {
 	case protomodel.FieldType_STRING:
@@ -83,6 +129,8 @@ var protomodelValueTypeToSQLValueType = func(stype protomodel.FieldType) (sql.SQ
 		return sql.Float64Type, nil
 	case protomodel.FieldType_BOOLEAN:
 		return sql.BooleanType, nil
+	case protomodel.FieldType_TIMESTAMP:
+		return sql.TimestampType, nil
 	}
 
 	return "", fmt.Errorf("%w(%s)", ErrUnsupportedType, stype)
@@ -100,6 +148,8 @@ var sqlValueTypeDefaultLength = func(stype sql.SQLValueType) (int, error) {
 		return 0, nil
 	case sql.BooleanType:
 		return 0, nil
+	case sql.TimestampType:
+		return 0, nil
 	}
 
 	return 0, fmt.Errorf("%w(%s)", ErrUnsupportedType, stype)
@@ -114,3 +164,57 @@ func kvMetadataToProto(kvMetadata *store.KVMetadata) *protomodel.DocumentMetadat
 		Deleted: kvMetadata.Deleted(),
 	}
 }
+
+// structValueKindRank sets the order between values of different kinds, missing fields sort as null values
+func structValueKindRank(value *structpb.Value) int {
+	switch value.GetKind().(type) {
+	case *structpb.Value_BoolValue:
+		return 1
+	case *structpb.Value_NumberValue:
+		return 2
+	case *structpb.Value_StringValue:
+		return 3
+	case *structpb.Value_StructValue:
+		return 4
+	case *structpb.Value_ListValue:
+		return 5
+	}
+
+	return 0
+}
+
+// compareStructValues compares scalar values, nested documents and lists are considered equal to each other
+func compareStructValues(v1, v2 *structpb.Value) int {
+	r1, r2 := structValueKindRank(v1), structValueKindRank(v2)
+	if r1 != r2 {
+		if r1 < r2 {
+			return -1
+		}
+		return 1
+	}
+
+	switch v1.GetKind().(type) {
+	case *structpb.Value_BoolValue:
+		b1, b2 := v1.GetBoolValue(), v2.GetBoolValue()
+		if b1 == b2 {
+			return 0
+		}
+		if !b1 {
+			return -1
+		}
+		return 1
+	case *structpb.Value_NumberValue:
+		n1, n2 := v1.GetNumberValue(), v2.GetNumberValue()
+		if n1 < n2 {
+			return -1
+		}
+		if n1 > n2 {
+			return 1
+		}
+		return 0
+	case *structpb.Value_StringValue:
+		return strings.Compare(v1.GetStringValue(), v2.GetStringValue())
+	}
+
+	return 0
+}
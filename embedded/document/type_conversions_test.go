@@ -18,6 +18,7 @@ package document
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -110,6 +111,28 @@ func TestStructValueToSqlValue(t *testing.T) {
 	require.NoError(t, err, "Expected no error for BooleanType with NULL value")
 	require.Equal(t, sql.NewNull(sql.AnyType), result, "Expected NULL value")
 
+	// Test case for TimestampType
+	value = &structpb.Value{
+		Kind: &structpb.Value_StringValue{StringValue: "2023-08-01T10:30:00.123456789+02:00"},
+	}
+	result, err = structValueToSqlValue(value, sql.TimestampType)
+	require.NoError(t, err, "Expected no error for TimestampType")
+	require.Equal(t, sql.NewTimestamp(time.Date(2023, 8, 1, 8, 30, 0, 123456000, time.UTC)), result, "Expected Timestamp value")
+
+	// Test case for TimestampType with a value not formatted as RFC 3339
+	value = &structpb.Value{
+		Kind: &structpb.Value_StringValue{StringValue: "2023-08-01 10:30"},
+	}
+	_, err = structValueToSqlValue(value, sql.TimestampType)
+	require.ErrorIs(t, err, ErrUnexpectedValue, "Expected error for TimestampType with invalid format")
+
+	// Test case for TimestampType with a non-string value
+	value = &structpb.Value{
+		Kind: &structpb.Value_NumberValue{NumberValue: 1690878600},
+	}
+	_, err = structValueToSqlValue(value, sql.TimestampType)
+	require.ErrorIs(t, err, ErrUnexpectedValue, "Expected error for TimestampType with number value")
+
 	// Test case for unsupported type
 	value = &structpb.Value{
 		Kind: &structpb.Value_ListValue{},
@@ -150,6 +173,12 @@ func TestProtomodelValueTypeToSQLValueType(t *testing.T) {
 			sqlType:   sql.BooleanType,
 			expectErr: nil,
 		},
+		{
+			name:      "timestamp",
+			valueType: protomodel.FieldType_TIMESTAMP,
+			sqlType:   sql.TimestampType,
+			expectErr: nil,
+		},
 		{
 			name:      "unsupported",
 			valueType: 999,
@@ -205,6 +234,12 @@ func TestSQLValueTypeDefaultLength(t *testing.T) {
 			length:    0,
 			expectErr: nil,
 		},
+		{
+			name:      "timestamp",
+			valueType: sql.TimestampType,
+			length:    0,
+			expectErr: nil,
+		},
 		{
 			name:      "unsupported",
 			valueType: "unknown",
@@ -222,3 +257,9 @@ func TestSQLValueTypeDefaultLength(t *testing.T) {
 		})
 	}
 }
+
+func TestSQLTimestampToStructValue(t *testing.T) {
+	value, err := sqlValueToStructValue(sql.NewTimestamp(time.Date(2023, 8, 1, 8, 30, 0, 123456000, time.UTC)))
+	require.NoError(t, err)
+	require.Equal(t, "2023-08-01T08:30:00.123456Z", value.GetStringValue())
+}
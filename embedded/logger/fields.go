@@ -0,0 +1,133 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"fmt"
+	"strings"
+)
+
+var _ Logger = (*FieldLogger)(nil)
+
+// FieldLogger is a logger attaching a set of key/value pairs to every message
+type FieldLogger struct {
+	logger Logger
+	fields []interface{}
+}
+
+// With returns a logger which attaches the given key/value pairs to every message logged through l.
+// JSON loggers emit each pair as a separate field while the rest append them to the message as key=value.
+func With(l Logger, keysAndValues ...interface{}) *FieldLogger {
+	var fields []interface{}
+
+	if fl, ok := l.(*FieldLogger); ok {
+		l = fl.logger
+		fields = append(fields, fl.fields...)
+	}
+
+	fields = append(fields, keysAndValues...)
+
+	if len(fields)%2 != 0 {
+		fields = append(fields, nil)
+	}
+
+	return &FieldLogger{
+		logger: l,
+		fields: fields,
+	}
+}
+
+// With returns a logger attaching the given key/value pairs in addition to the current ones
+func (l *FieldLogger) With(keysAndValues ...interface{}) *FieldLogger {
+	return With(l, keysAndValues...)
+}
+
+// Error prints the message at ERROR level
+func (l *FieldLogger) Error(msg string) {
+	l.log(LogError, msg)
+}
+
+// Warning prints the message at WARN level
+func (l *FieldLogger) Warning(msg string) {
+	l.log(LogWarn, msg)
+}
+
+// Info prints the message at INFO level
+func (l *FieldLogger) Info(msg string) {
+	l.log(LogInfo, msg)
+}
+
+// Debug prints the message at DEBUG level
+func (l *FieldLogger) Debug(msg string) {
+	l.log(LogDebug, msg)
+}
+
+// Errorf prints the formatted message at ERROR level
+func (l *FieldLogger) Errorf(f string, v ...interface{}) {
+	l.log(LogError, fmt.Sprintf(f, v...))
+}
+
+// Warningf prints the formatted message at WARN level
+func (l *FieldLogger) Warningf(f string, v ...interface{}) {
+	l.log(LogWarn, fmt.Sprintf(f, v...))
+}
+
+// Infof prints the formatted message at INFO level
+func (l *FieldLogger) Infof(f string, v ...interface{}) {
+	l.log(LogInfo, fmt.Sprintf(f, v...))
+}
+
+// Debugf prints the formatted message at DEBUG level
+func (l *FieldLogger) Debugf(f string, v ...interface{}) {
+	l.log(LogDebug, fmt.Sprintf(f, v...))
+}
+
+func (l *FieldLogger) log(level LogLevel, msg string) {
+	if jl, ok := l.logger.(*JsonLogger); ok {
+		jl.log(jl.Name(), level, msg, l.fields...)
+		return
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(msg)
+
+	for i := 0; i < len(l.fields); i += 2 {
+		fmt.Fprintf(&sb, " %v=%v", l.fields[i], l.fields[i+1])
+	}
+
+	switch level {
+	case LogError:
+		l.logger.Errorf("%s", sb.String())
+	case LogWarn:
+		l.logger.Warningf("%s", sb.String())
+	case LogInfo:
+		l.logger.Infof("%s", sb.String())
+	default:
+		l.logger.Debugf("%s", sb.String())
+	}
+}
+
+// SetLevel sets the threshold of the underlying logger, if supported
+func (l *FieldLogger) SetLevel(level LogLevel) {
+	SetLevel(l.logger, level)
+}
+
+// Close the underlying logger
+func (l *FieldLogger) Close() error {
+	return l.logger.Close()
+}
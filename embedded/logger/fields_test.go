@@ -0,0 +1,76 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldLogger(t *testing.T) {
+	t.Run("text output", func(t *testing.T) {
+		ml := NewMemoryLoggerWithLevel(LogDebug)
+
+		l := With(ml, "db", "defaultdb").With("primary", "127.0.0.1:3322")
+
+		l.Infof("replicated tx %d", 1)
+		l.Warning("lagging")
+		l.Errorf("failure")
+		l.Debug("details")
+
+		logs := ml.GetLogs()
+		require.Len(t, logs, 4)
+		require.Regexp(t, `INF: replicated tx 1 db=defaultdb primary=127.0.0.1:3322$`, logs[0])
+		require.Regexp(t, `WRN: lagging db=defaultdb primary=127.0.0.1:3322$`, logs[1])
+		require.Regexp(t, `ERR: failure db=defaultdb primary=127.0.0.1:3322$`, logs[2])
+		require.Regexp(t, `DBG: details db=defaultdb primary=127.0.0.1:3322$`, logs[3])
+
+		require.NoError(t, l.Close())
+	})
+
+	t.Run("json output", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		jl, err := NewJSONLogger(&Options{Name: "test", Output: &buf})
+		require.NoError(t, err)
+
+		With(jl, "db", "defaultdb", "lag", 3).Infof("replicated tx %d", 1)
+
+		var raw map[string]interface{}
+		err = json.Unmarshal(buf.Bytes(), &raw)
+		require.NoError(t, err)
+
+		require.Equal(t, "replicated tx 1", raw["message"])
+		require.Equal(t, "info", raw["level"])
+		require.Equal(t, "defaultdb", raw["db"])
+		require.Equal(t, float64(3), raw["lag"])
+		require.Contains(t, raw["caller"], "fields_test.go")
+
+		require.NoError(t, jl.Close())
+	})
+
+	t.Run("odd number of key/values", func(t *testing.T) {
+		ml := NewMemoryLoggerWithLevel(LogInfo)
+
+		With(ml, "db").Info("message")
+
+		require.Regexp(t, `INF: message db=<nil>$`, ml.GetLogs()[0])
+	})
+}
@@ -21,13 +21,16 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"sync"
 )
 
 // FileLogger ...
 type FileLogger struct {
 	Logger   *log.Logger
-	LogLevel LogLevel
+	LogLevel LogLevel // changed with SetLevel while the logger is in use
 	out      *os.File
+
+	levelMutex sync.RWMutex
 }
 
 // NewFileLogger ...
@@ -72,32 +75,47 @@ func setup(file string) (out *os.File, err error) {
 
 // Errorf ...
 func (l *FileLogger) Errorf(f string, v ...interface{}) {
-	if l.LogLevel <= LogError {
+	if l.enabled(LogError) {
 		l.Logger.Printf("ERROR: "+f, v...)
 	}
 }
 
 // Warningf ...
 func (l *FileLogger) Warningf(f string, v ...interface{}) {
-	if l.LogLevel <= LogWarn {
+	if l.enabled(LogWarn) {
 		l.Logger.Printf("WARNING: "+f, v...)
 	}
 }
 
 // Infof ...
 func (l *FileLogger) Infof(f string, v ...interface{}) {
-	if l.LogLevel <= LogInfo {
+	if l.enabled(LogInfo) {
 		l.Logger.Printf("INFO: "+f, v...)
 	}
 }
 
 // Debugf ...
 func (l *FileLogger) Debugf(f string, v ...interface{}) {
-	if l.LogLevel <= LogDebug {
+	if l.enabled(LogDebug) {
 		l.Logger.Printf("DEBUG: "+f, v...)
 	}
 }
 
+// SetLevel sets the threshold of the logger, anything less severe is suppressed
+func (l *FileLogger) SetLevel(level LogLevel) {
+	l.levelMutex.Lock()
+	defer l.levelMutex.Unlock()
+
+	l.LogLevel = level
+}
+
+func (l *FileLogger) enabled(level LogLevel) bool {
+	l.levelMutex.RLock()
+	defer l.levelMutex.RUnlock()
+
+	return l.LogLevel <= level
+}
+
 // Close the logger ...
 func (l *FileLogger) Close() error {
 	if l.out != nil {
@@ -231,11 +231,18 @@ func (l *JsonLogger) Errorf(msg string, args ...interface{}) {
 	l.logWithFmt(l.Name(), LogError, msg, args...)
 }
 
-// Update the logging level
-func (l *JsonLogger) SetLogLevel(level LogLevel) {
+// SetLevel sets the threshold of the logger, anything less severe is suppressed
+func (l *JsonLogger) SetLevel(level LogLevel) {
 	atomic.StoreInt32(&l.level, int32(level))
 }
 
+// SetLogLevel updates the logging level
+//
+// Deprecated: use SetLevel instead
+func (l *JsonLogger) SetLogLevel(level LogLevel) {
+	l.SetLevel(level)
+}
+
 // Name returns the loggers name
 func (i *JsonLogger) Name() string {
 	return i.name
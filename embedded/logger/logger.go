@@ -55,6 +55,21 @@ type Logger interface {
 	Close() error
 }
 
+// LevelSetter is implemented by loggers whose threshold can be changed at runtime
+type LevelSetter interface {
+	SetLevel(LogLevel)
+}
+
+// SetLevel sets the threshold of the logger. It returns false if the logger
+// does not support changing its level
+func SetLevel(l Logger, level LogLevel) bool {
+	ls, ok := l.(LevelSetter)
+	if ok {
+		ls.SetLevel(level)
+	}
+	return ok
+}
+
 func LogLevelFromEnvironment() LogLevel {
 	logLevel, _ := os.LookupEnv("LOG_LEVEL")
 	switch strings.ToLower(logLevel) {
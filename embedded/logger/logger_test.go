@@ -17,10 +17,13 @@ limitations under the License.
 package logger
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
 	"reflect"
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewLogger(t *testing.T) {
@@ -121,3 +124,50 @@ func TestNewLoggerWithFile(t *testing.T) {
 		})
 	}
 }
+
+func TestSetLevel(t *testing.T) {
+	var buf bytes.Buffer
+
+	jl, err := NewJSONLogger(&Options{Output: &buf, Level: LogWarn})
+	require.NoError(t, err)
+
+	fl, _, err := NewFileLogger("test", filepath.Join(t.TempDir(), "test.log"))
+	require.NoError(t, err)
+	defer fl.Close()
+
+	ml := NewMemoryLoggerWithLevel(LogWarn)
+
+	for _, l := range []Logger{
+		NewSimpleLoggerWithLevel("test", &buf, LogWarn),
+		fl,
+		ml,
+		jl,
+		With(ml, "key", "value"),
+	} {
+		require.True(t, SetLevel(l, LogDebug))
+	}
+
+	buf.Reset()
+
+	sl := NewSimpleLoggerWithLevel("test", &buf, LogWarn)
+
+	sl.Infof("suppressed")
+	require.Empty(t, buf.String())
+
+	SetLevel(sl, LogDebug)
+
+	sl.Debugf("debug message")
+	require.Contains(t, buf.String(), "DEBUG: debug message")
+
+	SetLevel(sl, LogError)
+
+	buf.Reset()
+	sl.Warningf("suppressed")
+	require.Empty(t, buf.String())
+
+	require.False(t, SetLevel(&levellessLogger{}, LogDebug))
+}
+
+type levellessLogger struct {
+	Logger
+}
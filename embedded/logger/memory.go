@@ -20,13 +20,14 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
 type MemoryLogger struct {
 	m     sync.Mutex
 	lines *[]string
-	level LogLevel
+	level int32 // accessed atomically
 }
 
 func NewMemoryLogger() *MemoryLogger {
@@ -36,7 +37,7 @@ func NewMemoryLogger() *MemoryLogger {
 func NewMemoryLoggerWithLevel(level LogLevel) *MemoryLogger {
 	return &MemoryLogger{
 		lines: &[]string{},
-		level: level,
+		level: int32(level),
 	}
 }
 
@@ -64,7 +65,7 @@ func (l *MemoryLogger) GetLogs() []string {
 }
 
 func (l *MemoryLogger) addLog(level LogLevel, prefix string, f string, args []interface{}) {
-	if level < l.level {
+	if level < LogLevel(atomic.LoadInt32(&l.level)) {
 		return
 	}
 
@@ -84,6 +85,11 @@ func (l *MemoryLogger) addLog(level LogLevel, prefix string, f string, args []in
 	*l.lines = append(*l.lines, sb.String())
 }
 
+// SetLevel sets the threshold of the logger, anything less severe is suppressed
+func (l *MemoryLogger) SetLevel(level LogLevel) {
+	atomic.StoreInt32(&l.level, int32(level))
+}
+
 // Close the logger ...
 func (l *MemoryLogger) Close() error {
 	return nil
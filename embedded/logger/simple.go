@@ -19,12 +19,15 @@ package logger
 import (
 	"io"
 	"log"
+	"sync"
 )
 
 // SimpleLogger ...
 type SimpleLogger struct {
 	Logger   *log.Logger
-	LogLevel LogLevel
+	LogLevel LogLevel // changed with SetLevel while the logger is in use
+
+	levelMutex sync.RWMutex
 }
 
 // NewSimpleLogger ...
@@ -45,32 +48,47 @@ func NewSimpleLoggerWithLevel(name string, out io.Writer, level LogLevel) Logger
 
 // Errorf ...
 func (l *SimpleLogger) Errorf(f string, v ...interface{}) {
-	if l.LogLevel <= LogError {
+	if l.enabled(LogError) {
 		l.Logger.Printf("ERROR: "+f, v...)
 	}
 }
 
 // Warningf ...
 func (l *SimpleLogger) Warningf(f string, v ...interface{}) {
-	if l.LogLevel <= LogWarn {
+	if l.enabled(LogWarn) {
 		l.Logger.Printf("WARNING: "+f, v...)
 	}
 }
 
 // Infof ...
 func (l *SimpleLogger) Infof(f string, v ...interface{}) {
-	if l.LogLevel <= LogInfo {
+	if l.enabled(LogInfo) {
 		l.Logger.Printf("INFO: "+f, v...)
 	}
 }
 
 // Debugf ...
 func (l *SimpleLogger) Debugf(f string, v ...interface{}) {
-	if l.LogLevel <= LogDebug {
+	if l.enabled(LogDebug) {
 		l.Logger.Printf("DEBUG: "+f, v...)
 	}
 }
 
+// SetLevel sets the threshold of the logger, anything less severe is suppressed
+func (l *SimpleLogger) SetLevel(level LogLevel) {
+	l.levelMutex.Lock()
+	defer l.levelMutex.Unlock()
+
+	l.LogLevel = level
+}
+
+func (l *SimpleLogger) enabled(level LogLevel) bool {
+	l.levelMutex.RLock()
+	defer l.levelMutex.RUnlock()
+
+	return l.LogLevel <= level
+}
+
 // Close the logger ...
 func (l *SimpleLogger) Close() error {
 	return nil
@@ -79,13 +79,43 @@ var ErrDivisionByZero = errors.New("division by zero")
 var ErrMissingParameter = errors.New("missing parameter")
 var ErrUnsupportedParameter = errors.New("unsupported parameter")
 var ErrDuplicatedParameters = errors.New("duplicated parameters")
-var ErrLimitedIndexCreation = errors.New("index creation is only supported on empty tables")
+var ErrTooManyRowsToIndex = errors.New("too many rows to be indexed within a single transaction")
 var ErrTooManyRows = errors.New("too many rows")
 var ErrAlreadyClosed = store.ErrAlreadyClosed
 var ErrAmbiguousSelector = errors.New("ambiguous selector")
 var ErrUnsupportedCast = fmt.Errorf("%w: unsupported cast", ErrInvalidValue)
 var ErrColumnMismatchInUnionStmt = errors.New("column mismatch in union statement")
 
+// UniqueConstraintViolation is returned when a row would hold the same values as another row
+// in the columns of a unique index, it matches store.ErrKeyAlreadyExists when using errors.Is
+type UniqueConstraintViolation struct {
+	Table   string
+	Index   string
+	Columns []string
+}
+
+func newUniqueConstraintViolation(index *Index) *UniqueConstraintViolation {
+	cols := make([]string, len(index.cols))
+
+	for i, col := range index.cols {
+		cols[i] = col.colName
+	}
+
+	return &UniqueConstraintViolation{
+		Table:   index.table.name,
+		Index:   index.Name(),
+		Columns: cols,
+	}
+}
+
+func (v *UniqueConstraintViolation) Error() string {
+	return fmt.Sprintf("%s: unique index '%s' over columns (%s)", store.ErrKeyAlreadyExists, v.Index, strings.Join(v.Columns, ", "))
+}
+
+func (v *UniqueConstraintViolation) Unwrap() error {
+	return store.ErrKeyAlreadyExists
+}
+
 var MaxKeyLen = 512
 
 const EncIDLen = 4
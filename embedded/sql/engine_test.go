@@ -1152,7 +1152,88 @@ func TestCreateIndex(t *testing.T) {
 	require.ErrorIs(t, err, ErrPKCanNotBeNull)
 
 	_, _, err = engine.Exec(context.Background(), nil, "CREATE INDEX ON table1(active)", nil)
-	require.ErrorIs(t, err, ErrLimitedIndexCreation)
+	require.NoError(t, err)
+}
+
+func TestCreateIndexOnNonEmptyTable(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	_, _, err := engine.Exec(context.Background(), nil, "CREATE TABLE table1 (id INTEGER, name VARCHAR[256], age INTEGER, PRIMARY KEY id)", nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		INSERT INTO table1(id, name, age)
+		VALUES (1, 'name1', 30), (2, 'name2', 10), (3, 'name3', 20), (4, 'name3', NULL)`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "DELETE FROM table1 WHERE id = 2", nil)
+	require.NoError(t, err)
+
+	t.Run("unique index creation should fail when existing rows violate the constraint", func(t *testing.T) {
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE UNIQUE INDEX ON table1(name)", nil)
+		require.ErrorIs(t, err, store.ErrKeyAlreadyExists)
+
+		_, err = engine.Query(context.Background(), nil, "SELECT * FROM table1 USE INDEX ON (name)", nil)
+		require.ErrorIs(t, err, ErrNoAvailableIndex)
+	})
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE INDEX ON table1(age)", nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO table1(id, name, age) VALUES (5, 'name5', 25)", nil)
+	require.NoError(t, err)
+
+	r, err := engine.Query(context.Background(), nil, "SELECT id FROM table1 USE INDEX ON (age) WHERE age > 0 ORDER BY age", nil)
+	require.NoError(t, err)
+	defer r.Close()
+
+	for _, id := range []int64{3, 5, 1} {
+		row, err := r.Read(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, id, row.ValuesByPosition[0].RawValue())
+	}
+
+	_, err = r.Read(context.Background())
+	require.ErrorIs(t, err, ErrNoMoreRows)
+}
+
+func TestCreateIndexExceedingMaxTxEntries(t *testing.T) {
+	engine, _ := setupCommonTestWithOptions(t, store.DefaultOptions().WithMaxTxEntries(20))
+
+	_, _, err := engine.Exec(context.Background(), nil, "CREATE TABLE table1 (id INTEGER, age INTEGER, PRIMARY KEY id)", nil)
+	require.NoError(t, err)
+
+	for i := 0; i < 30; i++ {
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO table1(id, age) VALUES (@id, @age)", map[string]interface{}{"id": i, "age": 30 - i})
+		require.NoError(t, err)
+	}
+
+	// an index entry is written per existing row within the same transaction
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE INDEX ON table1(age)", nil)
+	require.ErrorIs(t, err, ErrTooManyRowsToIndex)
+
+	_, err = engine.Query(context.Background(), nil, "SELECT * FROM table1 USE INDEX ON (age)", nil)
+	require.ErrorIs(t, err, ErrNoAvailableIndex)
+
+	_, _, err = engine.Exec(context.Background(), nil, "DELETE FROM table1 WHERE id >= 10", nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE INDEX ON table1(age)", nil)
+	require.NoError(t, err)
+
+	r, err := engine.Query(context.Background(), nil, "SELECT id FROM table1 USE INDEX ON (age) ORDER BY age", nil)
+	require.NoError(t, err)
+	defer r.Close()
+
+	// every remaining row is indexed
+	for id := int64(9); id >= 0; id-- {
+		row, err := r.Read(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, id, row.ValuesByPosition[0].RawValue())
+	}
+
+	_, err = r.Read(context.Background())
+	require.ErrorIs(t, err, ErrNoMoreRows)
 }
 
 func TestUpsertInto(t *testing.T) {
@@ -1412,6 +1493,11 @@ func TestAutoIncrementPK(t *testing.T) {
 	_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO table1(id, title) VALUES (1, 'name2')", nil)
 	require.ErrorIs(t, err, store.ErrKeyAlreadyExists)
 
+	var violation *UniqueConstraintViolation
+	require.ErrorAs(t, err, &violation)
+	require.Equal(t, "table1", violation.Table)
+	require.Equal(t, []string{"id"}, violation.Columns)
+
 	_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO table1(id, title) VALUES (1, 'name2') ON CONFLICT DO NOTHING", nil)
 	require.NoError(t, err)
 
@@ -2629,6 +2715,12 @@ func TestIndexing(t *testing.T) {
 	t.Run("should fail due to unique index", func(t *testing.T) {
 		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO table1 (ts, title, amount, active) VALUES (1, 'title1', 10, true), (2, 'title1', 10, false)", nil)
 		require.ErrorIs(t, err, store.ErrKeyAlreadyExists)
+
+		var violation *UniqueConstraintViolation
+		require.ErrorAs(t, err, &violation)
+		require.Equal(t, "table1", violation.Table)
+		require.Equal(t, []string{"title", "amount"}, violation.Columns)
+		require.Contains(t, violation.Error(), "title, amount")
 	})
 
 	t.Run("should fail due non-available index", func(t *testing.T) {
@@ -3587,6 +3679,59 @@ func TestQueryWithInClause(t *testing.T) {
 		err = r.Close()
 		require.NoError(t, err)
 	})
+
+	t.Run("in clause should succeed reading rows within the range of the listed values", func(t *testing.T) {
+		r, err := engine.Query(context.Background(), nil, "SELECT id FROM table1 WHERE id IN (7, 3, 5) OR id IN (9)", nil)
+		require.NoError(t, err)
+
+		for _, id := range []int64{3, 5, 7, 9} {
+			row, err := r.Read(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, id, row.ValuesByPosition[0].RawValue())
+		}
+
+		_, err = r.Read(context.Background())
+		require.ErrorIs(t, err, ErrNoMoreRows)
+
+		err = r.Close()
+		require.NoError(t, err)
+	})
+
+	t.Run("in clause with an empty list should not match any row", func(t *testing.T) {
+		params := map[string]interface{}{"id": 1}
+
+		r, err := engine.QueryPreparedStmt(context.Background(), nil, NewSelectStmt(
+			[]Selector{NewColSelector("table1", "id")},
+			"table1",
+			NewInListExp(NewColSelector("table1", "id"), false, nil),
+			nil,
+			nil,
+			nil,
+		), params)
+		require.NoError(t, err)
+
+		_, err = r.Read(context.Background())
+		require.ErrorIs(t, err, ErrNoMoreRows)
+
+		err = r.Close()
+		require.NoError(t, err)
+	})
+
+	t.Run("in clause should succeed reading using 'NOT IN' clause in join condition", func(t *testing.T) {
+		r, err := engine.Query(context.Background(), nil, "SELECT t1.id, t2.id FROM table1 as t1 INNER JOIN table1 as t2 ON t2.title NOT IN (t1.title) WHERE t1.id = 0 AND t2.id < 2", nil)
+		require.NoError(t, err)
+
+		row, err := r.Read(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, int64(0), row.ValuesByPosition[0].RawValue())
+		require.Equal(t, int64(1), row.ValuesByPosition[1].RawValue())
+
+		_, err = r.Read(context.Background())
+		require.ErrorIs(t, err, ErrNoMoreRows)
+
+		err = r.Close()
+		require.NoError(t, err)
+	})
 }
 
 func TestAggregations(t *testing.T) {
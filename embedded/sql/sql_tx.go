@@ -81,6 +81,24 @@ func (sqlTx *SQLTx) TxHeader() *store.TxHeader {
 	return sqlTx.txHeader
 }
 
+// Get returns the value of a key as seen by the transaction. Keys are used as provided,
+// so entries kept alongside the catalog by layers built on top of the engine can be read
+func (sqlTx *SQLTx) Get(key []byte) (store.ValueRef, error) {
+	return sqlTx.get(key)
+}
+
+// Set adds an entry to the transaction. Keys are used as provided, thus
+// callers must prevent them from clashing with the ones managed by the engine
+func (sqlTx *SQLTx) Set(key []byte, metadata *store.KVMetadata, value []byte) error {
+	return sqlTx.set(key, metadata, value)
+}
+
+// NewKeyReader returns a reader over the entries seen by the transaction. Keys are used as provided,
+// so any entry of the store, not only the ones managed by the engine, can be read
+func (sqlTx *SQLTx) NewKeyReader(rSpec store.KeyReaderSpec) (store.KeyReader, error) {
+	return sqlTx.newKeyReader(rSpec)
+}
+
 func (sqlTx *SQLTx) sqlPrefix() []byte {
 	return sqlTx.engine.prefix
 }
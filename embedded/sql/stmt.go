@@ -410,18 +410,6 @@ func (stmt *CreateIndexStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 		return nil, err
 	}
 
-	// check table is empty
-	{
-		pkPrefix := mapKey(tx.sqlPrefix(), PIndexPrefix, EncodeID(1), EncodeID(table.id), EncodeID(PKIndexID))
-		existKey, err := tx.existKeyWith(pkPrefix, pkPrefix)
-		if err != nil {
-			return nil, err
-		}
-		if existKey {
-			return nil, ErrLimitedIndexCreation
-		}
-	}
-
 	// v={unique {colID1}(ASC|DESC)...{colIDN}(ASC|DESC)}
 	// TODO: currently only ASC order is supported
 	colSpecLen := EncIDLen + 1
@@ -443,6 +431,15 @@ func (stmt *CreateIndexStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 		return nil, err
 	}
 
+	err = tx.indexExistingRows(ctx, index)
+	if errors.Is(err, store.ErrMaxTxEntriesLimitExceeded) {
+		return nil, fmt.Errorf("%w: an index entry is written per row of table '%s' and at most %d entries fit into a transaction",
+			ErrTooManyRowsToIndex, table.name, tx.engine.store.MaxTxEntries())
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	tx.mutatedCatalog = true
 
 	return tx, nil
@@ -453,6 +450,10 @@ type AddColumnStmt struct {
 	colSpec *ColSpec
 }
 
+func NewAddColumnStmt(table string, colSpec *ColSpec) *AddColumnStmt {
+	return &AddColumnStmt{table: table, colSpec: colSpec}
+}
+
 func (stmt *AddColumnStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
 	return nil
 }
@@ -694,7 +695,7 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 
 		if stmt.isInsert {
 			if err == nil && stmt.onConflict == nil {
-				return nil, store.ErrKeyAlreadyExists
+				return nil, newUniqueConstraintViolation(table.primaryIndex)
 			}
 
 			if err == nil && stmt.onConflict != nil {
@@ -800,70 +801,133 @@ func (tx *SQLTx) doUpsert(ctx context.Context, pkEncVals []byte, valuesByColID m
 			}
 		}
 
-		var prefix string
-		var encodedValues [][]byte
-		var val []byte
-
-		if index.IsUnique() {
-			prefix = UIndexPrefix
-			encodedValues = make([][]byte, 3+len(index.cols))
-			val = pkEncVals
-		} else {
-			prefix = SIndexPrefix
-			encodedValues = make([][]byte, 4+len(index.cols))
-			encodedValues[len(encodedValues)-1] = pkEncVals
+		err = tx.setIndexEntry(index, pkEncVals, valuesByColID)
+		if err != nil {
+			return err
 		}
+	}
 
-		encodedValues[0] = EncodeID(1)
-		encodedValues[1] = EncodeID(table.id)
-		encodedValues[2] = EncodeID(index.id)
+	tx.updatedRows++
 
-		indexKeyLen := 0
+	return nil
+}
 
-		for i, col := range index.cols {
-			rval, specified := valuesByColID[col.id]
-			if !specified {
-				rval = &NullValue{t: col.colType}
-			}
+// setIndexEntry creates the entry of a secondary index for the row identified by pkEncVals
+func (tx *SQLTx) setIndexEntry(index *Index, pkEncVals []byte, valuesByColID map[uint32]TypedValue) error {
+	var prefix string
+	var encodedValues [][]byte
+	var val []byte
 
-			encVal, n, err := EncodeValueAsKey(rval, col.colType, col.MaxLen())
-			if err != nil {
-				return fmt.Errorf("%w: index on '%s' and column '%s'", err, index.Name(), col.colName)
-			}
+	if index.IsUnique() {
+		prefix = UIndexPrefix
+		encodedValues = make([][]byte, 3+len(index.cols))
+		val = pkEncVals
+	} else {
+		prefix = SIndexPrefix
+		encodedValues = make([][]byte, 4+len(index.cols))
+		encodedValues[len(encodedValues)-1] = pkEncVals
+	}
 
-			if n > MaxKeyLen {
-				return fmt.Errorf("%w: can not index entry for column '%s'. Max key length for variable columns is %d", ErrLimitedKeyType, col.colName, MaxKeyLen)
-			}
+	encodedValues[0] = EncodeID(1)
+	encodedValues[1] = EncodeID(index.table.id)
+	encodedValues[2] = EncodeID(index.id)
 
-			indexKeyLen += n
+	indexKeyLen := 0
 
-			encodedValues[i+3] = encVal
+	for i, col := range index.cols {
+		rval, specified := valuesByColID[col.id]
+		if !specified {
+			rval = &NullValue{t: col.colType}
 		}
 
-		if indexKeyLen > MaxKeyLen {
-			return fmt.Errorf("%w: can not index entry using columns '%v'. Max key length is %d", ErrLimitedKeyType, index.cols, MaxKeyLen)
+		encVal, n, err := EncodeValueAsKey(rval, col.colType, col.MaxLen())
+		if err != nil {
+			return fmt.Errorf("%w: index on '%s' and column '%s'", err, index.Name(), col.colName)
 		}
 
-		mkey := mapKey(tx.sqlPrefix(), prefix, encodedValues...)
+		if n > MaxKeyLen {
+			return fmt.Errorf("%w: can not index entry for column '%s'. Max key length for variable columns is %d", ErrLimitedKeyType, col.colName, MaxKeyLen)
+		}
 
-		if index.IsUnique() {
-			// mkey must not exist
-			_, err := tx.get(mkey)
-			if err == nil {
-				return store.ErrKeyAlreadyExists
-			}
-			if err != store.ErrKeyNotFound {
-				return err
-			}
+		indexKeyLen += n
+
+		encodedValues[i+3] = encVal
+	}
+
+	if indexKeyLen > MaxKeyLen {
+		return fmt.Errorf("%w: can not index entry using columns '%v'. Max key length is %d", ErrLimitedKeyType, index.cols, MaxKeyLen)
+	}
+
+	mkey := mapKey(tx.sqlPrefix(), prefix, encodedValues...)
+
+	if index.IsUnique() {
+		// mkey must not exist
+		_, err := tx.get(mkey)
+		if err == nil {
+			return newUniqueConstraintViolation(index)
+		}
+		if err != store.ErrKeyNotFound {
+			return err
 		}
+	}
+
+	return tx.set(mkey, nil, val)
+}
 
-		err = tx.set(mkey, nil, val)
+// indexExistingRows creates the entries of a newly created index for every row already in the table
+// within the same transaction. Rows are read before writing any entry, as entries are written into the
+// snapshot being read, thus no more rows than entries fit into a transaction are read
+func (tx *SQLTx) indexExistingRows(ctx context.Context, index *Index) error {
+	table := index.table
+
+	r, err := newRawRowReader(tx, nil, table, period{}, table.name, &ScanSpecs{Index: table.primaryIndex})
+	if err != nil {
+		return err
+	}
+
+	var rows []map[uint32]TypedValue
+
+	for {
+		row, err := r.Read(ctx)
+		if err == ErrNoMoreRows {
+			break
+		}
 		if err != nil {
+			r.Close()
 			return err
 		}
+
+		if len(rows) == tx.engine.store.MaxTxEntries() {
+			r.Close()
+			return store.ErrMaxTxEntriesLimitExceeded
+		}
+
+		valuesByColID := make(map[uint32]TypedValue, len(row.ValuesBySelector))
+
+		for _, col := range table.cols {
+			encSel := EncodeSelector("", table.name, col.colName)
+			valuesByColID[col.id] = row.ValuesBySelector[encSel]
+		}
+
+		rows = append(rows, valuesByColID)
 	}
 
-	tx.updatedRows++
+	err = r.Close()
+	if err != nil {
+		return err
+	}
+
+	for _, valuesByColID := range rows {
+		pkEncVals, err := encodedPK(table, valuesByColID)
+		if err != nil {
+			return err
+		}
+
+		err = tx.setIndexEntry(index, pkEncVals, valuesByColID)
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -1565,6 +1629,11 @@ type Timestamp struct {
 	val time.Time
 }
 
+// NewTimestamp returns a timestamp value, truncated to microseconds as it's the precision timestamps are stored with
+func NewTimestamp(val time.Time) *Timestamp {
+	return &Timestamp{val: val.Truncate(time.Microsecond).UTC()}
+}
+
 func (v *Timestamp) Type() SQLValueType {
 	return TimestampType
 }
@@ -2184,6 +2253,12 @@ func NewSelectStmt(
 	}
 }
 
+// WithGroupBy sets the columns rows are grouped by, rows must be sorted by them
+func (stmt *SelectStmt) WithGroupBy(groupBy []*ColSelector) *SelectStmt {
+	stmt.groupBy = groupBy
+	return stmt
+}
+
 func (stmt *SelectStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
 	_, err := stmt.execAt(ctx, tx, nil)
 	if err != nil {
@@ -3699,6 +3774,10 @@ type InListExp struct {
 	values []ValueExp
 }
 
+func NewInListExp(val ValueExp, notIn bool, values []ValueExp) *InListExp {
+	return &InListExp{val: val, notIn: notIn, values: values}
+}
+
 func (bexp *InListExp) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
 	t, err := bexp.val.inferType(cols, params, implicitTable)
 	if err != nil {
@@ -3787,6 +3866,7 @@ func (bexp *InListExp) reduceSelectors(row *Row, implicitTable string) ValueExp
 
 	return &InListExp{
 		val:    bexp.val.reduceSelectors(row, implicitTable),
+		notIn:  bexp.notIn,
 		values: values,
 	}
 }
@@ -3796,8 +3876,76 @@ func (bexp *InListExp) isConstant() bool {
 }
 
 func (bexp *InListExp) selectorRanges(table *Table, asTable string, params map[string]interface{}, rangesByColID map[uint32]*typedValueRange) error {
-	// TODO: may be determiined by smallest and bigggest value in the list
-	return nil
+	if bexp.notIn || len(bexp.values) == 0 {
+		return nil
+	}
+
+	sel, isSel := bexp.val.(*ColSelector)
+	if !isSel {
+		return nil
+	}
+
+	aggFn, t, col := sel.resolve(table.name)
+	if aggFn != "" || t != asTable {
+		return nil
+	}
+
+	column, err := table.GetColumnByName(col)
+	if err != nil {
+		// errors are reported when evaluating the expression
+		return nil
+	}
+
+	// the range is determined by the smallest and biggest values in the list,
+	// it's left unrestricted if any of them is not a constant of the type of the column
+	var minVal, maxVal TypedValue
+
+	for _, v := range bexp.values {
+		if !v.isConstant() {
+			return nil
+		}
+
+		sv, err := v.substitute(params)
+		if err != nil {
+			return nil
+		}
+
+		rval, err := sv.reduce(nil, nil, table.name)
+		if err != nil || rval.IsNull() || rval.Type() != column.colType {
+			return nil
+		}
+
+		if minVal == nil {
+			minVal = rval
+			maxVal = rval
+			continue
+		}
+
+		cmpMin, err := rval.Compare(minVal)
+		if err != nil {
+			return nil
+		}
+
+		if cmpMin < 0 {
+			minVal = rval
+		}
+
+		cmpMax, err := rval.Compare(maxVal)
+		if err != nil {
+			return nil
+		}
+
+		if cmpMax > 0 {
+			maxVal = rval
+		}
+	}
+
+	err = updateRangeFor(column.id, minVal, GE, rangesByColID)
+	if err != nil {
+		return err
+	}
+
+	return updateRangeFor(column.id, maxVal, LE, rangesByColID)
 }
 
 type FnDataSourceStmt struct {
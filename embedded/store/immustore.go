@@ -41,6 +41,7 @@ import (
 	"github.com/codenotary/immudb/embedded/multierr"
 	"github.com/codenotary/immudb/embedded/tbtree"
 	"github.com/codenotary/immudb/embedded/watchers"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/codenotary/immudb/embedded/logger"
 )
@@ -93,6 +94,8 @@ var ErrInvalidPreconditionNull = fmt.Errorf("%w: null", ErrInvalidPrecondition)
 var ErrInvalidPreconditionNullKey = fmt.Errorf("%w: %v", ErrInvalidPrecondition, ErrNullKey)
 var ErrInvalidPreconditionMaxKeyLenExceeded = fmt.Errorf("%w: %v", ErrInvalidPrecondition, ErrMaxKeyLenExceeded)
 var ErrInvalidPreconditionInvalidTxID = fmt.Errorf("%w: invalid transaction ID", ErrInvalidPrecondition)
+var ErrInvalidPreconditionInvalidTime = fmt.Errorf("%w: invalid time", ErrInvalidPrecondition)
+var ErrInvalidPreconditionConflict = fmt.Errorf("%w: conflicting preconditions", ErrInvalidPrecondition)
 
 var ErrSourceTxNewerThanTargetTx = fmt.Errorf("%w: source tx is newer than target tx", ErrIllegalArguments)
 
@@ -214,6 +217,8 @@ type ImmuStore struct {
 	mutex sync.Mutex
 
 	compactionDisabled bool
+
+	metricsPreconditionFailures *prometheus.CounterVec
 }
 
 type refVLog struct {
@@ -655,6 +660,10 @@ func OpenWith(path string, vLogs []appendable.Appendable, txLog, cLog appendable
 		_valBs: make([]byte, maxValueLen),
 
 		compactionDisabled: opts.CompactionDisabled,
+
+		metricsPreconditionFailures: metricsPreconditionFailures.MustCurryWith(prometheus.Labels{
+			"db": filepath.Base(path),
+		}),
 	}
 
 	if store.aht.Size() > precommittedTxID {
@@ -875,7 +884,7 @@ func (s *ImmuStore) NewTxHolderPool(poolSize int, preallocated bool) (TxPool, er
 }
 
 func (s *ImmuStore) syncSnapshot() (*Snapshot, error) {
-	snap, err := s.indexer.index.SyncSnapshot()
+	snap, err := s.indexer.SyncSnapshot()
 	if err != nil {
 		return nil, err
 	}
@@ -1444,7 +1453,7 @@ func (s *ImmuStore) precommit(ctx context.Context, otx *OngoingTx, hdr *TxHeader
 		var waitForIndexingUpto uint64
 
 		if otx.unsafeMVCC {
-			waitForIndexingUpto = s.mandatoryMVCCUpToTxID
+			waitForIndexingUpto = s.MandatoryMVCCUpToTxID()
 		} else {
 			// Preconditions must be executed with up-to-date tree
 			waitForIndexingUpto = currPrecomittedTxID
@@ -1467,7 +1476,10 @@ func (s *ImmuStore) precommit(ctx context.Context, otx *OngoingTx, hdr *TxHeader
 	}
 
 	if otx.requireMVCCOnFollowingTxs {
+		// guarded by commitStateRWMutex as it's read when creating new transactions
+		s.commitStateRWMutex.Lock()
 		s.mandatoryMVCCUpToTxID = tx.header.ID
+		s.commitStateRWMutex.Unlock()
 	}
 
 	return tx.Header(), err
@@ -2460,50 +2472,45 @@ func (s *ImmuStore) ExportTx(txID uint64, allowPrecommitted bool, skipIntegrityC
 	return buf.Bytes(), nil
 }
 
-func (s *ImmuStore) ReplicateTx(ctx context.Context, exportedTx []byte, skipIntegrityCheck bool, waitForIndexing bool) (*TxHeader, error) {
+// decodeExportedTx decodes a transaction as exported by ExportTx. When values are truncated,
+// the value of each entry holds the digest of the actual value
+func decodeExportedTx(exportedTx []byte) (hdr *TxHeader, entries []*EntrySpec, isTruncated bool, err error) {
 	if len(exportedTx) == 0 {
-		return nil, ErrIllegalArguments
+		return nil, nil, false, ErrIllegalArguments
 	}
 
 	i := 0
 
 	if len(exportedTx) < lszSize {
-		return nil, ErrIllegalArguments
+		return nil, nil, false, ErrIllegalArguments
 	}
 
 	hdrLen := int(binary.BigEndian.Uint32(exportedTx[i:]))
 	i += lszSize
 
 	if len(exportedTx) < i+hdrLen {
-		return nil, ErrIllegalArguments
+		return nil, nil, false, ErrIllegalArguments
 	}
 
-	hdr := &TxHeader{}
-	err := hdr.ReadFrom(exportedTx[i : i+hdrLen])
+	hdr = &TxHeader{}
+	err = hdr.ReadFrom(exportedTx[i : i+hdrLen])
 	if err != nil {
-		return nil, err
+		return nil, nil, false, err
 	}
 	i += hdrLen
 
-	txSpec, err := s.NewWriteOnlyTx(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	txSpec.metadata = hdr.Metadata
-
-	var entries []*EntrySpec = make([]*EntrySpec, 0)
+	entries = make([]*EntrySpec, 0)
 
 	for e := 0; e < hdr.NEntries; e++ {
 		if len(exportedTx) < i+2*sszSize+lszSize {
-			return nil, ErrIllegalArguments
+			return nil, nil, false, ErrIllegalArguments
 		}
 
 		kLen := int(binary.BigEndian.Uint16(exportedTx[i:]))
 		i += sszSize
 
 		if len(exportedTx) < i+sszSize+lszSize+kLen {
-			return nil, ErrIllegalArguments
+			return nil, nil, false, ErrIllegalArguments
 		}
 
 		key := make([]byte, kLen)
@@ -2514,7 +2521,7 @@ func (s *ImmuStore) ReplicateTx(ctx context.Context, exportedTx []byte, skipInte
 		i += sszSize
 
 		if len(exportedTx) < i+mdLen {
-			return nil, ErrIllegalArguments
+			return nil, nil, false, ErrIllegalArguments
 		}
 
 		var md *KVMetadata
@@ -2524,7 +2531,7 @@ func (s *ImmuStore) ReplicateTx(ctx context.Context, exportedTx []byte, skipInte
 
 			err := md.unsafeReadFrom(exportedTx[i : i+mdLen])
 			if err != nil {
-				return nil, err
+				return nil, nil, false, err
 			}
 			i += mdLen
 		}
@@ -2534,7 +2541,7 @@ func (s *ImmuStore) ReplicateTx(ctx context.Context, exportedTx []byte, skipInte
 		i += lszSize
 
 		if len(exportedTx) < i+vLen {
-			return nil, ErrIllegalArguments
+			return nil, nil, false, ErrIllegalArguments
 		}
 
 		entries = append(entries, &EntrySpec{
@@ -2546,31 +2553,45 @@ func (s *ImmuStore) ReplicateTx(ctx context.Context, exportedTx []byte, skipInte
 		i += vLen
 	}
 
-	var isTruncated bool
-
 	// check if there is truncated value information in the transaction
 	if i < len(exportedTx) {
 		// information for truncated value
 		tLen := int(binary.BigEndian.Uint16(exportedTx[i:]))
 		i += sszSize
 		if len(exportedTx) < i+tLen {
-			return nil, ErrIllegalArguments
+			return nil, nil, false, ErrIllegalArguments
 		}
 
 		v := exportedTx[i : i+tLen]
 		// v[0] == 1 means that the value is truncated
 		// validate that the value is either 0 or 1
 		if len(v) > 0 && v[0] > 1 {
-			return nil, ErrIllegalTruncationArgument
+			return nil, nil, false, ErrIllegalTruncationArgument
 		}
 		isTruncated = v[0] == 1
 		i += tLen
 	}
 
 	if i != len(exportedTx) {
-		return nil, ErrIllegalArguments
+		return nil, nil, false, ErrIllegalArguments
+	}
+
+	return hdr, entries, isTruncated, nil
+}
+
+func (s *ImmuStore) ReplicateTx(ctx context.Context, exportedTx []byte, skipIntegrityCheck bool, waitForIndexing bool) (*TxHeader, error) {
+	hdr, entries, isTruncated, err := decodeExportedTx(exportedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	txSpec, err := s.NewWriteOnlyTx(ctx)
+	if err != nil {
+		return nil, err
 	}
 
+	txSpec.metadata = hdr.Metadata
+
 	// add entries to tx
 	for _, e := range entries {
 		var err error
@@ -2622,6 +2643,73 @@ func (s *ImmuStore) ReplicateTx(ctx context.Context, exportedTx []byte, skipInte
 	return txHdr, nil
 }
 
+// ExportedTxKeys returns the keys of the entries of a transaction as exported by ExportTx
+func ExportedTxKeys(exportedTx []byte) ([][]byte, error) {
+	_, entries, _, err := decodeExportedTx(exportedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([][]byte, len(entries))
+
+	for i, e := range entries {
+		keys[i] = e.Key
+	}
+
+	return keys, nil
+}
+
+// TruncateExportedTx replaces the values of an exported transaction with their digests, as done by ExportTx
+// when the values of the transaction have been truncated. The transaction is replicated with the same header,
+// thus keeping the replica verifiable, but its values are not available on the replica
+func TruncateExportedTx(exportedTx []byte) ([]byte, error) {
+	_, entries, isTruncated, err := decodeExportedTx(exportedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	if isTruncated {
+		return exportedTx, nil
+	}
+
+	hdrLen := int(binary.BigEndian.Uint32(exportedTx))
+
+	var buf bytes.Buffer
+
+	// the header is kept as exported
+	buf.Write(exportedTx[:lszSize+hdrLen])
+
+	var b [lszSize]byte
+
+	for _, e := range entries {
+		binary.BigEndian.PutUint16(b[:], uint16(len(e.Key)))
+		buf.Write(b[:sszSize])
+		buf.Write(e.Key)
+
+		var md []byte
+
+		if e.Metadata != nil {
+			md = e.Metadata.Bytes()
+		}
+
+		binary.BigEndian.PutUint16(b[:], uint16(len(md)))
+		buf.Write(b[:sszSize])
+		buf.Write(md)
+
+		hVal := sha256.Sum256(e.Value)
+
+		binary.BigEndian.PutUint32(b[:], uint32(len(hVal)))
+		buf.Write(b[:])
+		buf.Write(hVal[:])
+	}
+
+	binary.BigEndian.PutUint16(b[:], 1)
+	buf.Write(b[:sszSize])
+	buf.WriteByte(1)
+
+	return buf.Bytes(), nil
+}
+
 func (s *ImmuStore) FirstTxSince(ts time.Time) (*TxHeader, error) {
 	left := uint64(1)
 	right := s.LastCommittedTxID()
@@ -2969,6 +3057,9 @@ func (s *ImmuStore) validatePreconditions(preconditions []Precondition) error {
 		return ErrInvalidPreconditionTooMany
 	}
 
+	mustNotExist := make(map[string]struct{})
+	notModifiedAfterTx := make(map[string]struct{})
+
 	for _, c := range preconditions {
 		if c == nil {
 			return ErrInvalidPreconditionNull
@@ -2978,10 +3069,101 @@ func (s *ImmuStore) validatePreconditions(preconditions []Precondition) error {
 		if err != nil {
 			return err
 		}
+
+		switch c := c.(type) {
+		case *PreconditionKeyMustNotExist:
+			mustNotExist[string(c.Key)] = struct{}{}
+		case *PreconditionKeyNotModifiedAfterTx:
+			if c.TxID == ReadSnapshotTxID {
+				// only resolved when added to a read-write transaction
+				return fmt.Errorf("%w: no read snapshot to refer to", ErrInvalidPreconditionInvalidTxID)
+			}
+
+			notModifiedAfterTx[string(c.Key)] = struct{}{}
+		}
+	}
+
+	// the last modification of a key can be bounded either by transaction or by time
+	for _, c := range preconditions {
+		c, ok := c.(*PreconditionKeyNotModifiedAfterTime)
+		if !ok {
+			continue
+		}
+
+		_, conflict := notModifiedAfterTx[string(c.Key)]
+		if conflict {
+			return fmt.Errorf("%w: %s and KeyNotModifiedAfterTxID over the same key", ErrInvalidPreconditionConflict, c)
+		}
+	}
+
+	// preconditions requiring the key to exist can not be combined with KeyMustNotExist
+	for _, c := range preconditions {
+		var key []byte
+
+		switch c := c.(type) {
+		case *PreconditionKeyMustEqualValue:
+			key = c.Key
+		case *PreconditionKeyMustExistWithValuePrefix:
+			key = c.Key
+		default:
+			continue
+		}
+
+		_, conflict := mustNotExist[string(key)]
+		if conflict {
+			return fmt.Errorf("%w: %s and KeyMustNotExist over the same key", ErrInvalidPreconditionConflict, c)
+		}
 	}
+
 	return nil
 }
 
+// CheckPreconditions evaluates the preconditions against the latest precommitted state without
+// performing any write, the outcome is the one a commit would get with the same preconditions
+func (s *ImmuStore) CheckPreconditions(ctx context.Context, preconditions []Precondition) error {
+	err := s.validatePreconditions(preconditions)
+	if err != nil {
+		return err
+	}
+
+	// preconditions must be evaluated with an up-to-date index
+	err = s.WaitForIndexingUpto(ctx, s.LastPrecommittedTxID())
+	if err != nil {
+		return err
+	}
+
+	snap, err := s.syncSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snap.Close()
+
+	return checkPreconditionsBatch(preconditions, snap, s.metricsPreconditionFailures)
+}
+
+// CheckAllPreconditions evaluates the preconditions as CheckPreconditions does, but every precondition
+// is evaluated even after a failure and a violation is returned for each unsatisfied one, in the provided order.
+// No violation is returned when all the preconditions are satisfied
+func (s *ImmuStore) CheckAllPreconditions(ctx context.Context, preconditions []Precondition) ([]*PreconditionViolation, error) {
+	err := s.validatePreconditions(preconditions)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.WaitForIndexingUpto(ctx, s.LastPrecommittedTxID())
+	if err != nil {
+		return nil, err
+	}
+
+	snap, err := s.syncSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	defer snap.Close()
+
+	return checkAllPreconditionsBatch(preconditions, snap, s.metricsPreconditionFailures)
+}
+
 func (s *ImmuStore) Sync() error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
@@ -40,6 +40,7 @@ import (
 	"github.com/codenotary/immudb/embedded/htree"
 	"github.com/codenotary/immudb/embedded/tbtree"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -944,6 +945,158 @@ func TestImmudbStoreEdgeCases(t *testing.T) {
 		})
 		require.ErrorIs(t, err, ErrInvalidPrecondition)
 		require.ErrorIs(t, err, ErrInvalidPreconditionInvalidTxID)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyModifiedAfterTx{
+				TxID: 1,
+			},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionNullKey)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyModifiedAfterTx{
+				Key:  make([]byte, immuStore.maxKeyLen+1),
+				TxID: 1,
+			},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionMaxKeyLenExceeded)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyModifiedAfterTx{
+				Key:  []byte("key"),
+				TxID: 0,
+			},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionInvalidTxID)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyMustEqualValue{},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionNullKey)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyMustEqualValue{
+				Key: make([]byte, immuStore.maxKeyLen+1),
+			},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionMaxKeyLenExceeded)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyMustEqualValue{
+				Key:   []byte("key"),
+				Value: []byte("value"),
+			},
+			&PreconditionKeyMustNotExist{
+				Key: []byte("key"),
+			},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionConflict)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyMustHaveVersion{},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionNullKey)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyMustHaveVersion{
+				Key: make([]byte, immuStore.maxKeyLen+1),
+			},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionMaxKeyLenExceeded)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyNotModifiedAfterTime{},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionNullKey)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyNotModifiedAfterTime{
+				Key: make([]byte, immuStore.maxKeyLen+1),
+			},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionMaxKeyLenExceeded)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyNotModifiedAfterTime{
+				Key: []byte("key"),
+			},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionInvalidTime)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyNotModifiedAfterTx{
+				Key:  []byte("key"),
+				TxID: 1,
+			},
+			&PreconditionKeyNotModifiedAfterTime{
+				Key:  []byte("key"),
+				Time: time.Now(),
+			},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionConflict)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyMustNotExistUnlessEqual{},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionNullKey)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyMustNotExistUnlessEqual{
+				Key: make([]byte, immuStore.maxKeyLen+1),
+			},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionMaxKeyLenExceeded)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyMustExistWithValuePrefix{},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionNullKey)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyMustExistWithValuePrefix{
+				Key: make([]byte, immuStore.maxKeyLen+1),
+			},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionMaxKeyLenExceeded)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyMustNotExist{
+				Key: []byte("key"),
+			},
+			&PreconditionKeyMustExistWithValuePrefix{
+				Key:    []byte("key"),
+				Prefix: []byte{1},
+			},
+		})
+		require.ErrorIs(t, err, ErrInvalidPrecondition)
+		require.ErrorIs(t, err, ErrInvalidPreconditionConflict)
+
+		err = immuStore.validatePreconditions([]Precondition{
+			&PreconditionKeyMustEqualValue{
+				Key:   []byte("key1"),
+				Value: []byte("value"),
+			},
+			&PreconditionKeyMustNotExist{
+				Key: []byte("key2"),
+			},
+		})
+		require.NoError(t, err)
 	})
 }
 
@@ -2520,6 +2673,99 @@ func TestExportAndReplicateTx(t *testing.T) {
 	require.ErrorIs(t, err, ErrIllegalArguments)
 }
 
+func TestExportAndReplicateTruncatedTx(t *testing.T) {
+	primaryStore, err := Open(t.TempDir(), DefaultOptions())
+	require.NoError(t, err)
+	defer immustoreClose(t, primaryStore)
+
+	replicaStore, err := Open(t.TempDir(), DefaultOptions())
+	require.NoError(t, err)
+	defer immustoreClose(t, replicaStore)
+
+	var hdrs []*TxHeader
+
+	for i := 0; i < 2; i++ {
+		tx, err := primaryStore.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte(fmt.Sprintf("key%d_1", i)), nil, []byte("value1"))
+		require.NoError(t, err)
+
+		md := NewKVMetadata()
+
+		err = md.AsNonIndexable(true)
+		require.NoError(t, err)
+
+		err = tx.Set([]byte(fmt.Sprintf("key%d_2", i)), md, []byte("value2"))
+		require.NoError(t, err)
+
+		hdr, err := tx.Commit(context.Background())
+		require.NoError(t, err)
+
+		hdrs = append(hdrs, hdr)
+	}
+
+	txholder := tempTxHolder(t, primaryStore)
+
+	etx, err := primaryStore.ExportTx(1, false, false, txholder)
+	require.NoError(t, err)
+
+	keys, err := ExportedTxKeys(etx)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("key0_1"), []byte("key0_2")}, keys)
+
+	truncatedEtx, err := TruncateExportedTx(etx)
+	require.NoError(t, err)
+	require.NotEqual(t, etx, truncatedEtx)
+
+	// truncating an already truncated transaction has no effect
+	retruncatedEtx, err := TruncateExportedTx(truncatedEtx)
+	require.NoError(t, err)
+	require.Equal(t, truncatedEtx, retruncatedEtx)
+
+	keys, err = ExportedTxKeys(truncatedEtx)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("key0_1"), []byte("key0_2")}, keys)
+
+	rhdr, err := replicaStore.ReplicateTx(context.Background(), truncatedEtx, false, false)
+	require.NoError(t, err)
+	require.Equal(t, hdrs[0].Alh(), rhdr.Alh())
+
+	// transactions following a truncated one are replicated as usual
+	etx, err = primaryStore.ExportTx(2, false, false, txholder)
+	require.NoError(t, err)
+
+	rhdr, err = replicaStore.ReplicateTx(context.Background(), etx, false, false)
+	require.NoError(t, err)
+	require.Equal(t, hdrs[1].Alh(), rhdr.Alh())
+
+	tx := NewTx(replicaStore.MaxTxEntries(), replicaStore.MaxKeyLen())
+
+	err = replicaStore.ReadTx(1, false, tx)
+	require.NoError(t, err)
+
+	for _, e := range tx.Entries() {
+		val, err := replicaStore.ReadValue(e)
+		require.NoError(t, err)
+		require.Nil(t, val)
+	}
+
+	err = replicaStore.ReadTx(2, false, tx)
+	require.NoError(t, err)
+
+	for _, e := range tx.Entries() {
+		val, err := replicaStore.ReadValue(e)
+		require.NoError(t, err)
+		require.NotNil(t, val)
+	}
+
+	_, err = ExportedTxKeys(nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = TruncateExportedTx(etx[:len(etx)-4])
+	require.ErrorIs(t, err, ErrIllegalArguments)
+}
+
 func TestExportAndReplicateTxCornerCases(t *testing.T) {
 	primaryDir := t.TempDir()
 
@@ -2724,121 +2970,374 @@ func (la *FailingAppendable) Append(bs []byte) (off int64, n int, err error) {
 	return la.Appendable.Append(bs)
 }
 
-func TestImmudbStoreCommitWithPreconditions(t *testing.T) {
+func TestImmudbStoreCommitWithTimePreconditions(t *testing.T) {
 	immuStore, err := Open(t.TempDir(), DefaultOptions().WithMaxConcurrency(1))
 	require.NoError(t, err)
 
 	defer immustoreClose(t, immuStore)
 
-	// set initial value
-	otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
-	require.NoError(t, err)
-
-	err = otx.Set([]byte("key1"), nil, []byte("value1"))
-	require.NoError(t, err)
+	now := time.Now()
 
-	hdr1, err := otx.Commit(context.Background())
+	err = immuStore.UseTimeFunc(func() time.Time { return now })
 	require.NoError(t, err)
 
-	// delete entry
-	otx, err = immuStore.NewTx(context.Background(), DefaultTxOptions())
+	otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
 	require.NoError(t, err)
 
-	err = otx.Delete([]byte("key1"))
+	err = otx.Set([]byte("key1"), nil, []byte("value1"))
 	require.NoError(t, err)
 
 	_, err = otx.Commit(context.Background())
 	require.NoError(t, err)
 
-	t.Run("must not exist constraint should pass when evaluated over a deleted key", func(t *testing.T) {
+	t.Run("not modified after time constraint should pass when the key was modified before", func(t *testing.T) {
 		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
 		require.NoError(t, err)
 
 		err = otx.Set([]byte("key2"), nil, []byte("value2"))
 		require.NoError(t, err)
 
-		err = otx.AddPrecondition(&PreconditionKeyMustNotExist{[]byte("key1")})
+		err = otx.AddPrecondition(&PreconditionKeyNotModifiedAfterTime{Key: []byte("key1"), Time: now})
 		require.NoError(t, err)
 
 		_, err = otx.Commit(context.Background())
 		require.NoError(t, err)
 	})
 
-	t.Run("must exist constraint should pass when evaluated over an existent key", func(t *testing.T) {
+	t.Run("not modified after time constraint should pass when the key does not exist", func(t *testing.T) {
 		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
 		require.NoError(t, err)
 
-		err = otx.Set([]byte("key3"), nil, []byte("value3"))
+		err = otx.Set([]byte("key2"), nil, []byte("value2"))
 		require.NoError(t, err)
 
-		err = otx.AddPrecondition(&PreconditionKeyMustExist{[]byte("key2")})
+		err = otx.AddPrecondition(&PreconditionKeyNotModifiedAfterTime{Key: []byte("nonExistentKey"), Time: now.Add(-time.Hour)})
 		require.NoError(t, err)
 
 		_, err = otx.Commit(context.Background())
 		require.NoError(t, err)
 	})
 
-	t.Run("must not be modified after constraint should not pass when key is deleted after specified tx", func(t *testing.T) {
+	t.Run("not modified after time constraint should not pass when the key was modified after", func(t *testing.T) {
 		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
 		require.NoError(t, err)
 
-		err = otx.Set([]byte("key4"), nil, []byte("value4"))
+		err = otx.Set([]byte("key2"), nil, []byte("value2"))
 		require.NoError(t, err)
 
-		err = otx.AddPrecondition(&PreconditionKeyNotModifiedAfterTx{Key: []byte("key1"), TxID: hdr1.ID})
+		err = otx.AddPrecondition(&PreconditionKeyNotModifiedAfterTime{Key: []byte("key1"), Time: now.Add(-time.Minute)})
 		require.NoError(t, err)
 
 		_, err = otx.Commit(context.Background())
 		require.ErrorIs(t, err, ErrPreconditionFailed)
+		require.Contains(t, err.Error(), "KeyNotModifiedAfterTime")
 	})
 
-	t.Run("must not be modified after constraint should pass when if key does not exist", func(t *testing.T) {
+	t.Run("not modified after time constraint should not pass when the key was deleted after", func(t *testing.T) {
+		later := now.Add(time.Minute)
+
+		err = immuStore.UseTimeFunc(func() time.Time { return later })
+		require.NoError(t, err)
+
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Delete([]byte("key1"))
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.NoError(t, err)
+
 		otx, err = immuStore.NewTx(context.Background(), DefaultTxOptions())
 		require.NoError(t, err)
 
-		err = otx.Set([]byte("key4"), nil, []byte("value4"))
+		err = otx.Set([]byte("key2"), nil, []byte("value2"))
 		require.NoError(t, err)
 
-		err = otx.AddPrecondition(&PreconditionKeyNotModifiedAfterTx{Key: []byte("nonExistentKey"), TxID: 1})
+		err = otx.AddPrecondition(&PreconditionKeyNotModifiedAfterTime{Key: []byte("key1"), Time: now})
 		require.NoError(t, err)
 
 		_, err = otx.Commit(context.Background())
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+
+		err = immuStore.CheckPreconditions(context.Background(), []Precondition{
+			&PreconditionKeyNotModifiedAfterTime{Key: []byte("key1"), Time: later},
+		})
 		require.NoError(t, err)
 	})
+}
 
-	// insert an expirable entry
-	otx, err = immuStore.NewTx(context.Background(), DefaultTxOptions())
+func TestImmudbStoreCommitWithPreconditions(t *testing.T) {
+	immuStore, err := Open(t.TempDir(), DefaultOptions().WithMaxConcurrency(1))
 	require.NoError(t, err)
 
-	md := NewKVMetadata()
-	err = md.ExpiresAt(time.Now().Add(1 * time.Second))
+	defer immustoreClose(t, immuStore)
+
+	// set initial value
+	otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
 	require.NoError(t, err)
 
-	err = otx.Set([]byte("expirableKey"), md, []byte("expirableValue"))
+	err = otx.Set([]byte("key1"), nil, []byte("value1"))
 	require.NoError(t, err)
 
-	hdr, err := otx.Commit(context.Background())
+	hdr1, err := otx.Commit(context.Background())
 	require.NoError(t, err)
 
-	// wait for entry to be expired
-	for i := 0; ; i++ {
-		require.Less(t, i, 20, "entry expiration failed")
+	// delete entry
+	otx, err = immuStore.NewTx(context.Background(), DefaultTxOptions())
+	require.NoError(t, err)
 
-		time.Sleep(100 * time.Millisecond)
+	err = otx.Delete([]byte("key1"))
+	require.NoError(t, err)
 
-		_, err = immuStore.Get([]byte("expirableKey"))
-		if err != nil && errors.Is(err, ErrKeyNotFound) {
-			break
-		}
+	_, err = otx.Commit(context.Background())
+	require.NoError(t, err)
 
+	t.Run("must not exist constraint should pass when evaluated over a deleted key", func(t *testing.T) {
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
 		require.NoError(t, err)
-	}
 
-	t.Run("must not be modified after constraint should not pass when if expired and expiration was set after specified tx", func(t *testing.T) {
-		otx, err = immuStore.NewTx(context.Background(), DefaultTxOptions())
+		err = otx.Set([]byte("key2"), nil, []byte("value2"))
 		require.NoError(t, err)
 
-		err = otx.Set([]byte("key5"), nil, []byte("value5"))
+		err = otx.AddPrecondition(&PreconditionKeyMustNotExist{[]byte("key1")})
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("must exist constraint should pass when evaluated over an existent key", func(t *testing.T) {
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("key3"), nil, []byte("value3"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyMustExist{[]byte("key2")})
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("modified after constraint should pass when key is deleted after specified tx", func(t *testing.T) {
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("key4"), nil, []byte("value4"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyModifiedAfterTx{Key: []byte("key1"), TxID: hdr1.ID})
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("modified after constraint should not pass when key was not modified after specified tx", func(t *testing.T) {
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("key4"), nil, []byte("value4"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyModifiedAfterTx{Key: []byte("key1"), TxID: hdr1.ID + 1})
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+	})
+
+	t.Run("modified after constraint should not pass when key does not exist", func(t *testing.T) {
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("key4"), nil, []byte("value4"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyModifiedAfterTx{Key: []byte("nonExistentKey"), TxID: 1})
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+	})
+
+	t.Run("first failing constraint should be reported when evaluating multiple constraints", func(t *testing.T) {
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("key4"), nil, []byte("value4"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyMustExist{Key: []byte("key3")})
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyMustNotExist{Key: []byte("key1")})
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyMustNotExist{Key: []byte("key3")})
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyMustExist{Key: []byte("key1")})
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+		require.Contains(t, err.Error(), "KeyMustNotExist over key 6b657933 (precondition at index 2)")
+
+		var violation *PreconditionViolation
+		require.ErrorAs(t, err, &violation)
+		require.Equal(t, []byte("key3"), violation.Key)
+		require.Equal(t, "KeyMustNotExist", violation.Precondition)
+		require.Equal(t, 2, violation.Index)
+	})
+
+	t.Run("must exist with value prefix constraint should pass when the current value has the prefix", func(t *testing.T) {
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("key4"), nil, []byte("value4"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyMustExistWithValuePrefix{Key: []byte("key2"), Prefix: []byte("val")})
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("must exist with value prefix constraint should not pass when the current value lacks the prefix", func(t *testing.T) {
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("key4"), nil, []byte("value4"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyMustExistWithValuePrefix{Key: []byte("key2"), Prefix: []byte("other")})
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+	})
+
+	t.Run("must exist with value prefix constraint should not pass when the key is deleted", func(t *testing.T) {
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("key4"), nil, []byte("value4"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyMustExistWithValuePrefix{Key: []byte("key1"), Prefix: nil})
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+	})
+
+	t.Run("must equal value constraint should pass when the current value matches", func(t *testing.T) {
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("key2"), nil, []byte("value2-updated"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyMustEqualValue{Key: []byte("key2"), Value: []byte("value2")})
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("must equal value constraint should not pass when the current value does not match", func(t *testing.T) {
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("key2"), nil, []byte("value2-updated-again"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyMustEqualValue{Key: []byte("key2"), Value: []byte("value2")})
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+	})
+
+	t.Run("must equal value constraint should not pass when the key is deleted", func(t *testing.T) {
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("key4"), nil, []byte("value4"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyMustEqualValue{Key: []byte("key1"), Value: []byte("value1")})
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+	})
+
+	t.Run("must not be modified after constraint should not pass when key is deleted after specified tx", func(t *testing.T) {
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("key4"), nil, []byte("value4"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyNotModifiedAfterTx{Key: []byte("key1"), TxID: hdr1.ID})
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+	})
+
+	t.Run("must not be modified after constraint should pass when if key does not exist", func(t *testing.T) {
+		otx, err = immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("key4"), nil, []byte("value4"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyNotModifiedAfterTx{Key: []byte("nonExistentKey"), TxID: 1})
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.NoError(t, err)
+	})
+
+	// insert an expirable entry
+	otx, err = immuStore.NewTx(context.Background(), DefaultTxOptions())
+	require.NoError(t, err)
+
+	md := NewKVMetadata()
+	err = md.ExpiresAt(time.Now().Add(1 * time.Second))
+	require.NoError(t, err)
+
+	err = otx.Set([]byte("expirableKey"), md, []byte("expirableValue"))
+	require.NoError(t, err)
+
+	hdr, err := otx.Commit(context.Background())
+	require.NoError(t, err)
+
+	// wait for entry to be expired
+	for i := 0; ; i++ {
+		require.Less(t, i, 20, "entry expiration failed")
+
+		time.Sleep(100 * time.Millisecond)
+
+		_, err = immuStore.Get([]byte("expirableKey"))
+		if err != nil && errors.Is(err, ErrKeyNotFound) {
+			break
+		}
+
+		require.NoError(t, err)
+	}
+
+	t.Run("must not be modified after constraint should not pass when if expired and expiration was set after specified tx", func(t *testing.T) {
+		otx, err = immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("key5"), nil, []byte("value5"))
 		require.NoError(t, err)
 
 		err = otx.AddPrecondition(&PreconditionKeyNotModifiedAfterTx{Key: []byte("expirableKey"), TxID: hdr.ID - 1})
@@ -2875,6 +3374,422 @@ func TestImmudbStoreCommitWithPreconditions(t *testing.T) {
 		_, err = otx.Commit(context.Background())
 		require.ErrorIs(t, err, ErrPreconditionFailed)
 	})
+
+	t.Run("must not exist unless equal constraint should write the key when it does not exist", func(t *testing.T) {
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("idempotentKey"), nil, []byte("value1"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyMustNotExistUnlessEqual{Key: []byte("idempotentKey"), Value: []byte("value1")})
+		require.NoError(t, err)
+
+		hdr, err := otx.Commit(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 1, hdr.NEntries)
+	})
+
+	t.Run("must not exist unless equal constraint should skip the write when the key holds the same value", func(t *testing.T) {
+		valRef, err := immuStore.Get([]byte("idempotentKey"))
+		require.NoError(t, err)
+
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("idempotentKey"), nil, []byte("value1"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyMustNotExistUnlessEqual{Key: []byte("idempotentKey"), Value: []byte("value1")})
+		require.NoError(t, err)
+
+		hdr, err := otx.Commit(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, valRef.Tx(), hdr.ID)
+
+		// no duplicate version is written
+		latest, err := immuStore.Get([]byte("idempotentKey"))
+		require.NoError(t, err)
+		require.Equal(t, valRef.Tx(), latest.Tx())
+		require.Equal(t, valRef.HC(), latest.HC())
+	})
+
+	t.Run("must not exist unless equal constraint should only write the rest of entries when the key holds the same value", func(t *testing.T) {
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("idempotentKey"), nil, []byte("value1"))
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("otherKey"), nil, []byte("value1"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyMustNotExistUnlessEqual{Key: []byte("idempotentKey"), Value: []byte("value1")})
+		require.NoError(t, err)
+
+		hdr, err := otx.Commit(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 1, hdr.NEntries)
+
+		tx := tempTxHolder(t, immuStore)
+
+		err = immuStore.ReadTx(hdr.ID, false, tx)
+		require.NoError(t, err)
+		require.Equal(t, []byte("otherKey"), tx.Entries()[0].Key())
+	})
+
+	t.Run("must not exist unless equal constraint should not pass when the key holds a different value", func(t *testing.T) {
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("idempotentKey"), nil, []byte("value2"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyMustNotExistUnlessEqual{Key: []byte("idempotentKey"), Value: []byte("value2")})
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+		require.Contains(t, err.Error(), "KeyMustNotExistUnlessEqual")
+	})
+
+	t.Run("must not exist unless equal constraint should not skip the write when other preconditions fail", func(t *testing.T) {
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("idempotentKey"), nil, []byte("value1"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyMustNotExistUnlessEqual{Key: []byte("idempotentKey"), Value: []byte("value1")})
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyMustExist{Key: []byte("key1")})
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+	})
+
+	t.Run("must have version constraint should pass when the key has the expected number of versions", func(t *testing.T) {
+		for version := uint64(0); version < 3; version++ {
+			otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+			require.NoError(t, err)
+
+			err = otx.Set([]byte("versionedKey"), nil, []byte(fmt.Sprintf("value%d", version)))
+			require.NoError(t, err)
+
+			err = otx.AddPrecondition(&PreconditionKeyMustHaveVersion{Key: []byte("versionedKey"), Version: version})
+			require.NoError(t, err)
+
+			_, err = otx.Commit(context.Background())
+			require.NoError(t, err)
+		}
+
+		// deletion is accounted as a version
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Delete([]byte("versionedKey"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyMustHaveVersion{Key: []byte("versionedKey"), Version: 3})
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.NoError(t, err)
+
+		err = immuStore.CheckPreconditions(context.Background(), []Precondition{
+			&PreconditionKeyMustHaveVersion{Key: []byte("versionedKey"), Version: 4},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("must have version constraint should not pass when the key has a different number of versions", func(t *testing.T) {
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("versionedKey"), nil, []byte("value"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyMustHaveVersion{Key: []byte("versionedKey"), Version: 3})
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+		require.Contains(t, err.Error(), "KeyMustHaveVersion")
+
+		err = immuStore.CheckPreconditions(context.Background(), []Precondition{
+			&PreconditionKeyMustHaveVersion{Key: []byte("nonExistentKey"), Version: 1},
+		})
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+	})
+
+	t.Run("preconditions can be checked without committing", func(t *testing.T) {
+		lastTxID := immuStore.LastCommittedTxID()
+
+		err := immuStore.CheckPreconditions(context.Background(), []Precondition{
+			&PreconditionKeyMustExist{Key: []byte("key2")},
+			&PreconditionKeyMustNotExist{Key: []byte("key1")},
+		})
+		require.NoError(t, err)
+
+		err = immuStore.CheckPreconditions(context.Background(), []Precondition{
+			&PreconditionKeyMustExist{Key: []byte("key2")},
+			&PreconditionKeyMustExist{Key: []byte("key1")},
+		})
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+
+		var violation *PreconditionViolation
+		require.ErrorAs(t, err, &violation)
+		require.Equal(t, []byte("key1"), violation.Key)
+		require.Equal(t, 1, violation.Index)
+
+		err = immuStore.CheckPreconditions(context.Background(), []Precondition{
+			&PreconditionKeyMustExist{},
+		})
+		require.ErrorIs(t, err, ErrInvalidPreconditionNullKey)
+
+		require.Equal(t, lastTxID, immuStore.LastCommittedTxID())
+	})
+
+	t.Run("not modified after the read snapshot should fail when the key was modified after the snapshot was taken", func(t *testing.T) {
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("snapshotKey"), nil, []byte("value1"))
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.NoError(t, err)
+
+		otx, err = immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyNotModifiedAfterTx{Key: []byte("snapshotKey"), TxID: ReadSnapshotTxID})
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("otherKey"), nil, []byte("value"))
+		require.NoError(t, err)
+
+		concurrentTx, err := immuStore.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = concurrentTx.Set([]byte("snapshotKey"), nil, []byte("value2"))
+		require.NoError(t, err)
+
+		_, err = concurrentTx.Commit(context.Background())
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+
+		otx, err = immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyNotModifiedAfterTx{Key: []byte("snapshotKey"), TxID: ReadSnapshotTxID})
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("otherKey"), nil, []byte("value"))
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("not modified after the read snapshot should be rejected when there is no read snapshot", func(t *testing.T) {
+		otx, err := immuStore.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+		defer otx.Cancel()
+
+		err = otx.AddPrecondition(&PreconditionKeyNotModifiedAfterTx{Key: []byte("snapshotKey"), TxID: ReadSnapshotTxID})
+		require.ErrorIs(t, err, ErrInvalidPreconditionInvalidTxID)
+
+		err = immuStore.CheckPreconditions(context.Background(), []Precondition{
+			&PreconditionKeyNotModifiedAfterTx{Key: []byte("snapshotKey"), TxID: ReadSnapshotTxID},
+		})
+		require.ErrorIs(t, err, ErrInvalidPreconditionInvalidTxID)
+	})
+
+	t.Run("all precondition violations can be reported at once", func(t *testing.T) {
+		violations, err := immuStore.CheckAllPreconditions(context.Background(), []Precondition{
+			&PreconditionKeyMustExist{Key: []byte("key2")},
+			&PreconditionKeyMustNotExist{Key: []byte("key1")},
+		})
+		require.NoError(t, err)
+		require.Empty(t, violations)
+
+		violations, err = immuStore.CheckAllPreconditions(context.Background(), []Precondition{
+			&PreconditionKeyMustExist{Key: []byte("missingKey")},
+			&PreconditionKeyMustExist{Key: []byte("key2")},
+			&PreconditionKeyMustExist{Key: []byte("key1")},
+			&PreconditionKeyMustNotExist{Key: []byte("key2")},
+		})
+		require.NoError(t, err)
+		require.Len(t, violations, 3)
+
+		require.Equal(t, []byte("missingKey"), violations[0].Key)
+		require.Equal(t, 0, violations[0].Index)
+		require.Equal(t, []byte("key1"), violations[1].Key)
+		require.Equal(t, 2, violations[1].Index)
+		require.Equal(t, []byte("key2"), violations[2].Key)
+		require.Equal(t, 3, violations[2].Index)
+		require.Equal(t, "KeyMustNotExist", violations[2].Precondition)
+		require.ErrorIs(t, violations[2], ErrPreconditionFailed)
+
+		_, err = immuStore.CheckAllPreconditions(context.Background(), []Precondition{
+			&PreconditionKeyMustExist{Key: []byte("key2")},
+			nil,
+		})
+		require.ErrorIs(t, err, ErrInvalidPreconditionNull)
+	})
+
+	t.Run("precondition failures should be counted by type", func(t *testing.T) {
+		mustExistFailures := immuStore.metricsPreconditionFailures.WithLabelValues("KeyMustExist")
+		mustNotExistFailures := immuStore.metricsPreconditionFailures.WithLabelValues("KeyMustNotExist")
+
+		mustExistCount := testutil.ToFloat64(mustExistFailures)
+		mustNotExistCount := testutil.ToFloat64(mustNotExistFailures)
+
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("key4"), nil, []byte("value"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyMustExist{Key: []byte("missingKey")})
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyMustNotExist{Key: []byte("key2")})
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+
+		// only the reported failure is counted
+		require.Equal(t, mustExistCount+1, testutil.ToFloat64(mustExistFailures))
+		require.Equal(t, mustNotExistCount, testutil.ToFloat64(mustNotExistFailures))
+
+		err = immuStore.CheckPreconditions(context.Background(), []Precondition{
+			&PreconditionKeyMustExist{Key: []byte("key2")},
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, mustExistCount+1, testutil.ToFloat64(mustExistFailures))
+	})
+}
+
+func TestImmudbStoreReadSnapshotPreconditionOnEmptyStore(t *testing.T) {
+	immuStore, err := Open(t.TempDir(), DefaultOptions())
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+	require.NoError(t, err)
+
+	err = otx.AddPrecondition(&PreconditionKeyNotModifiedAfterTx{Key: []byte("snapshotKey"), TxID: ReadSnapshotTxID})
+	require.NoError(t, err)
+
+	err = otx.Set([]byte("snapshotKey"), nil, []byte("value1"))
+	require.NoError(t, err)
+
+	_, err = otx.Commit(context.Background())
+	require.NoError(t, err)
+
+	immuStore, err = Open(t.TempDir(), DefaultOptions())
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	otx, err = immuStore.NewTx(context.Background(), DefaultTxOptions())
+	require.NoError(t, err)
+
+	err = otx.AddPrecondition(&PreconditionKeyNotModifiedAfterTx{Key: []byte("snapshotKey"), TxID: ReadSnapshotTxID})
+	require.NoError(t, err)
+
+	err = otx.Set([]byte("otherKey"), nil, []byte("value"))
+	require.NoError(t, err)
+
+	concurrentTx, err := immuStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = concurrentTx.Set([]byte("snapshotKey"), nil, []byte("value2"))
+	require.NoError(t, err)
+
+	_, err = concurrentTx.Commit(context.Background())
+	require.NoError(t, err)
+
+	_, err = otx.Commit(context.Background())
+	require.ErrorIs(t, err, ErrPreconditionFailed)
+}
+
+func TestImmudbStoreSkippedWriteDoesNotBlockIndexing(t *testing.T) {
+	immuStore, err := Open(t.TempDir(), DefaultOptions().WithSynced(false))
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	otx, err := immuStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = otx.Set([]byte("idempotentKey"), nil, []byte("value1"))
+	require.NoError(t, err)
+
+	_, err = otx.Commit(context.Background())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	done := make(chan struct{})
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+
+		go func(w int) {
+			defer wg.Done()
+
+			// indexing these transactions concurrently locks the index for writing
+			for i := 0; ; i++ {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				otx, err := immuStore.NewWriteOnlyTx(ctx)
+				require.NoError(t, err)
+
+				err = otx.Set([]byte(fmt.Sprintf("concurrentKey%d_%d", w, i)), nil, []byte("value"))
+				require.NoError(t, err)
+
+				_, err = otx.Commit(ctx)
+				require.NoError(t, err)
+			}
+		}(w)
+	}
+
+	// the write is skipped and the remaining precondition is checked, both read from the index
+	for i := 0; i < 10000; i++ {
+		otx, err := immuStore.NewWriteOnlyTx(ctx)
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("idempotentKey"), nil, []byte("value1"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyMustNotExistUnlessEqual{Key: []byte("idempotentKey"), Value: []byte("value1")})
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionKeyMustExist{Key: []byte("idempotentKey")})
+		require.NoError(t, err)
+
+		_, err = otx.Commit(ctx)
+		require.NoError(t, err)
+	}
+
+	close(done)
+	wg.Wait()
 }
 
 func BenchmarkSyncedAppend(b *testing.B) {
@@ -4377,6 +5292,57 @@ func TestImmudbStoreMVCCBoundaries(t *testing.T) {
 	})
 }
 
+func TestImmudbStoreMandatoryMVCC(t *testing.T) {
+	immuStore, err := Open(t.TempDir(), DefaultOptions().WithSynced(false).WithMaxConcurrency(10))
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+
+		go func(w int) {
+			defer wg.Done()
+
+			for i := 0; i < 50; i++ {
+				otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+				require.NoError(t, err)
+
+				err = otx.Set([]byte(fmt.Sprintf("key%d_%d", w, i)), nil, []byte("value"))
+				require.NoError(t, err)
+
+				err = otx.RequireMVCCOnFollowingTxs(true)
+				require.NoError(t, err)
+
+				hdr, err := otx.Commit(context.Background())
+				require.NoError(t, err)
+				require.GreaterOrEqual(t, immuStore.MandatoryMVCCUpToTxID(), hdr.ID)
+
+				// following transactions are bound to snapshots including the committed one
+				otx, err = immuStore.NewTx(context.Background(), DefaultTxOptions().WithUnsafeMVCC(true))
+				require.NoError(t, err)
+				require.GreaterOrEqual(t, otx.snap.Ts(), hdr.ID)
+
+				_, err = otx.Get([]byte(fmt.Sprintf("key%d_%d", w, i)))
+				require.NoError(t, err)
+
+				err = otx.Set([]byte(fmt.Sprintf("otherKey%d_%d", w, i)), nil, []byte("value"))
+				require.NoError(t, err)
+
+				err = otx.AddPrecondition(&PreconditionKeyMustExist{Key: []byte(fmt.Sprintf("key%d_%d", w, i))})
+				require.NoError(t, err)
+
+				_, err = otx.Commit(context.Background())
+				require.NoError(t, err)
+			}
+		}(w)
+	}
+
+	wg.Wait()
+}
+
 func TestImmudbStoreWithClosedContext(t *testing.T) {
 	immuStore, err := Open(t.TempDir(), DefaultOptions())
 	require.NoError(t, err)
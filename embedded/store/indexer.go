@@ -202,6 +202,17 @@ func (idx *indexer) Snapshot() (*tbtree.Snapshot, error) {
 	return idx.index.Snapshot()
 }
 
+func (idx *indexer) SyncSnapshot() (*tbtree.Snapshot, error) {
+	idx.mutex.Lock()
+	defer idx.mutex.Unlock()
+
+	if idx.closed {
+		return nil, ErrAlreadyClosed
+	}
+
+	return idx.index.SyncSnapshot()
+}
+
 func (idx *indexer) SnapshotMustIncludeTxIDWithRenewalPeriod(txID uint64, renewalPeriod time.Duration) (*tbtree.Snapshot, error) {
 	idx.mutex.Lock()
 	defer idx.mutex.Unlock()
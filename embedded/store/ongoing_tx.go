@@ -23,6 +23,8 @@ import (
 	"errors"
 	"fmt"
 	"time"
+
+	"github.com/codenotary/immudb/embedded/watchers"
 )
 
 // OngoingTx (no-thread safe) represents an interactive or incremental transaction with support of RYOW.
@@ -276,6 +278,20 @@ func (tx *OngoingTx) AddPrecondition(c Precondition) error {
 		return err
 	}
 
+	if nm, ok := c.(*PreconditionKeyNotModifiedAfterTx); ok && nm.TxID == ReadSnapshotTxID {
+		if tx.snap == nil {
+			return fmt.Errorf("%w: the read snapshot can not be referred in write-only transactions", ErrInvalidPreconditionInvalidTxID)
+		}
+
+		// the snapshot of the transaction does not change until it's committed or cancelled
+		if tx.snap.Ts() == 0 {
+			// no transaction was committed when the snapshot was taken, so the key must not have been written at all
+			c = &PreconditionKeyMustHaveVersion{Key: nm.Key, Version: 0}
+		} else {
+			c = &PreconditionKeyNotModifiedAfterTx{Key: nm.Key, TxID: tx.snap.Ts()}
+		}
+	}
+
 	tx.preconditions = append(tx.preconditions, c)
 	return nil
 }
@@ -468,9 +484,129 @@ func (tx *OngoingTx) commit(ctx context.Context, waitForIndexing bool) (*TxHeade
 		return nil, ctx.Err()
 	}
 
+	hdr, err := tx.skipUnchangedEntries(ctx)
+	if err != nil || hdr != nil {
+		return hdr, err
+	}
+
 	return tx.st.commit(ctx, tx, nil, false, waitForIndexing)
 }
 
+// skipUnchangedEntries drops the entries setting a key to the value it already holds when
+// covered by a KeyMustNotExistUnlessEqual precondition. Such keys are then required to keep
+// their value until the transaction is committed. When there is nothing left to be written,
+// the header of the most recent transaction holding any of those values is returned instead.
+func (tx *OngoingTx) skipUnchangedEntries(ctx context.Context) (*TxHeader, error) {
+	var snap *Snapshot
+	var lastTxID uint64
+
+	defer func() {
+		if snap != nil {
+			snap.Close()
+		}
+	}()
+
+	for i, c := range tx.preconditions {
+		c, ok := c.(*PreconditionKeyMustNotExistUnlessEqual)
+		if !ok {
+			continue
+		}
+
+		kid := sha256.Sum256(c.Key)
+
+		keyRef, ok := tx.entriesByKey[kid]
+		if !ok {
+			continue
+		}
+
+		e := tx.entries[keyRef]
+		if e.Metadata != nil || e.IsValueTruncated || !bytes.Equal(e.Value, c.Value) {
+			continue
+		}
+
+		if snap == nil {
+			// values must be compared with an up-to-date index
+			err := tx.st.WaitForIndexingUpto(ctx, tx.st.LastPrecommittedTxID())
+			if err != nil {
+				return nil, err
+			}
+
+			snap, err = tx.st.syncSnapshot()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		valRef, err := snap.Get(c.Key)
+		if errors.Is(err, ErrKeyNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		val, err := valRef.Resolve()
+		if err != nil {
+			return nil, err
+		}
+
+		if !bytes.Equal(val, c.Value) {
+			// the precondition will fail at commit time
+			continue
+		}
+
+		tx.removeEntry(kid, keyRef)
+
+		tx.preconditions[i] = &PreconditionKeyMustEqualValue{Key: c.Key, Value: c.Value}
+
+		if valRef.Tx() > lastTxID {
+			lastTxID = valRef.Tx()
+		}
+	}
+
+	if snap != nil {
+		// the snapshot holds a read lock on the index, it must be released before
+		// another one is taken, otherwise a pending index write would block both
+		err := snap.Close()
+		snap = nil
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if lastTxID == 0 || len(tx.entries) > 0 || !tx.metadata.IsEmpty() {
+		return nil, nil
+	}
+
+	// nothing to be written, but the rest of preconditions must still be satisfied
+	err := tx.checkPreconditions(tx.st)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.st.commitWHub.WaitFor(ctx, lastTxID)
+	if errors.Is(err, watchers.ErrAlreadyClosed) {
+		return nil, ErrAlreadyClosed
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.st.ReadTxHeader(lastTxID, false, false)
+}
+
+func (tx *OngoingTx) removeEntry(kid [sha256.Size]byte, keyRef int) {
+	tx.entries = append(tx.entries[:keyRef], tx.entries[keyRef+1:]...)
+
+	delete(tx.entriesByKey, kid)
+
+	for k, ref := range tx.entriesByKey {
+		if ref > keyRef {
+			tx.entriesByKey[k] = ref - 1
+		}
+	}
+}
+
 func (tx *OngoingTx) Cancel() error {
 	if tx.closed {
 		return ErrAlreadyClosed
@@ -497,16 +633,25 @@ func (tx *OngoingTx) hasPreconditions() bool {
 }
 
 func (tx *OngoingTx) checkPreconditions(st *ImmuStore) error {
-	for _, c := range tx.preconditions {
-		if c == nil {
-			return ErrInvalidPreconditionNull
+	if len(tx.preconditions) > 0 {
+		for _, c := range tx.preconditions {
+			if c == nil {
+				return ErrInvalidPreconditionNull
+			}
 		}
-		ok, err := c.Check(st)
+
+		// all the preconditions are evaluated over the same snapshot
+		snap, err := st.syncSnapshot()
 		if err != nil {
-			return fmt.Errorf("error checking %s precondition: %w", c, err)
+			return err
 		}
-		if !ok {
-			return fmt.Errorf("%w: %s", ErrPreconditionFailed, c)
+
+		err = checkPreconditionsBatch(tx.preconditions, snap, st.metricsPreconditionFailures)
+
+		snap.Close()
+
+		if err != nil {
+			return err
 		}
 	}
 
@@ -17,11 +17,24 @@ limitations under the License.
 package store
 
 import (
+	"bytes"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/tbtree"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 )
 
+var metricsPreconditionFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "immudb_precondition_failures_total",
+	Help: "Number of preconditions found not satisfied, either at commit time or when checked without committing",
+}, []string{"db", "precondition"})
+
 type Precondition interface {
 	String() string
 
@@ -86,6 +99,14 @@ func (cs *PreconditionKeyMustNotExist) Check(idx KeyIndex) (bool, error) {
 	return err != nil, nil
 }
 
+// ReadSnapshotTxID can be used as the TxID of a PreconditionKeyNotModifiedAfterTx added to a read-write
+// transaction to refer to the latest transaction included in the snapshot the ongoing transaction reads from.
+// The precondition is then satisfied only if the key was not modified, deleted included, by any transaction
+// committed after the snapshot was taken, regardless of whether the key was read or not.
+// The value is only accepted by OngoingTx.AddPrecondition on read-write transactions, which resolves it,
+// any other use of it is rejected with ErrInvalidPreconditionInvalidTxID.
+const ReadSnapshotTxID uint64 = math.MaxUint64
+
 type PreconditionKeyNotModifiedAfterTx struct {
 	Key  []byte
 	TxID uint64
@@ -122,3 +143,396 @@ func (cs *PreconditionKeyNotModifiedAfterTx) Check(idx KeyIndex) (bool, error) {
 
 	return valRef.Tx() <= cs.TxID, nil
 }
+
+// PreconditionKeyNotModifiedAfterTime is satisfied when the key was last modified, including deletion,
+// not after the given time. Transaction timestamps have a resolution of seconds.
+type PreconditionKeyNotModifiedAfterTime struct {
+	Key  []byte
+	Time time.Time
+}
+
+func (cs *PreconditionKeyNotModifiedAfterTime) String() string { return "KeyNotModifiedAfterTime" }
+
+func (cs *PreconditionKeyNotModifiedAfterTime) Validate(st *ImmuStore) error {
+	if len(cs.Key) == 0 {
+		return ErrInvalidPreconditionNullKey
+	}
+
+	if len(cs.Key) > st.maxKeyLen {
+		return ErrInvalidPreconditionMaxKeyLenExceeded
+	}
+
+	if cs.Time.IsZero() {
+		return ErrInvalidPreconditionInvalidTime
+	}
+
+	return nil
+}
+
+func (cs *PreconditionKeyNotModifiedAfterTime) Check(idx KeyIndex) (bool, error) {
+	// get the latest entry (it could be deleted or even expired)
+	valRef, err := idx.GetWithFilters(cs.Key)
+	if err != nil && errors.Is(err, ErrKeyNotFound) {
+		// key does not exist thus not modified at all
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	ts, err := txTimestampOf(valRef)
+	if err != nil {
+		return false, err
+	}
+
+	return ts <= cs.Time.Unix(), nil
+}
+
+// txTimestampOf returns the timestamp of the transaction the value was written in
+func txTimestampOf(valRef ValueRef) (int64, error) {
+	vr, ok := valRef.(*valueRef)
+	if !ok {
+		return 0, fmt.Errorf("%w: value reference not bound to a committed transaction", ErrIllegalState)
+	}
+
+	hdr, err := vr.st.ReadTxHeader(vr.tx, true, false)
+	if err != nil {
+		return 0, err
+	}
+
+	return hdr.Ts, nil
+}
+
+type PreconditionKeyMustEqualValue struct {
+	Key   []byte
+	Value []byte
+}
+
+func (cs *PreconditionKeyMustEqualValue) String() string { return "KeyMustEqualValue" }
+
+func (cs *PreconditionKeyMustEqualValue) Validate(st *ImmuStore) error {
+	if len(cs.Key) == 0 {
+		return ErrInvalidPreconditionNullKey
+	}
+
+	if len(cs.Key) > st.maxKeyLen {
+		return ErrInvalidPreconditionMaxKeyLenExceeded
+	}
+
+	return nil
+}
+
+func (cs *PreconditionKeyMustEqualValue) Check(idx KeyIndex) (bool, error) {
+	valRef, err := idx.Get(cs.Key)
+	if errors.Is(err, tbtree.ErrKeyNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	val, err := valRef.Resolve()
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(val, cs.Value), nil
+}
+
+type PreconditionKeyModifiedAfterTx struct {
+	Key  []byte
+	TxID uint64
+}
+
+func (cs *PreconditionKeyModifiedAfterTx) String() string { return "KeyModifiedAfterTxID" }
+
+func (cs *PreconditionKeyModifiedAfterTx) Validate(st *ImmuStore) error {
+	if len(cs.Key) == 0 {
+		return ErrInvalidPreconditionNullKey
+	}
+
+	if len(cs.Key) > st.maxKeyLen {
+		return ErrInvalidPreconditionMaxKeyLenExceeded
+	}
+
+	if cs.TxID == 0 {
+		return ErrInvalidPreconditionInvalidTxID
+	}
+
+	return nil
+}
+
+func (cs *PreconditionKeyModifiedAfterTx) Check(idx KeyIndex) (bool, error) {
+	// get the latest entry (it could be deleted or even expired)
+	valRef, err := idx.GetWithFilters(cs.Key)
+	if err != nil && errors.Is(err, ErrKeyNotFound) {
+		// key does not exist thus not modified at all
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return valRef.Tx() > cs.TxID, nil
+}
+
+type PreconditionKeyMustExistWithValuePrefix struct {
+	Key    []byte
+	Prefix []byte
+}
+
+func (cs *PreconditionKeyMustExistWithValuePrefix) String() string {
+	return "KeyMustExistWithValuePrefix"
+}
+
+func (cs *PreconditionKeyMustExistWithValuePrefix) Validate(st *ImmuStore) error {
+	if len(cs.Key) == 0 {
+		return ErrInvalidPreconditionNullKey
+	}
+
+	if len(cs.Key) > st.maxKeyLen {
+		return ErrInvalidPreconditionMaxKeyLenExceeded
+	}
+
+	return nil
+}
+
+func (cs *PreconditionKeyMustExistWithValuePrefix) Check(idx KeyIndex) (bool, error) {
+	valRef, err := idx.Get(cs.Key)
+	if errors.Is(err, tbtree.ErrKeyNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	val, err := valRef.Resolve()
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.HasPrefix(val, cs.Prefix), nil
+}
+
+// PreconditionKeyMustNotExistUnlessEqual is satisfied when the key does not exist or when it already holds
+// the given value. When the transaction sets the key to the same value, the write is skipped at commit time
+// if the key already holds it, so idempotent writes do not produce duplicate versions.
+type PreconditionKeyMustNotExistUnlessEqual struct {
+	Key   []byte
+	Value []byte
+}
+
+func (cs *PreconditionKeyMustNotExistUnlessEqual) String() string {
+	return "KeyMustNotExistUnlessEqual"
+}
+
+func (cs *PreconditionKeyMustNotExistUnlessEqual) Validate(st *ImmuStore) error {
+	if len(cs.Key) == 0 {
+		return ErrInvalidPreconditionNullKey
+	}
+
+	if len(cs.Key) > st.maxKeyLen {
+		return ErrInvalidPreconditionMaxKeyLenExceeded
+	}
+
+	return nil
+}
+
+func (cs *PreconditionKeyMustNotExistUnlessEqual) Check(idx KeyIndex) (bool, error) {
+	valRef, err := idx.Get(cs.Key)
+	if errors.Is(err, tbtree.ErrKeyNotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	val, err := valRef.Resolve()
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(val, cs.Value), nil
+}
+
+// PreconditionKeyMustHaveVersion is satisfied when the key currently has exactly the given number of versions,
+// deletions included. A key which was never set has no versions.
+type PreconditionKeyMustHaveVersion struct {
+	Key     []byte
+	Version uint64
+}
+
+func (cs *PreconditionKeyMustHaveVersion) String() string { return "KeyMustHaveVersion" }
+
+func (cs *PreconditionKeyMustHaveVersion) Validate(st *ImmuStore) error {
+	if len(cs.Key) == 0 {
+		return ErrInvalidPreconditionNullKey
+	}
+
+	if len(cs.Key) > st.maxKeyLen {
+		return ErrInvalidPreconditionMaxKeyLenExceeded
+	}
+
+	return nil
+}
+
+func (cs *PreconditionKeyMustHaveVersion) Check(idx KeyIndex) (bool, error) {
+	// get the latest entry (it could be deleted or even expired)
+	valRef, err := idx.GetWithFilters(cs.Key)
+	if err != nil && errors.Is(err, ErrKeyNotFound) {
+		return cs.Version == 0, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return valRef.HC() == cs.Version, nil
+}
+
+// PreconditionViolation is returned when a precondition is not satisfied at commit time,
+// it matches ErrPreconditionFailed when using errors.Is
+type PreconditionViolation struct {
+	Key          []byte
+	Precondition string
+	Index        int
+}
+
+func (v *PreconditionViolation) Error() string {
+	return fmt.Sprintf("%s: %s over key %s (precondition at index %d)",
+		ErrPreconditionFailed, v.Precondition, hex.EncodeToString(v.Key), v.Index)
+}
+
+func (v *PreconditionViolation) Unwrap() error {
+	return ErrPreconditionFailed
+}
+
+// preconditionKey returns the key a precondition is evaluated over, nil is returned for unknown preconditions
+func preconditionKey(c Precondition) []byte {
+	switch c := c.(type) {
+	case *PreconditionKeyMustExist:
+		return c.Key
+	case *PreconditionKeyMustNotExist:
+		return c.Key
+	case *PreconditionKeyNotModifiedAfterTx:
+		return c.Key
+	case *PreconditionKeyNotModifiedAfterTime:
+		return c.Key
+	case *PreconditionKeyMustEqualValue:
+		return c.Key
+	case *PreconditionKeyModifiedAfterTx:
+		return c.Key
+	case *PreconditionKeyMustExistWithValuePrefix:
+		return c.Key
+	case *PreconditionKeyMustNotExistUnlessEqual:
+		return c.Key
+	case *PreconditionKeyMustHaveVersion:
+		return c.Key
+	}
+
+	return nil
+}
+
+// checkPreconditionsBatch evaluates the preconditions in ascending key order, each one with its own
+// lookup into the index. When more than one precondition fails, the one reported is the first in the
+// provided order, along with its position, so once a failure is found only the preconditions preceding
+// it are still evaluated. The reported failure is counted, labeled by its type, into the failures counter.
+func checkPreconditionsBatch(preconditions []Precondition, idx KeyIndex, failures *prometheus.CounterVec) error {
+	order, err := preconditionsInKeyOrder(preconditions)
+	if err != nil {
+		return err
+	}
+
+	failed := len(preconditions)
+
+	for _, i := range order {
+		if i > failed {
+			continue
+		}
+
+		c := preconditions[i]
+
+		ok, err := c.Check(idx)
+		if err != nil {
+			return fmt.Errorf("error checking %s precondition: %w", c, err)
+		}
+
+		if !ok {
+			failed = i
+		}
+
+		if failed == 0 {
+			break
+		}
+	}
+
+	if failed < len(preconditions) {
+		failures.WithLabelValues(preconditions[failed].String()).Inc()
+		return newPreconditionViolation(preconditions, failed)
+	}
+
+	return nil
+}
+
+// checkAllPreconditionsBatch evaluates the preconditions as checkPreconditionsBatch does but, instead
+// of reporting only the first failure, it returns a violation for each of the unsatisfied preconditions,
+// following the provided order.
+func checkAllPreconditionsBatch(preconditions []Precondition, idx KeyIndex, failures *prometheus.CounterVec) ([]*PreconditionViolation, error) {
+	order, err := preconditionsInKeyOrder(preconditions)
+	if err != nil {
+		return nil, err
+	}
+
+	failed := make([]bool, len(preconditions))
+
+	for _, i := range order {
+		c := preconditions[i]
+
+		ok, err := c.Check(idx)
+		if err != nil {
+			return nil, fmt.Errorf("error checking %s precondition: %w", c, err)
+		}
+
+		if !ok {
+			failures.WithLabelValues(c.String()).Inc()
+		}
+
+		failed[i] = !ok
+	}
+
+	var violations []*PreconditionViolation
+
+	for i := range preconditions {
+		if failed[i] {
+			violations = append(violations, newPreconditionViolation(preconditions, i))
+		}
+	}
+
+	return violations, nil
+}
+
+// preconditionsInKeyOrder returns the positions of the preconditions sorted by the key they are evaluated over
+func preconditionsInKeyOrder(preconditions []Precondition) ([]int, error) {
+	order := make([]int, len(preconditions))
+
+	for i, c := range preconditions {
+		if c == nil {
+			return nil, ErrInvalidPreconditionNull
+		}
+
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return bytes.Compare(preconditionKey(preconditions[order[i]]), preconditionKey(preconditions[order[j]])) < 0
+	})
+
+	return order, nil
+}
+
+func newPreconditionViolation(preconditions []Precondition, i int) *PreconditionViolation {
+	return &PreconditionViolation{
+		Key:          preconditionKey(preconditions[i]),
+		Precondition: preconditions[i].String(),
+		Index:        i,
+	}
+}
@@ -23,15 +23,19 @@ type DocumentServiceClient interface {
 	GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*GetCollectionResponse, error)
 	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error)
 	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
+	AlterCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*AlterCollectionResponse, error)
 	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*CreateIndexResponse, error)
 	DeleteIndex(ctx context.Context, in *DeleteIndexRequest, opts ...grpc.CallOption) (*DeleteIndexResponse, error)
 	InsertDocuments(ctx context.Context, in *InsertDocumentsRequest, opts ...grpc.CallOption) (*InsertDocumentsResponse, error)
 	ReplaceDocuments(ctx context.Context, in *ReplaceDocumentsRequest, opts ...grpc.CallOption) (*ReplaceDocumentsResponse, error)
 	DeleteDocuments(ctx context.Context, in *DeleteDocumentsRequest, opts ...grpc.CallOption) (*DeleteDocumentsResponse, error)
 	SearchDocuments(ctx context.Context, in *SearchDocumentsRequest, opts ...grpc.CallOption) (*SearchDocumentsResponse, error)
+	StreamSearchDocuments(ctx context.Context, in *StreamSearchDocumentsRequest, opts ...grpc.CallOption) (DocumentService_StreamSearchDocumentsClient, error)
 	CountDocuments(ctx context.Context, in *CountDocumentsRequest, opts ...grpc.CallOption) (*CountDocumentsResponse, error)
+	AggregateDocuments(ctx context.Context, in *AggregateDocumentsRequest, opts ...grpc.CallOption) (*AggregateDocumentsResponse, error)
 	AuditDocument(ctx context.Context, in *AuditDocumentRequest, opts ...grpc.CallOption) (*AuditDocumentResponse, error)
 	ProofDocument(ctx context.Context, in *ProofDocumentRequest, opts ...grpc.CallOption) (*ProofDocumentResponse, error)
+	GetDocumentVerified(ctx context.Context, in *GetDocumentVerifiedRequest, opts ...grpc.CallOption) (*GetDocumentVerifiedResponse, error)
 }
 
 type documentServiceClient struct {
@@ -87,6 +91,15 @@ func (c *documentServiceClient) DeleteCollection(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *documentServiceClient) AlterCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*AlterCollectionResponse, error) {
+	out := new(AlterCollectionResponse)
+	err := c.cc.Invoke(ctx, "/immudb.model.DocumentService/AlterCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *documentServiceClient) CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*CreateIndexResponse, error) {
 	out := new(CreateIndexResponse)
 	err := c.cc.Invoke(ctx, "/immudb.model.DocumentService/CreateIndex", in, out, opts...)
@@ -141,6 +154,38 @@ func (c *documentServiceClient) SearchDocuments(ctx context.Context, in *SearchD
 	return out, nil
 }
 
+func (c *documentServiceClient) StreamSearchDocuments(ctx context.Context, in *StreamSearchDocumentsRequest, opts ...grpc.CallOption) (DocumentService_StreamSearchDocumentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DocumentService_ServiceDesc.Streams[0], "/immudb.model.DocumentService/StreamSearchDocuments", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &documentServiceStreamSearchDocumentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DocumentService_StreamSearchDocumentsClient interface {
+	Recv() (*DocumentAtRevision, error)
+	grpc.ClientStream
+}
+
+type documentServiceStreamSearchDocumentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *documentServiceStreamSearchDocumentsClient) Recv() (*DocumentAtRevision, error) {
+	m := new(DocumentAtRevision)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *documentServiceClient) CountDocuments(ctx context.Context, in *CountDocumentsRequest, opts ...grpc.CallOption) (*CountDocumentsResponse, error) {
 	out := new(CountDocumentsResponse)
 	err := c.cc.Invoke(ctx, "/immudb.model.DocumentService/CountDocuments", in, out, opts...)
@@ -150,6 +195,15 @@ func (c *documentServiceClient) CountDocuments(ctx context.Context, in *CountDoc
 	return out, nil
 }
 
+func (c *documentServiceClient) AggregateDocuments(ctx context.Context, in *AggregateDocumentsRequest, opts ...grpc.CallOption) (*AggregateDocumentsResponse, error) {
+	out := new(AggregateDocumentsResponse)
+	err := c.cc.Invoke(ctx, "/immudb.model.DocumentService/AggregateDocuments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *documentServiceClient) AuditDocument(ctx context.Context, in *AuditDocumentRequest, opts ...grpc.CallOption) (*AuditDocumentResponse, error) {
 	out := new(AuditDocumentResponse)
 	err := c.cc.Invoke(ctx, "/immudb.model.DocumentService/AuditDocument", in, out, opts...)
@@ -168,6 +222,15 @@ func (c *documentServiceClient) ProofDocument(ctx context.Context, in *ProofDocu
 	return out, nil
 }
 
+func (c *documentServiceClient) GetDocumentVerified(ctx context.Context, in *GetDocumentVerifiedRequest, opts ...grpc.CallOption) (*GetDocumentVerifiedResponse, error) {
+	out := new(GetDocumentVerifiedResponse)
+	err := c.cc.Invoke(ctx, "/immudb.model.DocumentService/GetDocumentVerified", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DocumentServiceServer is the server API for DocumentService service.
 // All implementations should embed UnimplementedDocumentServiceServer
 // for forward compatibility
@@ -177,15 +240,19 @@ type DocumentServiceServer interface {
 	GetCollection(context.Context, *GetCollectionRequest) (*GetCollectionResponse, error)
 	UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error)
 	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
+	AlterCollection(context.Context, *AlterCollectionRequest) (*AlterCollectionResponse, error)
 	CreateIndex(context.Context, *CreateIndexRequest) (*CreateIndexResponse, error)
 	DeleteIndex(context.Context, *DeleteIndexRequest) (*DeleteIndexResponse, error)
 	InsertDocuments(context.Context, *InsertDocumentsRequest) (*InsertDocumentsResponse, error)
 	ReplaceDocuments(context.Context, *ReplaceDocumentsRequest) (*ReplaceDocumentsResponse, error)
 	DeleteDocuments(context.Context, *DeleteDocumentsRequest) (*DeleteDocumentsResponse, error)
 	SearchDocuments(context.Context, *SearchDocumentsRequest) (*SearchDocumentsResponse, error)
+	StreamSearchDocuments(*StreamSearchDocumentsRequest, DocumentService_StreamSearchDocumentsServer) error
 	CountDocuments(context.Context, *CountDocumentsRequest) (*CountDocumentsResponse, error)
+	AggregateDocuments(context.Context, *AggregateDocumentsRequest) (*AggregateDocumentsResponse, error)
 	AuditDocument(context.Context, *AuditDocumentRequest) (*AuditDocumentResponse, error)
 	ProofDocument(context.Context, *ProofDocumentRequest) (*ProofDocumentResponse, error)
+	GetDocumentVerified(context.Context, *GetDocumentVerifiedRequest) (*GetDocumentVerifiedResponse, error)
 }
 
 // UnimplementedDocumentServiceServer should be embedded to have forward compatible implementations.
@@ -207,6 +274,9 @@ func (UnimplementedDocumentServiceServer) UpdateCollection(context.Context, *Upd
 func (UnimplementedDocumentServiceServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
 }
+func (UnimplementedDocumentServiceServer) AlterCollection(context.Context, *AlterCollectionRequest) (*AlterCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterCollection not implemented")
+}
 func (UnimplementedDocumentServiceServer) CreateIndex(context.Context, *CreateIndexRequest) (*CreateIndexResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateIndex not implemented")
 }
@@ -225,15 +295,24 @@ func (UnimplementedDocumentServiceServer) DeleteDocuments(context.Context, *Dele
 func (UnimplementedDocumentServiceServer) SearchDocuments(context.Context, *SearchDocumentsRequest) (*SearchDocumentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchDocuments not implemented")
 }
+func (UnimplementedDocumentServiceServer) StreamSearchDocuments(*StreamSearchDocumentsRequest, DocumentService_StreamSearchDocumentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSearchDocuments not implemented")
+}
 func (UnimplementedDocumentServiceServer) CountDocuments(context.Context, *CountDocumentsRequest) (*CountDocumentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CountDocuments not implemented")
 }
+func (UnimplementedDocumentServiceServer) AggregateDocuments(context.Context, *AggregateDocumentsRequest) (*AggregateDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateDocuments not implemented")
+}
 func (UnimplementedDocumentServiceServer) AuditDocument(context.Context, *AuditDocumentRequest) (*AuditDocumentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuditDocument not implemented")
 }
 func (UnimplementedDocumentServiceServer) ProofDocument(context.Context, *ProofDocumentRequest) (*ProofDocumentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProofDocument not implemented")
 }
+func (UnimplementedDocumentServiceServer) GetDocumentVerified(context.Context, *GetDocumentVerifiedRequest) (*GetDocumentVerifiedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDocumentVerified not implemented")
+}
 
 // UnsafeDocumentServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to DocumentServiceServer will
@@ -336,6 +415,24 @@ func _DocumentService_DeleteCollection_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DocumentService_AlterCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).AlterCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.model.DocumentService/AlterCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).AlterCollection(ctx, req.(*AlterCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DocumentService_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateIndexRequest)
 	if err := dec(in); err != nil {
@@ -444,6 +541,27 @@ func _DocumentService_SearchDocuments_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DocumentService_StreamSearchDocuments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamSearchDocumentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DocumentServiceServer).StreamSearchDocuments(m, &documentServiceStreamSearchDocumentsServer{stream})
+}
+
+type DocumentService_StreamSearchDocumentsServer interface {
+	Send(*DocumentAtRevision) error
+	grpc.ServerStream
+}
+
+type documentServiceStreamSearchDocumentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *documentServiceStreamSearchDocumentsServer) Send(m *DocumentAtRevision) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _DocumentService_CountDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CountDocumentsRequest)
 	if err := dec(in); err != nil {
@@ -462,6 +580,24 @@ func _DocumentService_CountDocuments_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DocumentService_AggregateDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).AggregateDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.model.DocumentService/AggregateDocuments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).AggregateDocuments(ctx, req.(*AggregateDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DocumentService_AuditDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AuditDocumentRequest)
 	if err := dec(in); err != nil {
@@ -498,6 +634,24 @@ func _DocumentService_ProofDocument_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DocumentService_GetDocumentVerified_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentVerifiedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).GetDocumentVerified(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.model.DocumentService/GetDocumentVerified",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).GetDocumentVerified(ctx, req.(*GetDocumentVerifiedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DocumentService_ServiceDesc is the grpc.ServiceDesc for DocumentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -525,6 +679,10 @@ var DocumentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCollection",
 			Handler:    _DocumentService_DeleteCollection_Handler,
 		},
+		{
+			MethodName: "AlterCollection",
+			Handler:    _DocumentService_AlterCollection_Handler,
+		},
 		{
 			MethodName: "CreateIndex",
 			Handler:    _DocumentService_CreateIndex_Handler,
@@ -553,6 +711,10 @@ var DocumentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CountDocuments",
 			Handler:    _DocumentService_CountDocuments_Handler,
 		},
+		{
+			MethodName: "AggregateDocuments",
+			Handler:    _DocumentService_AggregateDocuments_Handler,
+		},
 		{
 			MethodName: "AuditDocument",
 			Handler:    _DocumentService_AuditDocument_Handler,
@@ -561,7 +723,17 @@ var DocumentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProofDocument",
 			Handler:    _DocumentService_ProofDocument_Handler,
 		},
+		{
+			MethodName: "GetDocumentVerified",
+			Handler:    _DocumentService_GetDocumentVerified_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamSearchDocuments",
+			Handler:       _DocumentService_StreamSearchDocuments_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "documents.proto",
 }
@@ -17,46 +17,50 @@ limitations under the License.
 package auth
 
 var maintenanceMethods = map[string]struct{}{
-	"Get":                 {},
-	"VerifiableGet":       {},
-	"StreamGet":           {},
-	"StreamVerifiableGet": {},
-	"GetAll":              {},
-	"ZScan":               {},
-	"StreamZScan":         {},
-	"VerifiableTxByID":    {},
-	"IScan":               {},
-	"Scan":                {},
-	"StreamScan":          {},
-	"History":             {},
-	"StreamHistory":       {},
-	"TxByID":              {},
-	"TxScan":              {},
-	"ExportTx":            {},
-	"ReplicateTx":         {},
-	"Count":               {},
-	"CountAll":            {},
-	"DatabaseList":        {},
-	"CurrentState":        {},
-	"UseSnapshot":         {},
-	"SQLQuery":            {},
-	"ListTables":          {},
-	"DescribeTable":       {},
-	"VerifiableSQLGet":    {},
-	"CreateCollection":    {},
-	"GetCollection":       {},
-	"GetCollections":      {},
-	"UpdateCollection":    {},
-	"DeleteCollection":    {},
-	"CreateIndex":         {},
-	"DeleteIndex":         {},
-	"InsertDocuments":     {},
-	"ReplaceDocuments":    {},
-	"DeleteDocuments":     {},
-	"SearchDocuments":     {},
-	"CountDocuments":      {},
-	"AuditDocument":       {},
-	"ProofDocument":       {},
+	"Get":                   {},
+	"VerifiableGet":         {},
+	"StreamGet":             {},
+	"StreamVerifiableGet":   {},
+	"GetAll":                {},
+	"ZScan":                 {},
+	"StreamZScan":           {},
+	"VerifiableTxByID":      {},
+	"IScan":                 {},
+	"Scan":                  {},
+	"StreamScan":            {},
+	"History":               {},
+	"StreamHistory":         {},
+	"TxByID":                {},
+	"TxScan":                {},
+	"ExportTx":              {},
+	"ReplicateTx":           {},
+	"Count":                 {},
+	"CountAll":              {},
+	"DatabaseList":          {},
+	"CurrentState":          {},
+	"UseSnapshot":           {},
+	"SQLQuery":              {},
+	"ListTables":            {},
+	"DescribeTable":         {},
+	"VerifiableSQLGet":      {},
+	"CreateCollection":      {},
+	"GetCollection":         {},
+	"GetCollections":        {},
+	"UpdateCollection":      {},
+	"AlterCollection":       {},
+	"DeleteCollection":      {},
+	"CreateIndex":           {},
+	"DeleteIndex":           {},
+	"InsertDocuments":       {},
+	"ReplaceDocuments":      {},
+	"DeleteDocuments":       {},
+	"SearchDocuments":       {},
+	"StreamSearchDocuments": {},
+	"CountDocuments":        {},
+	"AggregateDocuments":    {},
+	"AuditDocument":         {},
+	"ProofDocument":         {},
+	"GetDocumentVerified":   {},
 
 	// admin methods
 	"ListUsers":    {},
@@ -119,20 +123,24 @@ var methodsPermissions = map[string][]uint32{
 	"DescribeTable":          {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"VerifiableSQLGet":       {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 
-	"CreateCollection": {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"GetCollection":    {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
-	"GetCollections":   {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
-	"UpdateCollection": {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"DeleteCollection": {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"CreateIndex":      {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"DeleteIndex":      {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"InsertDocuments":  {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"ReplaceDocuments": {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"DeleteDocuments":  {PermissionSysAdmin, PermissionAdmin, PermissionRW},
-	"SearchDocuments":  {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
-	"CountDocuments":   {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
-	"AuditDocument":    {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
-	"ProofDocument":    {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"CreateCollection":      {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"GetCollection":         {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"GetCollections":        {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"UpdateCollection":      {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"AlterCollection":       {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"DeleteCollection":      {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"CreateIndex":           {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"DeleteIndex":           {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"InsertDocuments":       {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"ReplaceDocuments":      {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"DeleteDocuments":       {PermissionSysAdmin, PermissionAdmin, PermissionRW},
+	"SearchDocuments":       {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"StreamSearchDocuments": {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"CountDocuments":        {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"AggregateDocuments":    {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"AuditDocument":         {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"ProofDocument":         {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"GetDocumentVerified":   {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 
 	// admin methods
 	"ListUsers":        {PermissionSysAdmin, PermissionAdmin},
@@ -47,6 +47,9 @@ var ErrIllegalState = store.ErrIllegalState
 var ErrIsReplica = errors.New("database is read-only because it's a replica")
 var ErrNotReplica = errors.New("database is NOT a replica")
 var ErrReplicaDivergedFromPrimary = errors.New("replica diverged from primary")
+var ErrReplicaCommitStateDiverged = fmt.Errorf("%w: replica commit state diverged from primary's", ErrReplicaDivergedFromPrimary)
+var ErrReplicaPrecommitStateDiverged = fmt.Errorf("%w: replica precommit state diverged from primary's", ErrReplicaDivergedFromPrimary)
+var ErrTxAlreadyCommitted = store.ErrTxAlreadyCommitted
 var ErrInvalidRevision = errors.New("invalid key revision number")
 
 type DB interface {
@@ -167,6 +170,10 @@ type db struct {
 
 	replicaStates      map[uuid]*replicaState
 	replicaStatesMutex sync.Mutex
+
+	// documentSweeperCancel stops the periodic deletion of expired documents, including an ongoing sweep
+	documentSweeperCancel context.CancelFunc
+	documentSweeperDone   chan struct{}
 }
 
 // OpenDB Opens an existing Database from disk
@@ -219,7 +226,7 @@ func OpenDB(dbName string, multidbHandler sql.MultiDBHandler, op *Options, log l
 		return nil, err
 	}
 
-	dbi.documentEngine, err = document.NewEngine(dbi.st, document.DefaultOptions().WithPrefix([]byte{DocumentPrefix}))
+	dbi.documentEngine, err = document.NewEngine(dbi.st, op.documentOptions())
 	if err != nil {
 		return nil, err
 	}
@@ -230,6 +237,10 @@ func OpenDB(dbName string, multidbHandler sql.MultiDBHandler, op *Options, log l
 	}
 	dbi.txPool = txPool
 
+	if op.documentSweepFrequency > 0 {
+		dbi.startDocumentSweeper(op.documentSweepFrequency)
+	}
+
 	if op.replica {
 		dbi.Logger.Infof("Database '%s' {replica = %v} successfully opened", dbName, op.replica)
 		return dbi, nil
@@ -317,13 +328,17 @@ func NewDB(dbName string, multidbHandler sql.MultiDBHandler, op *Options, log lo
 		return nil, err
 	}
 
-	dbi.documentEngine, err = document.NewEngine(dbi.st, document.DefaultOptions().WithPrefix([]byte{DocumentPrefix}))
+	dbi.documentEngine, err = document.NewEngine(dbi.st, op.documentOptions())
 	if err != nil {
 		return nil, logErr(dbi.Logger, "Unable to open database: %s", err)
 	}
 
 	dbi.Logger.Infof("SQL Engine ready for database '%s' {replica = %v}", dbName, op.replica)
 
+	if op.documentSweepFrequency > 0 {
+		dbi.startDocumentSweeper(op.documentSweepFrequency)
+	}
+
 	dbi.Logger.Infof("Database '%s' successfully created {replica = %v}", dbName, op.replica)
 
 	return dbi, nil
@@ -1197,7 +1212,7 @@ func (d *db) ExportTxByID(ctx context.Context, req *schema.ExportTxRequest) (txb
 			// validate replica commit state
 			if req.ReplicaState.CommittedTxID > committedTxID {
 				return nil, committedTxID, committedAlh,
-					fmt.Errorf("%w: replica commit state diverged from primary's", ErrReplicaDivergedFromPrimary)
+					ErrReplicaCommitStateDiverged
 			}
 
 			// integrityCheck is currently required to validate Alh
@@ -1210,7 +1225,7 @@ func (d *db) ExportTxByID(ctx context.Context, req *schema.ExportTxRequest) (txb
 
 			if expectedReplicaCommitHdr.Alh() != replicaCommittedAlh {
 				return nil, expectedReplicaCommitHdr.ID, expectedReplicaCommitHdr.Alh(),
-					fmt.Errorf("%w: replica commit state diverged from primary's", ErrReplicaDivergedFromPrimary)
+					ErrReplicaCommitStateDiverged
 			}
 		}
 
@@ -1218,7 +1233,7 @@ func (d *db) ExportTxByID(ctx context.Context, req *schema.ExportTxRequest) (txb
 			// validate replica precommit state
 			if req.ReplicaState.PrecommittedTxID > preCommittedTxID {
 				return nil, committedTxID, committedAlh,
-					fmt.Errorf("%w: replica precommit state diverged from primary's", ErrReplicaDivergedFromPrimary)
+					ErrReplicaPrecommitStateDiverged
 			}
 
 			// integrityCheck is currently required to validate Alh
@@ -1231,7 +1246,7 @@ func (d *db) ExportTxByID(ctx context.Context, req *schema.ExportTxRequest) (txb
 
 			if expectedReplicaPrecommitHdr.Alh() != replicaPreCommittedAlh {
 				return nil, expectedReplicaPrecommitHdr.ID, expectedReplicaPrecommitHdr.Alh(),
-					fmt.Errorf("%w: replica precommit state diverged from primary's", ErrReplicaDivergedFromPrimary)
+					ErrReplicaPrecommitStateDiverged
 			}
 
 			// primary will provide commit state to the replica so it can commit pre-committed transactions
@@ -1313,7 +1328,7 @@ func (d *db) AllowCommitUpto(txID uint64, alh [sha256.Size]byte) error {
 	// handling a particular case in an optimized manner
 	if committedTxID == txID {
 		if committedAlh != alh {
-			return fmt.Errorf("%w: replica commit state diverged from primary's", ErrIllegalState)
+			return ErrReplicaCommitStateDiverged
 		}
 		return nil
 	}
@@ -1324,7 +1339,7 @@ func (d *db) AllowCommitUpto(txID uint64, alh [sha256.Size]byte) error {
 	}
 
 	if hdr.Alh() != alh {
-		return fmt.Errorf("%w: replica commit state diverged from primary's", ErrIllegalState)
+		return ErrReplicaCommitStateDiverged
 	}
 
 	return d.st.AllowCommitUpto(txID)
@@ -1582,6 +1597,8 @@ func (d *db) Close() (err error) {
 		}
 	}()
 
+	d.stopDocumentSweeper()
+
 	return d.st.Close()
 }
 
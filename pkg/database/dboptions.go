@@ -19,6 +19,7 @@ package database
 import (
 	"time"
 
+	"github.com/codenotary/immudb/embedded/document"
 	"github.com/codenotary/immudb/embedded/store"
 )
 
@@ -47,6 +48,13 @@ type Options struct {
 
 	// RetentionPeriod determines how long to store data in the database.
 	RetentionPeriod time.Duration
+
+	// documentSweepFrequency determines how frequently expired documents are deleted, never when zero
+	documentSweepFrequency time.Duration
+
+	// maxDocumentSize and maxDocumentFields limit the documents written into any collection, no limit when zero
+	maxDocumentSize   int
+	maxDocumentFields int
 }
 
 // DefaultOption Initialise Db Optionts to default values
@@ -126,3 +134,31 @@ func (o *Options) WithRetentionPeriod(c time.Duration) *Options {
 	o.RetentionPeriod = c
 	return o
 }
+
+// WithDocumentSweepFrequency sets how frequently expired documents are deleted from the collections
+// of the database. Expired documents are excluded from queries even if they were not yet deleted
+func (o *Options) WithDocumentSweepFrequency(c time.Duration) *Options {
+	o.documentSweepFrequency = c
+	return o
+}
+
+// WithMaxDocumentSize sets the maximum size in bytes of the documents written into the collections of the database,
+// collections created with a lower limit enforce their own. Document size is not limited when set to zero
+func (o *Options) WithMaxDocumentSize(maxDocumentSize int) *Options {
+	o.maxDocumentSize = maxDocumentSize
+	return o
+}
+
+// WithMaxDocumentFields sets the maximum number of fields of the documents written into the collections of the
+// database, collections created with a lower limit enforce their own. Fields are not limited when set to zero
+func (o *Options) WithMaxDocumentFields(maxDocumentFields int) *Options {
+	o.maxDocumentFields = maxDocumentFields
+	return o
+}
+
+func (o *Options) documentOptions() *document.Options {
+	return document.DefaultOptions().
+		WithPrefix([]byte{DocumentPrefix}).
+		WithMaxDocumentSize(o.maxDocumentSize).
+		WithMaxDocumentFields(o.maxDocumentFields)
+}
@@ -38,13 +38,19 @@ func TestDefaultOptions(t *testing.T) {
 		WithStoreOptions(storeOpts).
 		WithReadTxPoolSize(789).
 		WithSyncReplication(true).
-		WithTruncationFrequency(1 * time.Hour)
+		WithTruncationFrequency(1 * time.Hour).
+		WithDocumentSweepFrequency(1 * time.Minute).
+		WithMaxDocumentSize(1024).
+		WithMaxDocumentFields(16)
 
 	require.Equal(t, op.GetDBRootPath(), rootpath)
 	require.True(t, op.GetCorruptionChecker())
 	require.Equal(t, op.GetTxPoolSize(), 789)
 	require.True(t, op.syncReplication)
 	require.Equal(t, op.TruncationFrequency, 1*time.Hour)
+	require.Equal(t, op.documentSweepFrequency, 1*time.Minute)
+	require.Equal(t, op.maxDocumentSize, 1024)
+	require.Equal(t, op.maxDocumentFields, 16)
 
 	require.Equal(t, storeOpts, op.storeOpts)
 }
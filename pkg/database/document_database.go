@@ -17,12 +17,15 @@ package database
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/document"
 	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/pkg/api/protomodel"
 	"github.com/codenotary/immudb/pkg/api/schema"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 // DocumentDatabase is the interface for document database
@@ -31,10 +34,14 @@ type DocumentDatabase interface {
 	GetCollection(ctx context.Context, req *protomodel.GetCollectionRequest) (*protomodel.GetCollectionResponse, error)
 	// GetCollections returns the list of collection schemas
 	GetCollections(ctx context.Context, req *protomodel.GetCollectionsRequest) (*protomodel.GetCollectionsResponse, error)
+	// CollectionStats returns the number of documents and indexes of a collection along with its approximate size
+	CollectionStats(ctx context.Context, collectionName string) (*document.CollectionStats, error)
 	// CreateCollection creates a new collection
 	CreateCollection(ctx context.Context, req *protomodel.CreateCollectionRequest) (*protomodel.CreateCollectionResponse, error)
 	// UpdateCollection updates an existing collection
 	UpdateCollection(ctx context.Context, req *protomodel.UpdateCollectionRequest) (*protomodel.UpdateCollectionResponse, error)
+	// AlterCollection adds fields and indexes to an existing collection
+	AlterCollection(ctx context.Context, req *protomodel.AlterCollectionRequest) (*protomodel.AlterCollectionResponse, error)
 	// DeleteCollection deletes a collection
 	DeleteCollection(ctx context.Context, req *protomodel.DeleteCollectionRequest) (*protomodel.DeleteCollectionResponse, error)
 	// CreateIndex creates an index for a collection
@@ -43,18 +50,32 @@ type DocumentDatabase interface {
 	DeleteIndex(ctx context.Context, req *protomodel.DeleteIndexRequest) (*protomodel.DeleteIndexResponse, error)
 	// InsertDocuments creates new documents
 	InsertDocuments(ctx context.Context, req *protomodel.InsertDocumentsRequest) (*protomodel.InsertDocumentsResponse, error)
-	// ReplaceDocuments replaces documents matching the query
+	// ReplaceDocuments replaces documents matching the query, optionally inserting the document if none matches
 	ReplaceDocuments(ctx context.Context, req *protomodel.ReplaceDocumentsRequest) (*protomodel.ReplaceDocumentsResponse, error)
+	// NewDocumentTx starts a transaction grouping document writes over one or more collections
+	NewDocumentTx(ctx context.Context) (*document.DocumentTx, error)
 	// AuditDocument returns the document audit history
 	AuditDocument(ctx context.Context, req *protomodel.AuditDocumentRequest) (*protomodel.AuditDocumentResponse, error)
-	// SearchDocuments returns the documents matching the query
-	SearchDocuments(ctx context.Context, query *protomodel.Query, offset int64) (document.DocumentReader, error)
+	// SearchDocuments returns the documents matching the query, only including the given fields when any is specified
+	SearchDocuments(ctx context.Context, query *protomodel.Query, offset int64, fields []string) (document.DocumentReader, error)
+	// GetDocumentByKey returns the document with the given primary key values, read with a single lookup
+	GetDocumentByKey(ctx context.Context, collectionName string, keyValues []*structpb.Value) (*protomodel.DocumentAtRevision, error)
+	// GetDocuments returns the documents with the given primary key values in the same order, nil when not found
+	GetDocuments(ctx context.Context, collectionName string, keys [][]*structpb.Value) ([]*protomodel.DocumentAtRevision, error)
+	// GetDocumentHistory returns the revisions of the document with the given primary key values, newest first
+	GetDocumentHistory(ctx context.Context, collectionName string, keyValues []*structpb.Value, limit int, offset uint64) ([]*protomodel.DocumentAtRevision, error)
+	// StreamSearchDocuments calls fn with each of the documents matching the query as they are read
+	StreamSearchDocuments(ctx context.Context, query *protomodel.Query, fields []string, fn func(revision *protomodel.DocumentAtRevision) error) error
 	// CountDocuments returns the number of documents matching the query
 	CountDocuments(ctx context.Context, req *protomodel.CountDocumentsRequest) (*protomodel.CountDocumentsResponse, error)
-	// DeleteDocuments deletes documents maching the query
+	// AggregateDocuments computes an aggregate function over a field of the documents matching the query
+	AggregateDocuments(ctx context.Context, req *protomodel.AggregateDocumentsRequest) (*protomodel.AggregateDocumentsResponse, error)
+	// DeleteDocuments deletes documents maching the query and returns the number of deleted documents
 	DeleteDocuments(ctx context.Context, req *protomodel.DeleteDocumentsRequest) (*protomodel.DeleteDocumentsResponse, error)
 	// ProofDocument returns the proofs for a document
 	ProofDocument(ctx context.Context, req *protomodel.ProofDocumentRequest) (*protomodel.ProofDocumentResponse, error)
+	// GetDocumentVerified returns the documents matching the query along with the inclusion proof of each of them
+	GetDocumentVerified(ctx context.Context, req *protomodel.GetDocumentVerifiedRequest) (*protomodel.GetDocumentVerifiedResponse, error)
 }
 
 // CreateCollection creates a new collection
@@ -70,7 +91,18 @@ func (d *db) CreateCollection(ctx context.Context, req *protomodel.CreateCollect
 		return nil, ErrIllegalArguments
 	}
 
-	err := d.documentEngine.CreateCollection(ctx, req.Name, req.DocumentIdFieldName, req.Fields, req.Indexes)
+	err := d.documentEngine.CreateCollectionWithOptions(
+		ctx,
+		req.Name,
+		req.DocumentIdFieldName,
+		req.Fields,
+		req.Indexes,
+		document.DefaultCollectionOptions().
+			WithIDGenerator(req.DocumentIdGenerator).
+			WithTTL(time.Duration(req.Ttl)*time.Second).
+			WithMaxDocumentSize(int(req.MaxDocumentSize)).
+			WithMaxDocumentFields(int(req.MaxDocumentFields)),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -92,6 +124,12 @@ func (d *db) GetCollection(ctx context.Context, req *protomodel.GetCollectionReq
 	return &protomodel.GetCollectionResponse{Collection: cinfo}, nil
 }
 
+// CollectionStats returns the number of documents and indexes of a collection, along with the approximate size
+// of the current revision of its documents and index entries
+func (d *db) CollectionStats(ctx context.Context, collectionName string) (*document.CollectionStats, error) {
+	return d.documentEngine.CollectionStats(ctx, collectionName)
+}
+
 func (d *db) GetCollections(ctx context.Context, _ *protomodel.GetCollectionsRequest) (*protomodel.GetCollectionsResponse, error) {
 	collections, err := d.documentEngine.GetCollections(ctx)
 	if err != nil {
@@ -122,6 +160,27 @@ func (d *db) UpdateCollection(ctx context.Context, req *protomodel.UpdateCollect
 	return &protomodel.UpdateCollectionResponse{}, nil
 }
 
+// AlterCollection adds fields and indexes to an existing collection
+func (d *db) AlterCollection(ctx context.Context, req *protomodel.AlterCollectionRequest) (*protomodel.AlterCollectionResponse, error) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	if d.isReplica() {
+		return nil, ErrIsReplica
+	}
+
+	if req == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	err := d.documentEngine.AlterCollection(ctx, req.Name, req.Fields, req.Indexes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protomodel.AlterCollectionResponse{}, nil
+}
+
 // DeleteCollection deletes a collection
 func (d *db) DeleteCollection(ctx context.Context, req *protomodel.DeleteCollectionRequest) (*protomodel.DeleteCollectionResponse, error) {
 	d.mutex.RLock()
@@ -185,7 +244,7 @@ func (d *db) DeleteIndex(ctx context.Context, req *protomodel.DeleteIndexRequest
 	return &protomodel.DeleteIndexResponse{}, nil
 }
 
-// InsertDocuments inserts multiple documents
+// InsertDocuments inserts multiple documents atomically within a single transaction
 func (d *db) InsertDocuments(ctx context.Context, req *protomodel.InsertDocumentsRequest) (*protomodel.InsertDocumentsResponse, error) {
 	d.mutex.RLock()
 	defer d.mutex.RUnlock()
@@ -227,7 +286,14 @@ func (d *db) ReplaceDocuments(ctx context.Context, req *protomodel.ReplaceDocume
 		return nil, ErrIllegalArguments
 	}
 
-	revisions, err := d.documentEngine.ReplaceDocuments(ctx, req.Query, req.Document)
+	var revisions []*protomodel.DocumentAtRevision
+	var err error
+
+	if req.Upsert {
+		revisions, err = d.documentEngine.UpsertDocuments(ctx, req.Query, req.Document)
+	} else {
+		revisions, err = d.documentEngine.ReplaceDocuments(ctx, req.Query, req.Document)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -237,6 +303,19 @@ func (d *db) ReplaceDocuments(ctx context.Context, req *protomodel.ReplaceDocume
 	}, nil
 }
 
+// NewDocumentTx starts a transaction on which documents of different collections can be written,
+// all of them are committed within a single immudb transaction
+func (d *db) NewDocumentTx(ctx context.Context) (*document.DocumentTx, error) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	if d.isReplica() {
+		return nil, ErrIsReplica
+	}
+
+	return d.documentEngine.NewDocumentTx(ctx)
+}
+
 func (d *db) AuditDocument(ctx context.Context, req *protomodel.AuditDocumentRequest) (*protomodel.AuditDocumentResponse, error) {
 	if req == nil {
 		return nil, ErrIllegalArguments
@@ -271,8 +350,75 @@ func (d *db) AuditDocument(ctx context.Context, req *protomodel.AuditDocumentReq
 }
 
 // SearchDocuments returns the documents matching the search request constraints
-func (d *db) SearchDocuments(ctx context.Context, query *protomodel.Query, offset int64) (document.DocumentReader, error) {
-	return d.documentEngine.GetDocuments(ctx, query, offset)
+func (d *db) SearchDocuments(ctx context.Context, query *protomodel.Query, offset int64, fields []string) (document.DocumentReader, error) {
+	return d.documentEngine.GetDocumentsWithProjection(ctx, query, offset, fields)
+}
+
+// GetDocumentByKey returns the current revision of the document with the given primary key values. Unlike searching
+// by document id, the key of the document is built directly from the values thus point lookups avoid query planning
+func (d *db) GetDocumentByKey(ctx context.Context, collectionName string, keyValues []*structpb.Value) (*protomodel.DocumentAtRevision, error) {
+	return d.documentEngine.GetDocumentByKey(ctx, collectionName, keyValues)
+}
+
+// GetDocuments returns the current revision of the documents with the given primary key values, in the same order
+// as the keys are given and nil for the documents which are not found. Documents are read from a single snapshot,
+// each one with a key lookup, up to the maximum result size of the database
+func (d *db) GetDocuments(ctx context.Context, collectionName string, keys [][]*structpb.Value) ([]*protomodel.DocumentAtRevision, error) {
+	if len(keys) > d.maxResultSize {
+		return nil, fmt.Errorf("%w: the number of keys (%d) is larger than the maximum allowed one (%d)",
+			ErrResultSizeLimitExceeded, len(keys), d.maxResultSize)
+	}
+
+	return d.documentEngine.GetDocumentsByKeys(ctx, collectionName, keys)
+}
+
+// GetDocumentHistory returns up to limit revisions of the document with the given primary key values, skipping
+// the offset newest ones. Revisions are returned newest first, along with the timestamp of the transaction each of
+// them was written at. All revisions within the maximum result size are returned when no limit is specified
+func (d *db) GetDocumentHistory(ctx context.Context, collectionName string, keyValues []*structpb.Value, limit int, offset uint64) ([]*protomodel.DocumentAtRevision, error) {
+	if limit < 0 {
+		return nil, fmt.Errorf("%w: invalid limit", ErrIllegalArguments)
+	}
+
+	if limit > d.maxResultSize {
+		return nil, fmt.Errorf("%w: the specified limit (%d) is larger than the maximum allowed one (%d)",
+			ErrResultSizeLimitExceeded, limit, d.maxResultSize)
+	}
+
+	if limit == 0 {
+		limit = d.maxResultSize
+	}
+
+	return d.documentEngine.GetDocumentHistory(ctx, collectionName, keyValues, limit, offset)
+}
+
+// StreamSearchDocuments reads the documents matching the query one at a time, so memory usage does not depend
+// on the number of matching documents. Reading is stopped as soon as fn returns an error, which is then returned
+func (d *db) StreamSearchDocuments(ctx context.Context, query *protomodel.Query, fields []string, fn func(revision *protomodel.DocumentAtRevision) error) error {
+	if fn == nil {
+		return ErrIllegalArguments
+	}
+
+	reader, err := d.documentEngine.GetDocumentsWithProjection(ctx, query, 0, fields)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	for {
+		revision, err := reader.Read(ctx)
+		if errors.Is(err, document.ErrNoMoreDocuments) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		err = fn(revision)
+		if err != nil {
+			return err
+		}
+	}
 }
 
 // CountDocuments returns the number of documents matching the query
@@ -291,6 +437,22 @@ func (d *db) CountDocuments(ctx context.Context, req *protomodel.CountDocumentsR
 	}, nil
 }
 
+// AggregateDocuments computes an aggregate function over a field of the documents matching the query
+func (d *db) AggregateDocuments(ctx context.Context, req *protomodel.AggregateDocumentsRequest) (*protomodel.AggregateDocumentsResponse, error) {
+	if req == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	results, err := d.documentEngine.AggregateDocuments(ctx, req.Query, req.Field, req.Function, req.GroupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protomodel.AggregateDocumentsResponse{
+		Results: results,
+	}, nil
+}
+
 func (d *db) DeleteDocuments(ctx context.Context, req *protomodel.DeleteDocumentsRequest) (*protomodel.DeleteDocumentsResponse, error) {
 	if d.isReplica() {
 		return nil, ErrIsReplica
@@ -300,11 +462,14 @@ func (d *db) DeleteDocuments(ctx context.Context, req *protomodel.DeleteDocument
 		return nil, ErrIllegalArguments
 	}
 
-	err := d.documentEngine.DeleteDocuments(ctx, req.Query)
+	count, err := d.documentEngine.DeleteDocuments(ctx, req.Query)
 	if err != nil {
 		return nil, err
 	}
-	return &protomodel.DeleteDocumentsResponse{}, nil
+
+	return &protomodel.DeleteDocumentsResponse{
+		Count: count,
+	}, nil
 }
 
 // ProofDocument returns the proofs for a documenta
@@ -369,3 +534,62 @@ func (d *db) ProofDocument(ctx context.Context, req *protomodel.ProofDocumentReq
 		},
 	}, nil
 }
+
+// GetDocumentVerified returns a page of the documents matching the query. Each document is returned encoded as
+// the entry backing it, together with the header of the transaction the entry was written at and its inclusion
+// proof. Documents and their entries are read from a single snapshot, thus they are consistent with each other
+func (d *db) GetDocumentVerified(ctx context.Context, req *protomodel.GetDocumentVerifiedRequest) (*protomodel.GetDocumentVerifiedResponse, error) {
+	if req == nil || req.Query == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	if req.Page < 1 || req.PageSize < 1 {
+		return nil, fmt.Errorf("%w: invalid page or page size", ErrIllegalArguments)
+	}
+
+	if int(req.PageSize) > d.maxResultSize {
+		return nil, fmt.Errorf("%w: the specified page size (%d) is larger than the maximum allowed one (%d)",
+			ErrResultSizeLimitExceeded, req.PageSize, d.maxResultSize)
+	}
+
+	offset := int64((req.Page - 1) * req.PageSize)
+
+	collectionID, documentIdFieldName, encDocs, err := d.documentEngine.GetEncodedDocuments(ctx, req.Query, offset, int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := d.allocTx()
+	if err != nil {
+		return nil, err
+	}
+	defer d.releaseTx(tx)
+
+	res := &protomodel.GetDocumentVerifiedResponse{
+		Database:            d.name,
+		CollectionId:        collectionID,
+		DocumentIdFieldName: documentIdFieldName,
+		Documents:           make([]*protomodel.VerifiableDocument, len(encDocs)),
+	}
+
+	for i, encDoc := range encDocs {
+		err = d.st.ReadTx(encDoc.TxID, false, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		inclusionProof, err := tx.Proof(encDoc.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Documents[i] = &protomodel.VerifiableDocument{
+			EncodedDocument: encDoc.EncodedDocument,
+			Metadata:        schema.KVMetadataToProto(encDoc.KVMetadata),
+			TxHeader:        schema.TxHeaderToProto(tx.Header()),
+			InclusionProof:  schema.InclusionProofToProto(inclusionProof),
+		}
+	}
+
+	return res, nil
+}
@@ -17,8 +17,12 @@ package database
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"os"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -29,6 +33,7 @@ import (
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/verification"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
@@ -69,6 +74,9 @@ func TestDocumentDB_InvalidParameters(t *testing.T) {
 	_, err = db.UpdateCollection(context.Background(), nil)
 	require.ErrorIs(t, err, ErrIllegalArguments)
 
+	_, err = db.AlterCollection(context.Background(), nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
 	_, err = db.DeleteCollection(context.Background(), nil)
 	require.ErrorIs(t, err, ErrIllegalArguments)
 
@@ -90,6 +98,9 @@ func TestDocumentDB_InvalidParameters(t *testing.T) {
 	_, err = db.CountDocuments(context.Background(), nil)
 	require.ErrorIs(t, err, ErrIllegalArguments)
 
+	_, err = db.AggregateDocuments(context.Background(), nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
 	_, err = db.DeleteDocuments(context.Background(), nil)
 	require.ErrorIs(t, err, ErrIllegalArguments)
 
@@ -108,6 +119,9 @@ func TestDocumentDB_WritesOnReplica(t *testing.T) {
 	_, err = db.UpdateCollection(context.Background(), &protomodel.UpdateCollectionRequest{})
 	require.ErrorIs(t, err, ErrIsReplica)
 
+	_, err = db.AlterCollection(context.Background(), &protomodel.AlterCollectionRequest{})
+	require.ErrorIs(t, err, ErrIsReplica)
+
 	_, err = db.DeleteCollection(context.Background(), &protomodel.DeleteCollectionRequest{})
 	require.ErrorIs(t, err, ErrIsReplica)
 
@@ -123,10 +137,77 @@ func TestDocumentDB_WritesOnReplica(t *testing.T) {
 	_, err = db.ReplaceDocuments(context.Background(), &protomodel.ReplaceDocumentsRequest{})
 	require.ErrorIs(t, err, ErrIsReplica)
 
+	_, err = db.NewDocumentTx(context.Background())
+	require.ErrorIs(t, err, ErrIsReplica)
+
 	_, err = db.DeleteDocuments(context.Background(), &protomodel.DeleteDocumentsRequest{})
 	require.ErrorIs(t, err, ErrIsReplica)
 }
 
+func TestDocumentDB_WithDocumentTx(t *testing.T) {
+	db := makeDocumentDb(t)
+
+	for _, collectionName := range []string{"customers", "orders"} {
+		_, err := db.CreateCollection(context.Background(), &protomodel.CreateCollectionRequest{
+			Name: collectionName,
+			Fields: []*protomodel.Field{
+				{Name: "name", Type: protomodel.FieldType_STRING},
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	tx, err := db.NewDocumentTx(context.Background())
+	require.NoError(t, err)
+	defer tx.Cancel()
+
+	for _, collectionName := range []string{"customers", "orders"} {
+		_, err = tx.InsertDocuments(context.Background(), collectionName, []*structpb.Struct{
+			{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("John")}},
+		})
+		require.NoError(t, err)
+	}
+
+	txID, err := tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	state, err := db.CurrentState()
+	require.NoError(t, err)
+	require.Equal(t, state.TxId, txID)
+
+	for _, collectionName := range []string{"customers", "orders"} {
+		res, err := db.CountDocuments(context.Background(), &protomodel.CountDocumentsRequest{
+			Query: &protomodel.Query{CollectionName: collectionName},
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, res.Count)
+	}
+}
+
+func TestDocumentDB_WithSequentialDocumentIDs(t *testing.T) {
+	db := makeDocumentDb(t)
+
+	_, err := db.CreateCollection(context.Background(), &protomodel.CreateCollectionRequest{
+		Name:                "invoices",
+		DocumentIdGenerator: protomodel.DocumentIdGenerator_SEQUENCE,
+	})
+	require.NoError(t, err)
+
+	collection, err := db.GetCollection(context.Background(), &protomodel.GetCollectionRequest{Name: "invoices"})
+	require.NoError(t, err)
+	require.Equal(t, protomodel.DocumentIdGenerator_SEQUENCE, collection.Collection.DocumentIdGenerator)
+
+	res, err := db.InsertDocuments(context.Background(), &protomodel.InsertDocumentsRequest{
+		CollectionName: "invoices",
+		Documents: []*structpb.Struct{
+			{Fields: map[string]*structpb.Value{"amount": structpb.NewNumberValue(10)}},
+			{Fields: map[string]*structpb.Value{"amount": structpb.NewNumberValue(20)}},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"0000000000000001", "0000000000000002"}, res.DocumentIds)
+}
+
 func TestDocumentDB_WithCollections(t *testing.T) {
 	db := makeDocumentDb(t)
 
@@ -249,13 +330,40 @@ func TestDocumentDB_WithCollections(t *testing.T) {
 		require.Equal(t, "foo", collection.Fields[0].Name)
 	})
 
+	t.Run("should pass when altering a collection", func(t *testing.T) {
+		_, err := db.AlterCollection(context.Background(), &protomodel.AlterCollectionRequest{
+			Name:   defaultCollectionName,
+			Fields: []*protomodel.Field{{Name: "age", Type: protomodel.FieldType_INTEGER}},
+			Indexes: []*protomodel.Index{
+				{Fields: []string{"age"}},
+			},
+		})
+		require.NoError(t, err)
+
+		cinfo, err := db.GetCollection(context.Background(), &protomodel.GetCollectionRequest{
+			Name: defaultCollectionName,
+		})
+		require.NoError(t, err)
+
+		collection := cinfo.Collection
+		require.Equal(t, "age", collection.Fields[len(collection.Fields)-1].Name)
+		require.Equal(t, []string{"age"}, collection.Indexes[len(collection.Indexes)-1].Fields)
+
+		_, err = db.AlterCollection(context.Background(), &protomodel.AlterCollectionRequest{
+			Name:   defaultCollectionName,
+			Fields: []*protomodel.Field{{Name: "age", Type: protomodel.FieldType_INTEGER}},
+		})
+		require.ErrorIs(t, err, document.ErrFieldAlreadyExists)
+	})
+
 	t.Run("should pass when deleting documents", func(t *testing.T) {
-		_, err := db.DeleteDocuments(context.Background(), &protomodel.DeleteDocumentsRequest{
+		resp, err := db.DeleteDocuments(context.Background(), &protomodel.DeleteDocumentsRequest{
 			Query: &protomodel.Query{
 				CollectionName: defaultCollectionName,
 			},
 		})
 		require.NoError(t, err)
+		require.Zero(t, resp.Count)
 
 		_, err = db.DeleteDocuments(context.Background(), &protomodel.DeleteDocumentsRequest{
 			Query: &protomodel.Query{
@@ -277,6 +385,13 @@ func TestDocumentDB_WithCollections(t *testing.T) {
 		require.Len(t, resp.Collections, 0)
 	})
 
+	t.Run("should pass when deleting an already deleted collection", func(t *testing.T) {
+		_, err := db.DeleteCollection(context.Background(), &protomodel.DeleteCollectionRequest{
+			Name: defaultCollectionName,
+		})
+		require.NoError(t, err)
+	})
+
 	t.Run("should pass when creating multiple collections", func(t *testing.T) {
 		// create collection
 		collections := []string{"mycollection1", "mycollection2", "mycollection3"}
@@ -365,6 +480,10 @@ func TestDocumentDB_WithDocuments(t *testing.T) {
 		require.Len(t, res.DocumentIds, 1)
 		docID = res.DocumentIds[0]
 
+		state, err := db.CurrentState()
+		require.NoError(t, err)
+		require.Equal(t, state.TxId, res.TransactionId)
+
 		countResp, err := db.CountDocuments(context.Background(), &protomodel.CountDocumentsRequest{
 			Query: &protomodel.Query{
 				CollectionName: collectionName,
@@ -392,6 +511,7 @@ func TestDocumentDB_WithDocuments(t *testing.T) {
 			},
 		},
 			0,
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -419,6 +539,97 @@ func TestDocumentDB_WithDocuments(t *testing.T) {
 		require.Equal(t, proofRes.VerifiableTx.DualProof.TargetTxHeader.Id, knownState.TxId)
 	})
 
+	t.Run("should pass when streaming documents", func(t *testing.T) {
+		query := &protomodel.Query{CollectionName: collectionName}
+
+		err := db.StreamSearchDocuments(context.Background(), query, nil, nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		var streamed []*protomodel.DocumentAtRevision
+
+		err = db.StreamSearchDocuments(context.Background(), query, []string{"pincode"}, func(revision *protomodel.DocumentAtRevision) error {
+			streamed = append(streamed, revision)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, streamed, 1)
+		require.Equal(t, 123.0, streamed[0].Document.Fields["pincode"].GetNumberValue())
+
+		// revisions are streamed as read by searches
+		reader, err := db.SearchDocuments(context.Background(), query, 0, []string{"pincode"})
+		require.NoError(t, err)
+		defer reader.Close()
+
+		searched, err := reader.ReadN(context.Background(), 1)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(searched[0], streamed[0]))
+
+		errStop := errors.New("stop")
+
+		err = db.StreamSearchDocuments(context.Background(), query, nil, func(revision *protomodel.DocumentAtRevision) error {
+			return errStop
+		})
+		require.ErrorIs(t, err, errStop)
+	})
+
+	t.Run("should pass when querying verified documents", func(t *testing.T) {
+		_, err := db.GetDocumentVerified(context.Background(), nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = db.GetDocumentVerified(context.Background(), &protomodel.GetDocumentVerifiedRequest{
+			Query:    &protomodel.Query{CollectionName: collectionName},
+			PageSize: 1,
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = db.GetDocumentVerified(context.Background(), &protomodel.GetDocumentVerifiedRequest{
+			Query:    &protomodel.Query{CollectionName: collectionName},
+			Page:     1,
+			PageSize: uint32(db.MaxResultSize() + 1),
+		})
+		require.ErrorIs(t, err, ErrResultSizeLimitExceeded)
+
+		query := &protomodel.Query{
+			CollectionName: collectionName,
+			Expressions: []*protomodel.QueryExpression{
+				{
+					FieldComparisons: []*protomodel.FieldComparison{
+						{
+							Field:    "pincode",
+							Operator: protomodel.ComparisonOperator_EQ,
+							Value:    structpb.NewNumberValue(123),
+						},
+					},
+				},
+			},
+		}
+
+		res, err := db.GetDocumentVerified(context.Background(), &protomodel.GetDocumentVerifiedRequest{
+			Query:    query,
+			Page:     2,
+			PageSize: 1,
+		})
+		require.NoError(t, err)
+		require.Empty(t, res.Documents)
+
+		res, err = db.GetDocumentVerified(context.Background(), &protomodel.GetDocumentVerifiedRequest{
+			Query:    query,
+			Page:     1,
+			PageSize: 1,
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Documents, 1)
+
+		verifiedDoc, err := verification.VerifyDocumentInclusion(res.CollectionId, res.Documents[0])
+		require.NoError(t, err)
+		require.True(t, proto.Equal(doc, verifiedDoc))
+
+		res.Documents[0].TxHeader.EH = make([]byte, sha256.Size)
+
+		_, err = verification.VerifyDocumentInclusion(res.CollectionId, res.Documents[0])
+		require.ErrorIs(t, err, store.ErrInvalidProof)
+	})
+
 	var updatedDoc *structpb.Struct
 
 	t.Run("should pass when replacing document", func(t *testing.T) {
@@ -465,6 +676,7 @@ func TestDocumentDB_WithDocuments(t *testing.T) {
 			},
 		},
 			0,
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -494,6 +706,7 @@ func TestDocumentDB_WithDocuments(t *testing.T) {
 		})
 		require.NoError(t, err)
 		require.Len(t, resp.Revisions, 2)
+		require.Equal(t, res.TransactionId, resp.Revisions[0].TransactionId)
 
 		for _, rev := range resp.Revisions {
 			require.Equal(t, docID, rev.Document.Fields["_id"].GetStringValue())
@@ -631,3 +844,254 @@ func TestDocumentDB_WithSerializedJsonDocument(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestDocumentDB_GetDocumentByKey(t *testing.T) {
+	db := makeDocumentDb(t)
+
+	_, err := db.CreateCollection(context.Background(), &protomodel.CreateCollectionRequest{
+		Name:                "orders",
+		DocumentIdFieldName: "number",
+		DocumentIdGenerator: protomodel.DocumentIdGenerator_SEQUENCE,
+		Fields:              []*protomodel.Field{{Name: "item", Type: protomodel.FieldType_STRING}},
+	})
+	require.NoError(t, err)
+
+	res, err := db.InsertDocuments(context.Background(), &protomodel.InsertDocumentsRequest{
+		CollectionName: "orders",
+		Documents: []*structpb.Struct{
+			{Fields: map[string]*structpb.Value{"item": structpb.NewStringValue("book")}},
+			{Fields: map[string]*structpb.Value{"item": structpb.NewStringValue("pen")}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.DocumentIds, 2)
+
+	rev, err := db.GetDocumentByKey(context.Background(), "orders", []*structpb.Value{structpb.NewStringValue(res.DocumentIds[1])})
+	require.NoError(t, err)
+	require.Equal(t, res.TransactionId, rev.TransactionId)
+	require.Equal(t, res.DocumentIds[1], rev.DocumentId)
+	require.Equal(t, "pen", rev.Document.Fields["item"].GetStringValue())
+	require.Equal(t, res.DocumentIds[1], rev.Document.Fields["number"].GetStringValue())
+
+	_, err = db.GetDocumentByKey(context.Background(), "orders", []*structpb.Value{
+		structpb.NewStringValue(document.NewSequenceDocumentID(100).EncodeToHexString()),
+	})
+	require.ErrorIs(t, err, document.ErrDocumentNotFound)
+}
+
+func TestDocumentDB_GetDocuments(t *testing.T) {
+	db := makeDocumentDb(t)
+
+	_, err := db.CreateCollection(context.Background(), &protomodel.CreateCollectionRequest{
+		Name:   "cities",
+		Fields: []*protomodel.Field{{Name: "name", Type: protomodel.FieldType_STRING}},
+	})
+	require.NoError(t, err)
+
+	res, err := db.InsertDocuments(context.Background(), &protomodel.InsertDocumentsRequest{
+		CollectionName: "cities",
+		Documents: []*structpb.Struct{
+			{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("lisbon")}},
+			{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("oslo")}},
+		},
+	})
+	require.NoError(t, err)
+
+	keys := [][]*structpb.Value{
+		{structpb.NewStringValue(res.DocumentIds[1])},
+		{structpb.NewStringValue(document.NewDocumentIDFromTx(0).EncodeToHexString())},
+		{structpb.NewStringValue(res.DocumentIds[0])},
+	}
+
+	revisions, err := db.GetDocuments(context.Background(), "cities", keys)
+	require.NoError(t, err)
+	require.Len(t, revisions, 3)
+	require.Equal(t, "oslo", revisions[0].Document.Fields["name"].GetStringValue())
+	require.Nil(t, revisions[1])
+	require.Equal(t, "lisbon", revisions[2].Document.Fields["name"].GetStringValue())
+
+	tooManyKeys := make([][]*structpb.Value, db.MaxResultSize()+1)
+	for i := range tooManyKeys {
+		tooManyKeys[i] = keys[0]
+	}
+
+	_, err = db.GetDocuments(context.Background(), "cities", tooManyKeys)
+	require.ErrorIs(t, err, ErrResultSizeLimitExceeded)
+}
+
+func TestDocumentDB_GetDocumentHistory(t *testing.T) {
+	db := makeDocumentDb(t)
+
+	_, err := db.CreateCollection(context.Background(), &protomodel.CreateCollectionRequest{
+		Name:   "profiles",
+		Fields: []*protomodel.Field{{Name: "name", Type: protomodel.FieldType_STRING}},
+	})
+	require.NoError(t, err)
+
+	res, err := db.InsertDocuments(context.Background(), &protomodel.InsertDocumentsRequest{
+		CollectionName: "profiles",
+		Documents:      []*structpb.Struct{{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("bob")}}},
+	})
+	require.NoError(t, err)
+
+	key := []*structpb.Value{structpb.NewStringValue(res.DocumentIds[0])}
+
+	_, err = db.ReplaceDocuments(context.Background(), &protomodel.ReplaceDocumentsRequest{
+		Query: &protomodel.Query{
+			CollectionName: "profiles",
+			Expressions: []*protomodel.QueryExpression{{
+				FieldComparisons: []*protomodel.FieldComparison{{
+					Field:    "_id",
+					Operator: protomodel.ComparisonOperator_EQ,
+					Value:    key[0],
+				}},
+			}},
+		},
+		Document: &structpb.Struct{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("robert")}},
+	})
+	require.NoError(t, err)
+
+	_, err = db.GetDocumentHistory(context.Background(), "profiles", key, -1, 0)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = db.GetDocumentHistory(context.Background(), "profiles", key, db.MaxResultSize()+1, 0)
+	require.ErrorIs(t, err, ErrResultSizeLimitExceeded)
+
+	revisions, err := db.GetDocumentHistory(context.Background(), "profiles", key, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	require.Equal(t, "robert", revisions[0].Document.Fields["name"].GetStringValue())
+	require.Equal(t, "bob", revisions[1].Document.Fields["name"].GetStringValue())
+	require.Equal(t, res.TransactionId, revisions[1].TransactionId)
+	require.NotZero(t, revisions[1].Timestamp)
+}
+
+func TestDocumentDB_DocumentLimits(t *testing.T) {
+	options := DefaultOption().
+		WithDBRootPath(t.TempDir()).
+		WithMaxDocumentSize(1024)
+
+	db := makeDbWith(t, "doc_limits_db", options)
+
+	_, err := db.CreateCollection(context.Background(), &protomodel.CreateCollectionRequest{
+		Name:              "events",
+		MaxDocumentFields: 2,
+	})
+	require.NoError(t, err)
+
+	collection, err := db.GetCollection(context.Background(), &protomodel.GetCollectionRequest{Name: "events"})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, collection.Collection.MaxDocumentFields)
+	require.Zero(t, collection.Collection.MaxDocumentSize)
+
+	_, err = db.InsertDocuments(context.Background(), &protomodel.InsertDocumentsRequest{
+		CollectionName: "events",
+		Documents:      []*structpb.Struct{{Fields: map[string]*structpb.Value{"kind": structpb.NewStringValue("login")}}},
+	})
+	require.NoError(t, err)
+
+	_, err = db.InsertDocuments(context.Background(), &protomodel.InsertDocumentsRequest{
+		CollectionName: "events",
+		Documents: []*structpb.Struct{{Fields: map[string]*structpb.Value{
+			"kind": structpb.NewStringValue("login"),
+			"user": structpb.NewStringValue("alice"),
+		}}},
+	})
+	require.ErrorIs(t, err, document.ErrMaxFieldsExceeded)
+
+	_, err = db.InsertDocuments(context.Background(), &protomodel.InsertDocumentsRequest{
+		CollectionName: "events",
+		Documents:      []*structpb.Struct{{Fields: map[string]*structpb.Value{"kind": structpb.NewStringValue(strings.Repeat("a", 2048))}}},
+	})
+	require.ErrorIs(t, err, document.ErrMaxDocumentSizeExceeded)
+}
+
+func TestDocumentDB_ExpiredDocumentsSweeper(t *testing.T) {
+	// documents are written in the past until the clock is set to the present
+	var clockOffset int64 = int64(-2 * time.Hour)
+
+	options := DefaultOption().
+		WithDBRootPath(t.TempDir()).
+		WithDocumentSweepFrequency(10 * time.Millisecond)
+
+	options.storeOpts.WithTimeFunc(func() time.Time {
+		return time.Now().Add(time.Duration(atomic.LoadInt64(&clockOffset)))
+	})
+
+	db := makeDbWith(t, "doc_ttl_db", options)
+
+	_, err := db.CreateCollection(context.Background(), &protomodel.CreateCollectionRequest{
+		Name: "sessions",
+		Ttl:  60,
+	})
+	require.NoError(t, err)
+
+	collection, err := db.GetCollection(context.Background(), &protomodel.GetCollectionRequest{Name: "sessions"})
+	require.NoError(t, err)
+	require.Equal(t, int64(60), collection.Collection.Ttl)
+
+	res, err := db.InsertDocuments(context.Background(), &protomodel.InsertDocumentsRequest{
+		CollectionName: "sessions",
+		Documents:      []*structpb.Struct{{Fields: map[string]*structpb.Value{"user": structpb.NewStringValue("alice")}}},
+	})
+	require.NoError(t, err)
+
+	atomic.StoreInt64(&clockOffset, 0)
+
+	count, err := db.CountDocuments(context.Background(), &protomodel.CountDocumentsRequest{
+		Query: &protomodel.Query{CollectionName: "sessions"},
+	})
+	require.NoError(t, err)
+	require.Zero(t, count.Count)
+
+	// an interrupted sweep stops before deleting the next batch
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	deleted, err := db.deleteExpiredCollectionDocuments(ctx, "sessions")
+	require.ErrorIs(t, err, context.Canceled)
+	require.Zero(t, deleted)
+
+	// the sweeper eventually deletes the expired document
+	require.Eventually(t, func() bool {
+		audit, err := db.AuditDocument(context.Background(), &protomodel.AuditDocumentRequest{
+			CollectionName: "sessions",
+			DocumentId:     res.DocumentIds[0],
+			Page:           1,
+			PageSize:       10,
+		})
+		require.NoError(t, err)
+
+		return len(audit.Revisions) == 2 && audit.Revisions[1].Metadata.Deleted
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestDocumentDB_CollectionStats(t *testing.T) {
+	db := makeDocumentDb(t)
+
+	_, err := db.CollectionStats(context.Background(), "cities")
+	require.ErrorIs(t, err, document.ErrCollectionDoesNotExist)
+
+	_, err = db.CreateCollection(context.Background(), &protomodel.CreateCollectionRequest{
+		Name:    "cities",
+		Fields:  []*protomodel.Field{{Name: "name", Type: protomodel.FieldType_STRING}},
+		Indexes: []*protomodel.Index{{Fields: []string{"name"}}},
+	})
+	require.NoError(t, err)
+
+	_, err = db.InsertDocuments(context.Background(), &protomodel.InsertDocumentsRequest{
+		CollectionName: "cities",
+		Documents: []*structpb.Struct{
+			{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("lisbon")}},
+			{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("oslo")}},
+			{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("rome")}},
+		},
+	})
+	require.NoError(t, err)
+
+	stats, err := db.CollectionStats(context.Background(), "cities")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), stats.DocumentCount)
+	require.Equal(t, 2, stats.IndexCount)
+	require.Positive(t, stats.Size)
+}
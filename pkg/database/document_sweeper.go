@@ -0,0 +1,144 @@
+/*
+Copyright 2023 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/document"
+)
+
+func (d *db) startDocumentSweeper(frequency time.Duration) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	d.documentSweeperCancel = cancel
+	d.documentSweeperDone = make(chan struct{})
+
+	go func() {
+		defer close(d.documentSweeperDone)
+
+		ticker := time.NewTicker(frequency)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				d.deleteExpiredDocuments(ctx)
+			}
+		}
+	}()
+}
+
+// stopDocumentSweeper must be called while holding closingMutex
+func (d *db) stopDocumentSweeper() {
+	if d.documentSweeperCancel == nil {
+		return
+	}
+
+	d.documentSweeperCancel()
+	<-d.documentSweeperDone
+
+	d.documentSweeperCancel = nil
+	d.documentSweeperDone = nil
+}
+
+// deleteExpiredDocuments deletes the expired documents of every collection,
+// replicas are skipped as they get the deletions from their primary.
+// The sweep is interrupted as soon as the context is done
+func (d *db) deleteExpiredDocuments(ctx context.Context) {
+	collections, err := d.expirableCollections(ctx)
+	if err != nil {
+		d.Logger.Warningf("Unable to list collections of database '%s' to delete expired documents: %v", d.name, err)
+		return
+	}
+
+	for _, collection := range collections {
+		if ctx.Err() != nil {
+			return
+		}
+
+		count, err := d.deleteExpiredCollectionDocuments(ctx, collection)
+		if err != nil && ctx.Err() == nil {
+			d.Logger.Warningf("Unable to delete expired documents of collection '%s' in database '%s': %v", collection, d.name, err)
+		}
+
+		if count > 0 {
+			d.Logger.Infof("%d expired documents deleted from collection '%s' in database '%s'", count, collection, d.name)
+		}
+	}
+}
+
+// expirableCollections returns the names of the collections with a ttl, none when the database is a replica
+func (d *db) expirableCollections(ctx context.Context) ([]string, error) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	if d.isReplica() {
+		return nil, nil
+	}
+
+	collections, err := d.documentEngine.GetCollections(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+
+	for _, collection := range collections {
+		if collection.Ttl > 0 {
+			names = append(names, collection.Name)
+		}
+	}
+
+	return names, nil
+}
+
+// deleteExpiredCollectionDocuments deletes the expired documents of the collection batch by batch,
+// the database is only locked while each batch is deleted so other operations are not held back
+// during the whole sweep. It stops as soon as the database becomes a replica
+func (d *db) deleteExpiredCollectionDocuments(ctx context.Context, collection string) (count int64, err error) {
+	var lastDocID document.DocumentID
+
+	for {
+		if ctx.Err() != nil {
+			return count, ctx.Err()
+		}
+
+		deleted, lastScannedDocID, err := d.deleteExpiredDocumentsBatch(ctx, collection, lastDocID)
+		count += deleted
+
+		if err != nil || lastScannedDocID == nil {
+			return count, err
+		}
+
+		lastDocID = lastScannedDocID
+	}
+}
+
+func (d *db) deleteExpiredDocumentsBatch(ctx context.Context, collection string, afterDocID document.DocumentID) (int64, document.DocumentID, error) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	if d.isReplica() {
+		return 0, nil, nil
+	}
+
+	return d.documentEngine.DeleteExpiredDocumentsBatch(ctx, collection, afterDocID)
+}
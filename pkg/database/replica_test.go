@@ -132,3 +132,49 @@ func TestSwitchToReplica(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestReplicaTypedErrors(t *testing.T) {
+	primary := makeDb(t)
+
+	_, err := primary.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key1"), Value: []byte("value1")}}})
+	require.NoError(t, err)
+
+	state, err := primary.CurrentState()
+	require.NoError(t, err)
+
+	etx, _, _, err := primary.ExportTxByID(context.Background(), &schema.ExportTxRequest{Tx: state.TxId})
+	require.NoError(t, err)
+
+	// replica states are only accepted by a primary with synchronous replication
+	primary.AsReplica(false, false, 1)
+
+	_, _, _, err = primary.ExportTxByID(context.Background(), &schema.ExportTxRequest{
+		Tx:           state.TxId,
+		ReplicaState: &schema.ReplicaState{CommittedTxID: state.TxId + 1},
+	})
+	require.ErrorIs(t, err, ErrReplicaCommitStateDiverged)
+	require.ErrorIs(t, err, ErrReplicaDivergedFromPrimary)
+
+	_, _, _, err = primary.ExportTxByID(context.Background(), &schema.ExportTxRequest{
+		Tx:           state.TxId,
+		ReplicaState: &schema.ReplicaState{PrecommittedTxID: state.TxId + 1},
+	})
+	require.ErrorIs(t, err, ErrReplicaPrecommitStateDiverged)
+	require.ErrorIs(t, err, ErrReplicaDivergedFromPrimary)
+
+	replica := makeDbWith(t, "replica", DefaultOption().WithDBRootPath(t.TempDir()).AsReplica(true))
+
+	for i := uint64(1); i <= state.TxId; i++ {
+		etx, _, _, err := primary.ExportTxByID(context.Background(), &schema.ExportTxRequest{Tx: i})
+		require.NoError(t, err)
+
+		_, err = replica.ReplicateTx(context.Background(), etx, false, false)
+		require.NoError(t, err)
+	}
+
+	_, err = replica.ReplicateTx(context.Background(), etx, false, false)
+	require.ErrorIs(t, err, ErrTxAlreadyCommitted)
+
+	err = replica.AllowCommitUpto(state.TxId, [32]byte{})
+	require.ErrorIs(t, err, ErrReplicaCommitStateDiverged)
+}
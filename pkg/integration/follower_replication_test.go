@@ -21,12 +21,14 @@ import (
 	"encoding/binary"
 	"fmt"
 	"net"
+	"os"
 	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/codenotary/immudb/embedded"
+	"github.com/codenotary/immudb/embedded/logger"
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/auth"
 	ic "github.com/codenotary/immudb/pkg/client"
@@ -34,7 +36,11 @@ import (
 	"github.com/codenotary/immudb/pkg/replication"
 	"github.com/codenotary/immudb/pkg/server"
 	"github.com/codenotary/immudb/pkg/stream"
+	"github.com/rs/xid"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func TestReplication(t *testing.T) {
@@ -986,3 +992,1246 @@ func BenchmarkStreamExportTx(b *testing.B) {
 		wg.Wait()
 	}
 }
+
+func TestReplicationOptionsValidateConnection(t *testing.T) {
+	serverOpts := server.DefaultOptions().
+		WithMetricsServer(false).
+		WithWebServer(false).
+		WithPgsqlServer(false).
+		WithPort(0).
+		WithDir(t.TempDir())
+
+	srv := server.DefaultServer().WithOptions(serverOpts).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer srv.Stop()
+
+	port := srv.Listener.Addr().(*net.TCPAddr).Port
+
+	client := ic.NewClient().WithOptions(ic.DefaultOptions().WithDir(t.TempDir()).WithPort(port))
+
+	err = client.OpenSession(context.Background(), []byte(`immudb`), []byte(`immudb`), "defaultdb")
+	require.NoError(t, err)
+	defer client.CloseSession(context.Background())
+
+	_, err = client.CreateDatabaseV2(context.Background(), "emptydb", nil)
+	require.NoError(t, err)
+
+	_, err = client.Set(context.Background(), []byte("key1"), []byte("value1"))
+	require.NoError(t, err)
+
+	validOpts := func() *replication.Options {
+		return replication.DefaultOptions().
+			WithPrimaryDatabase("defaultdb").
+			WithPrimaryHost("127.0.0.1").
+			WithPrimaryPort(port).
+			WithPrimaryUsername("immudb").
+			WithPrimaryPassword("immudb").
+			WithDialTimeout(5 * time.Second)
+	}
+
+	t.Run("valid options", func(t *testing.T) {
+		err := validOpts().ValidateConnection(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("valid options over an empty database", func(t *testing.T) {
+		err := validOpts().WithPrimaryDatabase("emptydb").ValidateConnection(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("valid options with compression", func(t *testing.T) {
+		err := validOpts().WithStreamCompression(replication.StreamCompressionGzip).ValidateConnection(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		err := validOpts().WithStreamChunkSize(0).ValidateConnection(context.Background())
+		require.ErrorIs(t, err, replication.ErrInvalidOptions)
+	})
+
+	t.Run("wrong credentials", func(t *testing.T) {
+		err := validOpts().WithPrimaryPassword("wrong").ValidateConnection(context.Background())
+		require.ErrorContains(t, err, "invalid user name or password")
+	})
+
+	t.Run("unexistent database", func(t *testing.T) {
+		err := validOpts().WithPrimaryDatabase("unexistentdb").ValidateConnection(context.Background())
+		require.ErrorContains(t, err, database.ErrDatabaseNotExists.Error())
+	})
+
+	t.Run("unreachable primary", func(t *testing.T) {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+
+		unusedPort := l.Addr().(*net.TCPAddr).Port
+		l.Close()
+
+		err = validOpts().
+			WithPrimaryPort(unusedPort).
+			WithDialTimeout(500 * time.Millisecond).
+			ValidateConnection(context.Background())
+		require.ErrorContains(t, err, fmt.Sprintf("endpoint '127.0.0.1:%d'", unusedPort))
+	})
+}
+
+type fixedDelayer time.Duration
+
+func (d fixedDelayer) DelayAfter(retries int) time.Duration {
+	return time.Duration(d)
+}
+
+func TestReplicatorCredentialsUpdate(t *testing.T) {
+	serverOpts := server.DefaultOptions().
+		WithMetricsServer(false).
+		WithWebServer(false).
+		WithPgsqlServer(false).
+		WithPort(0).
+		WithDir(t.TempDir())
+
+	srv := server.DefaultServer().WithOptions(serverOpts).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer srv.Stop()
+
+	port := srv.Listener.Addr().(*net.TCPAddr).Port
+
+	client := ic.NewClient().WithOptions(ic.DefaultOptions().WithDir(t.TempDir()).WithPort(port))
+
+	err = client.OpenSession(context.Background(), []byte(`immudb`), []byte(`immudb`), "defaultdb")
+	require.NoError(t, err)
+	defer client.CloseSession(context.Background())
+
+	for _, user := range []string{"replicator1", "replicator2"} {
+		err = client.CreateUser(context.Background(), []byte(user), []byte("replicator1Pwd!"), auth.PermissionAdmin, "defaultdb")
+		require.NoError(t, err)
+	}
+
+	logger := logger.NewSimpleLogger("replica", os.Stdout)
+
+	replicaDB, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer replicaDB.Close()
+
+	rOpts := replication.DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(port).
+		WithPrimaryUsername("replicator1").
+		WithPrimaryPassword("replicator1Pwd!").
+		WithDelayer(fixedDelayer(10 * time.Millisecond))
+
+	txReplicator, err := replication.NewTxReplicator(xid.New(), replicaDB, rOpts, logger)
+	require.NoError(t, err)
+
+	err = txReplicator.Start()
+	require.NoError(t, err)
+	defer txReplicator.Stop()
+
+	waitForReplicatedTx := func(txID uint64) {
+		require.Eventually(t, func() bool {
+			state, err := replicaDB.CurrentState()
+			return err == nil && state.TxId >= txID
+		}, 10*time.Second, 10*time.Millisecond)
+	}
+
+	hdr, err := client.Set(context.Background(), []byte("key1"), []byte("value1"))
+	require.NoError(t, err)
+
+	waitForReplicatedTx(hdr.Id)
+
+	txReplicator.UpdateCredentials("replicator2", "wrongPwd!")
+
+	// the ongoing fetch is completed using the current connection
+	hdr, err = client.Set(context.Background(), []byte("key2"), []byte("value2"))
+	require.NoError(t, err)
+
+	waitForReplicatedTx(hdr.Id)
+
+	// reconnection with invalid credentials fails
+	require.Eventually(t, func() bool {
+		return txReplicator.Status().ConsecutiveFailures > 0
+	}, 10*time.Second, 10*time.Millisecond)
+
+	require.False(t, txReplicator.Status().Connected)
+
+	txReplicator.UpdateCredentials("replicator2", "replicator1Pwd!")
+
+	hdr, err = client.Set(context.Background(), []byte("key3"), []byte("value3"))
+	require.NoError(t, err)
+
+	waitForReplicatedTx(hdr.Id)
+
+	require.True(t, txReplicator.Status().Connected)
+}
+
+func TestReplicatorSessionExpiration(t *testing.T) {
+	serverOpts := server.DefaultOptions().
+		WithMetricsServer(false).
+		WithWebServer(false).
+		WithPgsqlServer(false).
+		WithPort(0).
+		WithDir(t.TempDir())
+
+	serverOpts.SessionsOptions.
+		WithSessionGuardCheckInterval(100 * time.Millisecond).
+		WithMaxSessionAgeTime(time.Second)
+
+	srv := server.DefaultServer().WithOptions(serverOpts).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer srv.Stop()
+
+	port := srv.Listener.Addr().(*net.TCPAddr).Port
+
+	set := func(key, value string) uint64 {
+		client := ic.NewClient().WithOptions(ic.DefaultOptions().WithDir(t.TempDir()).WithPort(port))
+
+		err := client.OpenSession(context.Background(), []byte(`immudb`), []byte(`immudb`), "defaultdb")
+		require.NoError(t, err)
+		defer client.CloseSession(context.Background())
+
+		hdr, err := client.Set(context.Background(), []byte(key), []byte(value))
+		require.NoError(t, err)
+
+		return hdr.Id
+	}
+
+	logger := logger.NewSimpleLogger("replica", os.Stdout)
+
+	replicaDB, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer replicaDB.Close()
+
+	// a long delay ensures the replicator only makes progress
+	// if it reconnects right after the session expired
+	rOpts := replication.DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(port).
+		WithPrimaryUsername("immudb").
+		WithPrimaryPassword("immudb").
+		WithDelayer(fixedDelayer(time.Hour))
+
+	txReplicator, err := replication.NewTxReplicator(xid.New(), replicaDB, rOpts, logger)
+	require.NoError(t, err)
+
+	err = txReplicator.Start()
+	require.NoError(t, err)
+	defer txReplicator.Stop()
+
+	waitForReplicatedTx := func(txID uint64) {
+		require.Eventually(t, func() bool {
+			state, err := replicaDB.CurrentState()
+			return err == nil && state.TxId >= txID
+		}, 10*time.Second, 10*time.Millisecond)
+	}
+
+	for i := 0; i < 3; i++ {
+		txID := set(fmt.Sprintf("key%d", i), fmt.Sprintf("value%d", i))
+		waitForReplicatedTx(txID)
+
+		// let the replication session expire
+		time.Sleep(1500 * time.Millisecond)
+	}
+}
+
+func TestReplicatorFallbackEndpoints(t *testing.T) {
+	serverOpts := server.DefaultOptions().
+		WithMetricsServer(false).
+		WithWebServer(false).
+		WithPgsqlServer(false).
+		WithPort(0).
+		WithDir(t.TempDir())
+
+	srv := server.DefaultServer().WithOptions(serverOpts).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer srv.Stop()
+
+	port := srv.Listener.Addr().(*net.TCPAddr).Port
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	unusedPort := l.Addr().(*net.TCPAddr).Port
+	l.Close()
+
+	client := ic.NewClient().WithOptions(ic.DefaultOptions().WithDir(t.TempDir()).WithPort(port))
+
+	err = client.OpenSession(context.Background(), []byte(`immudb`), []byte(`immudb`), "defaultdb")
+	require.NoError(t, err)
+	defer client.CloseSession(context.Background())
+
+	hdr, err := client.Set(context.Background(), []byte("key1"), []byte("value1"))
+	require.NoError(t, err)
+
+	rOpts := replication.DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(unusedPort).
+		WithPrimaryFallbackEndpoints(replication.Endpoint{Host: "127.0.0.1", Port: port}).
+		WithPrimaryUsername("immudb").
+		WithPrimaryPassword("immudb").
+		WithDialTimeout(10 * time.Second).
+		WithDelayer(fixedDelayer(10 * time.Millisecond))
+
+	t.Run("connection validation succeeds through a fallback endpoint", func(t *testing.T) {
+		err := rOpts.ValidateConnection(context.Background())
+		require.NoError(t, err)
+	})
+
+	logger := logger.NewSimpleLogger("replica", os.Stdout)
+
+	replicaDB, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer replicaDB.Close()
+
+	txReplicator, err := replication.NewTxReplicator(xid.New(), replicaDB, rOpts, logger)
+	require.NoError(t, err)
+
+	require.Equal(t, fmt.Sprintf("defaultdb@127.0.0.1:%d", unusedPort), txReplicator.Status().PrimaryDB)
+
+	err = txReplicator.Start()
+	require.NoError(t, err)
+	defer txReplicator.Stop()
+
+	require.Eventually(t, func() bool {
+		state, err := replicaDB.CurrentState()
+		return err == nil && state.TxId >= hdr.Id
+	}, 30*time.Second, 10*time.Millisecond)
+
+	status := txReplicator.Status()
+	require.True(t, status.Connected)
+	require.Equal(t, fmt.Sprintf("defaultdb@127.0.0.1:%d", port), status.PrimaryDB)
+
+	state, err := replicaDB.CurrentState()
+	require.NoError(t, err)
+	require.Equal(t, state.TxId, txReplicator.ReplicatedTxCount())
+}
+
+func TestReplicatorStreamCompression(t *testing.T) {
+	serverOpts := server.DefaultOptions().
+		WithMetricsServer(false).
+		WithWebServer(false).
+		WithPgsqlServer(false).
+		WithPort(0).
+		WithDir(t.TempDir())
+
+	srv := server.DefaultServer().WithOptions(serverOpts).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer srv.Stop()
+
+	port := srv.Listener.Addr().(*net.TCPAddr).Port
+
+	client := ic.NewClient().WithOptions(ic.DefaultOptions().WithDir(t.TempDir()).WithPort(port))
+
+	err = client.OpenSession(context.Background(), []byte(`immudb`), []byte(`immudb`), "defaultdb")
+	require.NoError(t, err)
+	defer client.CloseSession(context.Background())
+
+	kvs := make([]*schema.KeyValue, 100)
+	for i := range kvs {
+		kvs[i] = &schema.KeyValue{
+			Key:   []byte(fmt.Sprintf("key%d", i)),
+			Value: []byte(strings.Repeat("value", 100)),
+		}
+	}
+
+	var lastTxID uint64
+
+	for i := 0; i < 10; i++ {
+		hdr, err := client.SetAll(context.Background(), &schema.SetRequest{KVs: kvs})
+		require.NoError(t, err)
+
+		lastTxID = hdr.Id
+	}
+
+	logger := logger.NewSimpleLogger("replica", os.Stdout)
+
+	replicaDB, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer replicaDB.Close()
+
+	rOpts := replication.DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(port).
+		WithPrimaryUsername("immudb").
+		WithPrimaryPassword("immudb").
+		WithStreamCompression(replication.StreamCompressionGzip).
+		WithStreamChunkSize(stream.MinChunkSize).
+		WithAutoChunkSize(true).
+		WithFetchConcurrency(2)
+
+	txReplicator, err := replication.NewTxReplicator(xid.New(), replicaDB, rOpts, logger)
+	require.NoError(t, err)
+
+	err = txReplicator.Start()
+	require.NoError(t, err)
+	defer txReplicator.Stop()
+
+	require.Eventually(t, func() bool {
+		state, err := replicaDB.CurrentState()
+		return err == nil && state.TxId >= lastTxID
+	}, 30*time.Second, 10*time.Millisecond)
+
+	entry, err := replicaDB.Get(context.Background(), &schema.KeyRequest{Key: []byte("key99")})
+	require.NoError(t, err)
+	require.Equal(t, strings.Repeat("value", 100), string(entry.Value))
+
+	// transactions don't fit into the initial chunk size
+	require.Greater(t, txReplicator.Status().ChunkSize, stream.MinChunkSize)
+}
+
+func TestReplicatorFetchConcurrencyIdlePrimary(t *testing.T) {
+	serverOpts := server.DefaultOptions().
+		WithMetricsServer(false).
+		WithWebServer(false).
+		WithPgsqlServer(false).
+		WithPort(0).
+		WithDir(t.TempDir())
+
+	srv := server.DefaultServer().WithOptions(serverOpts).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer srv.Stop()
+
+	port := srv.Listener.Addr().(*net.TCPAddr).Port
+
+	client := ic.NewClient().WithOptions(ic.DefaultOptions().WithDir(t.TempDir()).WithPort(port))
+
+	err = client.OpenSession(context.Background(), []byte(`immudb`), []byte(`immudb`), "defaultdb")
+	require.NoError(t, err)
+	defer client.CloseSession(context.Background())
+
+	set := func(n int) (lastTxID uint64) {
+		for i := 0; i < n; i++ {
+			hdr, err := client.Set(context.Background(), []byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+			require.NoError(t, err)
+
+			lastTxID = hdr.Id
+		}
+
+		return lastTxID
+	}
+
+	lastTxID := set(10)
+
+	logger := logger.NewSimpleLogger("replica", os.Stdout)
+
+	replicaDB, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer replicaDB.Close()
+
+	rOpts := replication.DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(port).
+		WithPrimaryUsername("immudb").
+		WithPrimaryPassword("immudb").
+		WithFetchConcurrency(4)
+
+	txReplicator, err := replication.NewTxReplicator(xid.New(), replicaDB, rOpts, logger)
+	require.NoError(t, err)
+
+	err = txReplicator.Start()
+	require.NoError(t, err)
+	defer txReplicator.Stop()
+
+	waitForReplicatedTx := func(txID uint64) {
+		require.Eventually(t, func() bool {
+			state, err := replicaDB.CurrentState()
+			return err == nil && state.TxId == txID
+		}, 10*time.Second, 10*time.Millisecond)
+	}
+
+	// the primary stops writing, the last transactions must be replicated nonetheless
+	waitForReplicatedTx(lastTxID)
+
+	lastTxID = set(3)
+	waitForReplicatedTx(lastTxID)
+}
+
+func TestReplicatorMaxReplicationRetries(t *testing.T) {
+	serverOpts := server.DefaultOptions().
+		WithMetricsServer(false).
+		WithWebServer(false).
+		WithPgsqlServer(false).
+		WithPort(0).
+		WithDir(t.TempDir())
+
+	srv := server.DefaultServer().WithOptions(serverOpts).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer srv.Stop()
+
+	port := srv.Listener.Addr().(*net.TCPAddr).Port
+
+	client := ic.NewClient().WithOptions(ic.DefaultOptions().WithDir(t.TempDir()).WithPort(port))
+
+	err = client.OpenSession(context.Background(), []byte(`immudb`), []byte(`immudb`), "defaultdb")
+	require.NoError(t, err)
+	defer client.CloseSession(context.Background())
+
+	_, err = client.Set(context.Background(), []byte("key1"), []byte("value1"))
+	require.NoError(t, err)
+
+	logger := logger.NewSimpleLogger("replica", os.Stdout)
+
+	// transactions can not be applied to a database which is not a replica
+	replicaDB, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer replicaDB.Close()
+
+	rOpts := replication.DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(port).
+		WithPrimaryUsername("immudb").
+		WithPrimaryPassword("immudb").
+		WithDialTimeout(10 * time.Second).
+		WithMaxReplicationRetries(3).
+		WithDelayer(fixedDelayer(10 * time.Millisecond))
+
+	txReplicator, err := replication.NewTxReplicator(xid.New(), replicaDB, rOpts, logger)
+	require.NoError(t, err)
+
+	err = txReplicator.Start()
+	require.NoError(t, err)
+	defer txReplicator.Stop()
+
+	require.Eventually(t, func() bool {
+		return !txReplicator.Status().Running
+	}, 30*time.Second, 10*time.Millisecond)
+
+	require.Zero(t, txReplicator.ReplicatedTxCount())
+}
+
+func TestReplicatorCatchUpTo(t *testing.T) {
+	serverOpts := server.DefaultOptions().
+		WithMetricsServer(false).
+		WithWebServer(false).
+		WithPgsqlServer(false).
+		WithPort(0).
+		WithDir(t.TempDir())
+
+	srv := server.DefaultServer().WithOptions(serverOpts).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer srv.Stop()
+
+	port := srv.Listener.Addr().(*net.TCPAddr).Port
+
+	client := ic.NewClient().WithOptions(ic.DefaultOptions().WithDir(t.TempDir()).WithPort(port))
+
+	err = client.OpenSession(context.Background(), []byte(`immudb`), []byte(`immudb`), "defaultdb")
+	require.NoError(t, err)
+	defer client.CloseSession(context.Background())
+
+	var lastTxID uint64
+
+	for i := 0; i < 10; i++ {
+		hdr, err := client.Set(context.Background(), []byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+
+		lastTxID = hdr.Id
+	}
+
+	logger := logger.NewSimpleLogger("replica", os.Stdout)
+
+	replicaDB, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer replicaDB.Close()
+
+	rOpts := replication.DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(port).
+		WithPrimaryUsername("immudb").
+		WithPrimaryPassword("immudb").
+		WithDialTimeout(10 * time.Second)
+
+	txReplicator, err := replication.NewTxReplicator(xid.New(), replicaDB, rOpts, logger)
+	require.NoError(t, err)
+
+	err = txReplicator.CatchUpTo(context.Background(), lastTxID-5)
+	require.NoError(t, err)
+
+	state, err := replicaDB.CurrentState()
+	require.NoError(t, err)
+	require.Equal(t, lastTxID-5, state.TxId)
+	require.False(t, txReplicator.Status().Running)
+
+	err = txReplicator.CatchUpTo(context.Background(), lastTxID)
+	require.NoError(t, err)
+
+	state, err = replicaDB.CurrentState()
+	require.NoError(t, err)
+	require.Equal(t, lastTxID, state.TxId)
+
+	entry, err := replicaDB.Get(context.Background(), &schema.KeyRequest{Key: []byte("key9")})
+	require.NoError(t, err)
+	require.Equal(t, []byte("value9"), entry.Value)
+
+	// the primary does not have the target transaction yet
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	err = txReplicator.CatchUpTo(ctx, lastTxID+1)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// replication can be resumed after catching up
+	err = txReplicator.Start()
+	require.NoError(t, err)
+	defer txReplicator.Stop()
+
+	err = txReplicator.CatchUpTo(context.Background(), lastTxID)
+	require.ErrorIs(t, err, replication.ErrAlreadyRunning)
+
+	_, err = client.Set(context.Background(), []byte("key10"), []byte("value10"))
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		state, err := replicaDB.CurrentState()
+		return err == nil && state.TxId == lastTxID+1
+	}, 30*time.Second, 10*time.Millisecond)
+}
+
+func TestReplicatorPauseResume(t *testing.T) {
+	serverOpts := server.DefaultOptions().
+		WithMetricsServer(false).
+		WithWebServer(false).
+		WithPgsqlServer(false).
+		WithPort(0).
+		WithDir(t.TempDir())
+
+	srv := server.DefaultServer().WithOptions(serverOpts).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer srv.Stop()
+
+	port := srv.Listener.Addr().(*net.TCPAddr).Port
+
+	client := ic.NewClient().WithOptions(ic.DefaultOptions().WithDir(t.TempDir()).WithPort(port))
+
+	err = client.OpenSession(context.Background(), []byte(`immudb`), []byte(`immudb`), "defaultdb")
+	require.NoError(t, err)
+	defer client.CloseSession(context.Background())
+
+	logger := logger.NewSimpleLogger("replica", os.Stdout)
+
+	replicaDB, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer replicaDB.Close()
+
+	rOpts := replication.DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(port).
+		WithPrimaryUsername("immudb").
+		WithPrimaryPassword("immudb").
+		WithDialTimeout(10 * time.Second)
+
+	txReplicator, err := replication.NewTxReplicator(xid.New(), replicaDB, rOpts, logger)
+	require.NoError(t, err)
+
+	err = txReplicator.Pause()
+	require.ErrorIs(t, err, replication.ErrAlreadyStopped)
+
+	err = txReplicator.Start()
+	require.NoError(t, err)
+	defer txReplicator.Stop()
+
+	hdr, err := client.Set(context.Background(), []byte("key1"), []byte("value1"))
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		state, err := replicaDB.CurrentState()
+		return err == nil && state.TxId == hdr.Id
+	}, 30*time.Second, 10*time.Millisecond)
+
+	err = txReplicator.Resume()
+	require.ErrorIs(t, err, replication.ErrNotPaused)
+
+	err = txReplicator.Pause()
+	require.NoError(t, err)
+
+	err = txReplicator.Pause()
+	require.ErrorIs(t, err, replication.ErrAlreadyPaused)
+
+	status := txReplicator.Status()
+	require.True(t, status.Running)
+	require.True(t, status.Paused)
+	require.True(t, status.Connected)
+
+	var lastTxID uint64
+
+	for i := 2; i <= 5; i++ {
+		hdr, err := client.Set(context.Background(), []byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+
+		lastTxID = hdr.Id
+	}
+
+	// no transaction is replicated while paused
+	time.Sleep(500 * time.Millisecond)
+
+	state, err := replicaDB.CurrentState()
+	require.NoError(t, err)
+	require.Equal(t, hdr.Id, state.TxId)
+
+	err = txReplicator.Resume()
+	require.NoError(t, err)
+	require.False(t, txReplicator.Status().Paused)
+
+	require.Eventually(t, func() bool {
+		state, err := replicaDB.CurrentState()
+		return err == nil && state.TxId == lastTxID
+	}, 30*time.Second, 10*time.Millisecond)
+
+	// a stopped replicator is no longer paused
+	err = txReplicator.Pause()
+	require.NoError(t, err)
+
+	err = txReplicator.Stop()
+	require.NoError(t, err)
+
+	status = txReplicator.Status()
+	require.False(t, status.Running)
+	require.False(t, status.Paused)
+}
+
+func TestReplicatorApplyHook(t *testing.T) {
+	serverOpts := server.DefaultOptions().
+		WithMetricsServer(false).
+		WithWebServer(false).
+		WithPgsqlServer(false).
+		WithPort(0).
+		WithDir(t.TempDir())
+
+	srv := server.DefaultServer().WithOptions(serverOpts).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer srv.Stop()
+
+	port := srv.Listener.Addr().(*net.TCPAddr).Port
+
+	client := ic.NewClient().WithOptions(ic.DefaultOptions().WithDir(t.TempDir()).WithPort(port))
+
+	err = client.OpenSession(context.Background(), []byte(`immudb`), []byte(`immudb`), "defaultdb")
+	require.NoError(t, err)
+	defer client.CloseSession(context.Background())
+
+	logger := logger.NewSimpleLogger("replica", os.Stdout)
+
+	replicaDB, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer replicaDB.Close()
+
+	var mutex sync.Mutex
+	appliedTxs := make(map[uint64][]byte)
+
+	rOpts := replication.DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(port).
+		WithPrimaryUsername("immudb").
+		WithPrimaryPassword("immudb").
+		WithDialTimeout(10 * time.Second).
+		WithApplyHook(func(txID uint64, etx []byte) {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			appliedTxs[txID] = etx
+		})
+
+	txReplicator, err := replication.NewTxReplicator(xid.New(), replicaDB, rOpts, logger)
+	require.NoError(t, err)
+
+	err = txReplicator.Start()
+	require.NoError(t, err)
+	defer txReplicator.Stop()
+
+	var lastTxID uint64
+
+	for i := 0; i < 10; i++ {
+		hdr, err := client.Set(context.Background(), []byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+
+		lastTxID = hdr.Id
+	}
+
+	require.Eventually(t, func() bool {
+		state, err := replicaDB.CurrentState()
+		return err == nil && state.TxId == lastTxID
+	}, 30*time.Second, 10*time.Millisecond)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	require.Len(t, appliedTxs, int(lastTxID))
+
+	for txID := uint64(1); txID <= lastTxID; txID++ {
+		require.NotEmpty(t, appliedTxs[txID])
+	}
+}
+
+func TestReplicatorLag(t *testing.T) {
+	serverOpts := server.DefaultOptions().
+		WithMetricsServer(false).
+		WithWebServer(false).
+		WithPgsqlServer(false).
+		WithPort(0).
+		WithDir(t.TempDir())
+
+	srv := server.DefaultServer().WithOptions(serverOpts).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer srv.Stop()
+
+	port := srv.Listener.Addr().(*net.TCPAddr).Port
+
+	client := ic.NewClient().WithOptions(ic.DefaultOptions().WithDir(t.TempDir()).WithPort(port))
+
+	err = client.OpenSession(context.Background(), []byte(`immudb`), []byte(`immudb`), "defaultdb")
+	require.NoError(t, err)
+	defer client.CloseSession(context.Background())
+
+	var lastTxID uint64
+
+	for i := 0; i < 10; i++ {
+		hdr, err := client.Set(context.Background(), []byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+
+		lastTxID = hdr.Id
+	}
+
+	logger := logger.NewSimpleLogger("replica", os.Stdout)
+
+	replicaDB, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer replicaDB.Close()
+
+	blockedTxID := lastTxID / 2
+	unblock := make(chan struct{})
+
+	rOpts := replication.DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(port).
+		WithPrimaryUsername("immudb").
+		WithPrimaryPassword("immudb").
+		WithReplicationCommitConcurrency(1).
+		WithApplyHook(func(txID uint64, etx []byte) {
+			// the replica falls behind while the only replicator is blocked
+			if txID == blockedTxID {
+				<-unblock
+			}
+		})
+
+	txReplicator, err := replication.NewTxReplicator(xid.New(), replicaDB, rOpts, logger)
+	require.NoError(t, err)
+
+	err = txReplicator.Start()
+	require.NoError(t, err)
+	defer txReplicator.Stop()
+
+	require.Eventually(t, func() bool {
+		primaryTxID, replicaTxID, _ := txReplicator.Lag()
+		return primaryTxID == lastTxID && replicaTxID == blockedTxID
+	}, 30*time.Second, 10*time.Millisecond)
+
+	close(unblock)
+
+	require.Eventually(t, func() bool {
+		primaryTxID, replicaTxID, lastSyncedAt := txReplicator.Lag()
+		return primaryTxID == lastTxID && replicaTxID == lastTxID && !lastSyncedAt.IsZero()
+	}, 30*time.Second, 10*time.Millisecond)
+}
+
+func TestReplicatorSetCommitConcurrency(t *testing.T) {
+	serverOpts := server.DefaultOptions().
+		WithMetricsServer(false).
+		WithWebServer(false).
+		WithPgsqlServer(false).
+		WithPort(0).
+		WithDir(t.TempDir())
+
+	srv := server.DefaultServer().WithOptions(serverOpts).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer srv.Stop()
+
+	port := srv.Listener.Addr().(*net.TCPAddr).Port
+
+	client := ic.NewClient().WithOptions(ic.DefaultOptions().WithDir(t.TempDir()).WithPort(port))
+
+	err = client.OpenSession(context.Background(), []byte(`immudb`), []byte(`immudb`), "defaultdb")
+	require.NoError(t, err)
+	defer client.CloseSession(context.Background())
+
+	logger := logger.NewSimpleLogger("replica", os.Stdout)
+
+	replicaDB, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer replicaDB.Close()
+
+	rOpts := replication.DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(port).
+		WithPrimaryUsername("immudb").
+		WithPrimaryPassword("immudb").
+		WithDialTimeout(10 * time.Second).
+		WithReplicationCommitConcurrency(1)
+
+	txReplicator, err := replication.NewTxReplicator(xid.New(), replicaDB, rOpts, logger)
+	require.NoError(t, err)
+
+	err = txReplicator.Start()
+	require.NoError(t, err)
+	defer txReplicator.Stop()
+
+	var lastTxID uint64
+
+	// no transaction is lost while replicators are started and stopped
+	for i := 0; i < 50; i++ {
+		hdr, err := client.Set(context.Background(), []byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+
+		lastTxID = hdr.Id
+
+		err = txReplicator.SetCommitConcurrency(1 + i%5)
+		require.NoError(t, err)
+	}
+
+	require.Eventually(t, func() bool {
+		state, err := replicaDB.CurrentState()
+		return err == nil && state.TxId == lastTxID
+	}, 30*time.Second, 10*time.Millisecond)
+
+	entry, err := replicaDB.Get(context.Background(), &schema.KeyRequest{Key: []byte("key49")})
+	require.NoError(t, err)
+	require.Equal(t, []byte("value49"), entry.Value)
+}
+
+func TestReplicatorMaxExportStreamsPerReplica(t *testing.T) {
+	serverOpts := server.DefaultOptions().
+		WithMetricsServer(false).
+		WithWebServer(false).
+		WithPgsqlServer(false).
+		WithPort(0).
+		WithDir(t.TempDir()).
+		WithMaxExportStreamsPerReplica(1)
+
+	srv := server.DefaultServer().WithOptions(serverOpts).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer srv.Stop()
+
+	port := srv.Listener.Addr().(*net.TCPAddr).Port
+
+	client := ic.NewClient().WithOptions(ic.DefaultOptions().WithDir(t.TempDir()).WithPort(port))
+
+	err = client.OpenSession(context.Background(), []byte(`immudb`), []byte(`immudb`), "defaultdb")
+	require.NoError(t, err)
+	defer client.CloseSession(context.Background())
+
+	hdr, err := client.Set(context.Background(), []byte("key1"), []byte("value1"))
+	require.NoError(t, err)
+
+	logger := logger.NewSimpleLogger("replica", os.Stdout)
+
+	rOpts := replication.DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(port).
+		WithPrimaryUsername("immudb").
+		WithPrimaryPassword("immudb").
+		WithDialTimeout(10 * time.Second)
+
+	t.Run("streams in excess should be rejected", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "replica-uuid", xid.New().String())
+
+		stream1, err := client.StreamExportTx(ctx)
+		require.NoError(t, err)
+		defer stream1.CloseSend()
+
+		err = stream1.Send(&schema.ExportTxRequest{Tx: hdr.Id})
+		require.NoError(t, err)
+
+		_, err = stream1.Recv()
+		require.NoError(t, err)
+
+		stream2, err := client.StreamExportTx(ctx)
+		require.NoError(t, err)
+		defer stream2.CloseSend()
+
+		err = stream2.Send(&schema.ExportTxRequest{Tx: hdr.Id})
+		require.NoError(t, err)
+
+		_, err = stream2.Recv()
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("streams within the limit should be accepted", func(t *testing.T) {
+		replicaDB, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+		require.NoError(t, err)
+		defer replicaDB.Close()
+
+		txReplicator, err := replication.NewTxReplicator(xid.New(), replicaDB, rOpts, logger)
+		require.NoError(t, err)
+
+		err = txReplicator.Start()
+		require.NoError(t, err)
+		defer txReplicator.Stop()
+
+		require.Eventually(t, func() bool {
+			state, err := replicaDB.CurrentState()
+			return err == nil && state.TxId == hdr.Id
+		}, 30*time.Second, 10*time.Millisecond)
+	})
+}
+
+func TestReplicatorVerifyAppliedHashes(t *testing.T) {
+	serverOpts := server.DefaultOptions().
+		WithMetricsServer(false).
+		WithWebServer(false).
+		WithPgsqlServer(false).
+		WithPort(0).
+		WithDir(t.TempDir())
+
+	srv := server.DefaultServer().WithOptions(serverOpts).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer srv.Stop()
+
+	port := srv.Listener.Addr().(*net.TCPAddr).Port
+
+	client := ic.NewClient().WithOptions(ic.DefaultOptions().WithDir(t.TempDir()).WithPort(port))
+
+	err = client.OpenSession(context.Background(), []byte(`immudb`), []byte(`immudb`), "defaultdb")
+	require.NoError(t, err)
+	defer client.CloseSession(context.Background())
+
+	logger := logger.NewSimpleLogger("replica", os.Stdout)
+
+	replicaDB, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer replicaDB.Close()
+
+	rOpts := replication.DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(port).
+		WithPrimaryUsername("immudb").
+		WithPrimaryPassword("immudb").
+		WithDialTimeout(10 * time.Second).
+		WithVerifyAppliedHashes(true)
+
+	txReplicator, err := replication.NewTxReplicator(xid.New(), replicaDB, rOpts, logger)
+	require.NoError(t, err)
+
+	err = txReplicator.Start()
+	require.NoError(t, err)
+	defer txReplicator.Stop()
+
+	_, err = client.Set(context.Background(), []byte("key1"), []byte("value1"))
+	require.NoError(t, err)
+
+	_, err = client.ExpirableSet(context.Background(), []byte("key2"), []byte("value2"), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	hdr, err := client.Delete(context.Background(), &schema.DeleteKeysRequest{Keys: [][]byte{[]byte("key1")}})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		state, err := replicaDB.CurrentState()
+		return err == nil && state.TxId == hdr.Id
+	}, 30*time.Second, 10*time.Millisecond)
+
+	status := txReplicator.Status()
+	require.True(t, status.Running)
+	require.False(t, status.Diverged)
+}
+
+func TestReplicatorCheckpointStore(t *testing.T) {
+	serverOpts := server.DefaultOptions().
+		WithMetricsServer(false).
+		WithWebServer(false).
+		WithPgsqlServer(false).
+		WithPort(0).
+		WithDir(t.TempDir())
+
+	srv := server.DefaultServer().WithOptions(serverOpts).(*server.ImmuServer)
+
+	err := srv.Initialize()
+	require.NoError(t, err)
+
+	go func() {
+		srv.Start()
+	}()
+
+	defer srv.Stop()
+
+	port := srv.Listener.Addr().(*net.TCPAddr).Port
+
+	client := ic.NewClient().WithOptions(ic.DefaultOptions().WithDir(t.TempDir()).WithPort(port))
+
+	err = client.OpenSession(context.Background(), []byte(`immudb`), []byte(`immudb`), "defaultdb")
+	require.NoError(t, err)
+	defer client.CloseSession(context.Background())
+
+	logger := logger.NewSimpleLogger("replica", os.Stdout)
+
+	replicaDB, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer replicaDB.Close()
+
+	checkpointStore, err := replication.NewFileCheckpointStore(t.TempDir())
+	require.NoError(t, err)
+
+	primaryDB := fmt.Sprintf("defaultdb@127.0.0.1:%d", port)
+
+	rOpts := replication.DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(port).
+		WithPrimaryUsername("immudb").
+		WithPrimaryPassword("immudb").
+		WithDialTimeout(10 * time.Second).
+		WithCheckpointStore(checkpointStore)
+
+	txReplicator, err := replication.NewTxReplicator(xid.New(), replicaDB, rOpts, logger)
+	require.NoError(t, err)
+
+	err = txReplicator.Start()
+	require.NoError(t, err)
+
+	var lastTxID uint64
+
+	for i := 0; i < 10; i++ {
+		hdr, err := client.Set(context.Background(), []byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+
+		lastTxID = hdr.Id
+	}
+
+	require.Eventually(t, func() bool {
+		txID, ok, err := checkpointStore.LoadCheckpoint(primaryDB, "replicated_defaultdb")
+		return err == nil && ok && txID == lastTxID
+	}, 30*time.Second, 10*time.Millisecond)
+
+	err = txReplicator.Stop()
+	require.NoError(t, err)
+
+	// a checkpoint ahead of the replica is ignored, replication resumes from its precommit state
+	err = checkpointStore.SaveCheckpoint(primaryDB, "replicated_defaultdb", lastTxID+100)
+	require.NoError(t, err)
+
+	txReplicator, err = replication.NewTxReplicator(xid.New(), replicaDB, rOpts, logger)
+	require.NoError(t, err)
+
+	err = txReplicator.Start()
+	require.NoError(t, err)
+	defer txReplicator.Stop()
+
+	hdr, err := client.Set(context.Background(), []byte("key10"), []byte("value10"))
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		state, err := replicaDB.CurrentState()
+		return err == nil && state.TxId == hdr.Id
+	}, 30*time.Second, 10*time.Millisecond)
+
+	require.Eventually(t, func() bool {
+		txID, _, err := checkpointStore.LoadCheckpoint(primaryDB, "replicated_defaultdb")
+		return err == nil && txID == hdr.Id
+	}, 30*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replication
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+var ErrInvalidCheckpoint = errors.New("invalid replication checkpoint")
+
+// CheckpointStore persists the id of the last transaction replicated from a primary database
+// into a replica database, so replication can be resumed from it after a restart
+type CheckpointStore interface {
+	// LoadCheckpoint returns the checkpoint stored for the pair of databases, ok is false if there is none
+	LoadCheckpoint(primaryDB, replicaDB string) (txID uint64, ok bool, err error)
+	SaveCheckpoint(primaryDB, replicaDB string, txID uint64) error
+}
+
+// FileCheckpointStore keeps each checkpoint in its own file within a directory
+type FileCheckpointStore struct {
+	dir   string
+	mutex sync.Mutex
+}
+
+func NewFileCheckpointStore(dir string) (*FileCheckpointStore, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileCheckpointStore{dir: dir}, nil
+}
+
+func (s *FileCheckpointStore) LoadCheckpoint(primaryDB, replicaDB string) (uint64, bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	b, err := ioutil.ReadFile(s.checkpointPath(primaryDB, replicaDB))
+	if os.IsNotExist(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	if len(b) != 8 {
+		return 0, false, fmt.Errorf("%w: unexpected length %d", ErrInvalidCheckpoint, len(b))
+	}
+
+	return binary.BigEndian.Uint64(b), true, nil
+}
+
+// SaveCheckpoint writes the checkpoint into a temporary file which then replaces the previous one,
+// so a crash while saving leaves either the previous or the new checkpoint in place
+func (s *FileCheckpointStore) SaveCheckpoint(primaryDB, replicaDB string, txID uint64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], txID)
+
+	path := s.checkpointPath(primaryDB, replicaDB)
+	tmpPath := path + ".tmp"
+
+	err := ioutil.WriteFile(tmpPath, b[:], 0600)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// checkpointPath returns the file of the checkpoint, names are hex-encoded as
+// database addresses may contain characters not allowed in file names
+func (s *FileCheckpointStore) checkpointPath(primaryDB, replicaDB string) string {
+	name := hex.EncodeToString([]byte(primaryDB)) + "_" + hex.EncodeToString([]byte(replicaDB))
+	return filepath.Join(s.dir, name+".checkpoint")
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replication
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/logger"
+	"github.com/codenotary/immudb/pkg/database"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileCheckpointStore(t *testing.T) {
+	store, err := NewFileCheckpointStore(t.TempDir())
+	require.NoError(t, err)
+
+	_, ok, err := store.LoadCheckpoint("defaultdb@127.0.0.1:3322", "replicated_defaultdb")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	err = store.SaveCheckpoint("defaultdb@127.0.0.1:3322", "replicated_defaultdb", 10)
+	require.NoError(t, err)
+
+	err = store.SaveCheckpoint("defaultdb@127.0.0.2:3322", "replicated_defaultdb", 20)
+	require.NoError(t, err)
+
+	txID, ok, err := store.LoadCheckpoint("defaultdb@127.0.0.1:3322", "replicated_defaultdb")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(10), txID)
+
+	txID, ok, err = store.LoadCheckpoint("defaultdb@127.0.0.2:3322", "replicated_defaultdb")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(20), txID)
+
+	err = ioutil.WriteFile(store.checkpointPath("defaultdb@127.0.0.1:3322", "replicated_defaultdb"), []byte{1, 2, 3}, 0600)
+	require.NoError(t, err)
+
+	_, _, err = store.LoadCheckpoint("defaultdb@127.0.0.1:3322", "replicated_defaultdb")
+	require.ErrorIs(t, err, ErrInvalidCheckpoint)
+}
+
+func TestReplicationCheckpoint(t *testing.T) {
+	logger := logger.NewSimpleLogger("logger", os.Stdout)
+
+	store, err := NewFileCheckpointStore(t.TempDir())
+	require.NoError(t, err)
+
+	rOpts := DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(3322).
+		WithPrimaryUsername("immudb").
+		WithPrimaryPassword("immudb").
+		WithCheckpointStore(store)
+
+	db, err := database.NewDB("replicated_checkpoint_db", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer db.Close()
+
+	txr, err := NewTxReplicator(xid.New(), db, rOpts, logger)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(0), txr.resumeFromCheckpoint(0))
+
+	txr.saveCheckpoint(5)
+	txr.saveCheckpoint(3)
+
+	txID, ok, err := store.LoadCheckpoint("defaultdb@127.0.0.1:3322", "replicated_checkpoint_db")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(5), txID)
+
+	// the checkpoint is only honoured if the replica already holds the transaction
+	require.Equal(t, uint64(2), txr.resumeFromCheckpoint(2))
+	require.Equal(t, uint64(5), txr.resumeFromCheckpoint(7))
+
+	// a reset lets the checkpoint move backwards
+	err = txr.Reset()
+	require.NoError(t, err)
+
+	txr.saveCheckpoint(3)
+
+	txID, _, err = store.LoadCheckpoint("defaultdb@127.0.0.1:3322", "replicated_checkpoint_db")
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), txID)
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replication
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/database"
+)
+
+// ConsistencyReport is the outcome of comparing the commit state of a replica with the one of its primary
+type ConsistencyReport struct {
+	PrimaryTxID uint64 // last transaction committed on the primary
+	ReplicaTxID uint64 // last transaction committed on the replica
+
+	// TxID is the last transaction committed on both databases, the one the ALHs are compared at
+	TxID       uint64
+	PrimaryAlh [sha256.Size]byte
+	ReplicaAlh [sha256.Size]byte
+
+	TxIDMatches bool // both databases committed up to the same transaction
+	AlhMatches  bool // the ALHs at TxID match, thus so do all the previous transactions
+
+	// DivergedAtTxID is the first transaction whose ALH differs between both databases, zero when ALHs match
+	DivergedAtTxID uint64
+}
+
+// Consistent returns true when the replica is up to date and its history matches the one of the primary
+func (r *ConsistencyReport) Consistent() bool {
+	return r.TxIDMatches && r.AlhMatches
+}
+
+// VerifyConsistency compares the commit state of the replica with the one of the primary it's configured to
+// replicate from, without applying any transaction. As the ALH of a transaction depends on all the previous ones,
+// the ALHs are compared at the last transaction committed on both databases. When they differ, the first
+// diverging transaction is located with a binary search over the transactions committed on both of them.
+// Only connection related options are used to reach the primary, endpoints are attempted in order
+func VerifyConsistency(ctx context.Context, primaryOpts *Options, replica database.DB) (*ConsistencyReport, error) {
+	if replica == nil {
+		return nil, fmt.Errorf("%w: no replica database provided", ErrIllegalArguments)
+	}
+
+	err := primaryOpts.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	c, _, err := openAnyPrimarySession(ctx, primaryOpts, primaryOpts.primaryUsername, primaryOpts.primaryPassword, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer c.CloseSession(context.Background())
+
+	primaryState, err := c.CurrentState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	replicaState, err := replica.CurrentState()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ConsistencyReport{
+		PrimaryTxID: primaryState.TxId,
+		ReplicaTxID: replicaState.TxId,
+		TxIDMatches: primaryState.TxId == replicaState.TxId,
+		TxID:        primaryState.TxId,
+	}
+
+	if replicaState.TxId < report.TxID {
+		report.TxID = replicaState.TxId
+	}
+
+	if report.TxID == 0 {
+		// there is nothing to be compared yet
+		report.AlhMatches = true
+		return report, nil
+	}
+
+	alhs := func(txID uint64) (primaryAlh, replicaAlh [sha256.Size]byte, err error) {
+		req := &schema.TxRequest{
+			Tx: txID,
+			// only the header is needed
+			EntriesSpec: &schema.EntriesSpec{},
+		}
+
+		primaryTx, err := c.TxByIDWithSpec(ctx, req)
+		if err != nil {
+			return primaryAlh, replicaAlh, err
+		}
+
+		replicaTx, err := replica.TxByID(ctx, req)
+		if err != nil {
+			return primaryAlh, replicaAlh, err
+		}
+
+		return schema.TxHeaderFromProto(primaryTx.Header).Alh(), schema.TxHeaderFromProto(replicaTx.Header).Alh(), nil
+	}
+
+	report.PrimaryAlh, report.ReplicaAlh, err = alhs(report.TxID)
+	if err != nil {
+		return nil, err
+	}
+
+	report.AlhMatches = report.PrimaryAlh == report.ReplicaAlh
+
+	if report.AlhMatches {
+		return report, nil
+	}
+
+	// once two transactions differ, so do the ALHs of all the following ones
+	lo, hi := uint64(1), report.TxID
+
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+
+		primaryAlh, replicaAlh, err := alhs(mid)
+		if err != nil {
+			return nil, err
+		}
+
+		if primaryAlh == replicaAlh {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+
+	report.DivergedAtTxID = lo
+
+	return report, nil
+}
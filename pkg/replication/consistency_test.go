@@ -0,0 +1,168 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/logger"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/database"
+	"github.com/stretchr/testify/require"
+)
+
+// dbClient serves the requests used to verify consistency from a local database
+type dbClient struct {
+	serverInfoClient
+
+	db database.DB
+}
+
+func (c *dbClient) CurrentState(ctx context.Context) (*schema.ImmutableState, error) {
+	return c.db.CurrentState()
+}
+
+func (c *dbClient) TxByIDWithSpec(ctx context.Context, req *schema.TxRequest) (*schema.Tx, error) {
+	return c.db.TxByID(ctx, req)
+}
+
+func TestVerifyConsistency(t *testing.T) {
+	logger := logger.NewSimpleLogger("logger", os.Stdout)
+
+	primary, err := database.NewDB("primarydb", nil, database.DefaultOption().WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer primary.Close()
+
+	for i := 0; i < 3; i++ {
+		_, err = primary.Set(context.Background(), &schema.SetRequest{
+			KVs: []*schema.KeyValue{{Key: []byte(fmt.Sprintf("key%d", i)), Value: []byte("value")}},
+		})
+		require.NoError(t, err)
+	}
+
+	primaryState, err := primary.CurrentState()
+	require.NoError(t, err)
+
+	primaryClient := &dbClient{db: primary}
+
+	rOpts := DefaultOptions().
+		WithPrimaryDatabase("primarydb").
+		WithClientFactory(func(opts *client.Options) (client.ImmuClient, error) {
+			return primaryClient, nil
+		})
+
+	replicate := func(replica database.DB, fromTxID, toTxID uint64) {
+		for txID := fromTxID; txID <= toTxID; txID++ {
+			etx, _, _, err := primary.ExportTxByID(context.Background(), &schema.ExportTxRequest{Tx: txID})
+			require.NoError(t, err)
+
+			_, err = replica.ReplicateTx(context.Background(), etx, false, false)
+			require.NoError(t, err)
+		}
+	}
+
+	t.Run("invalid arguments should fail", func(t *testing.T) {
+		_, err := VerifyConsistency(context.Background(), rOpts, nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = VerifyConsistency(context.Background(), nil, primary)
+		require.ErrorIs(t, err, ErrInvalidOptions)
+	})
+
+	t.Run("primary connection failures should be returned", func(t *testing.T) {
+		errFactory := errors.New("simulated factory failure")
+
+		opts := DefaultOptions().WithClientFactory(func(opts *client.Options) (client.ImmuClient, error) {
+			return nil, errFactory
+		})
+
+		_, err := VerifyConsistency(context.Background(), opts, primary)
+		require.ErrorIs(t, err, errFactory)
+	})
+
+	t.Run("up to date replica should be consistent", func(t *testing.T) {
+		replica, err := database.NewDB("replicadb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+		require.NoError(t, err)
+		defer replica.Close()
+
+		report, err := VerifyConsistency(context.Background(), rOpts, replica)
+		require.NoError(t, err)
+		require.Zero(t, report.TxID)
+		require.False(t, report.TxIDMatches)
+		require.True(t, report.AlhMatches)
+
+		replicate(replica, 1, primaryState.TxId)
+
+		report, err = VerifyConsistency(context.Background(), rOpts, replica)
+		require.NoError(t, err)
+		require.True(t, report.Consistent())
+		require.Equal(t, primaryState.TxId, report.TxID)
+		require.Equal(t, primaryState.TxHash, report.PrimaryAlh[:])
+		require.Equal(t, report.PrimaryAlh, report.ReplicaAlh)
+		require.Zero(t, report.DivergedAtTxID)
+		require.True(t, primaryClient.sessionClosed)
+	})
+
+	t.Run("lagging replica should be compared up to its last transaction", func(t *testing.T) {
+		replica, err := database.NewDB("replicadb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+		require.NoError(t, err)
+		defer replica.Close()
+
+		replicate(replica, 1, primaryState.TxId-1)
+
+		report, err := VerifyConsistency(context.Background(), rOpts, replica)
+		require.NoError(t, err)
+		require.False(t, report.Consistent())
+		require.False(t, report.TxIDMatches)
+		require.True(t, report.AlhMatches)
+		require.Equal(t, primaryState.TxId, report.PrimaryTxID)
+		require.Equal(t, primaryState.TxId-1, report.ReplicaTxID)
+		require.Equal(t, primaryState.TxId-1, report.TxID)
+		require.Zero(t, report.DivergedAtTxID)
+	})
+
+	t.Run("diverged replica should report the first diverging transaction", func(t *testing.T) {
+		replica, err := database.NewDB("replicadb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+		require.NoError(t, err)
+		defer replica.Close()
+
+		replicate(replica, 1, primaryState.TxId-2)
+
+		// the replica is promoted so it commits transactions of its own
+		replica.AsReplica(false, false, 0)
+
+		for i := 0; i < 2; i++ {
+			_, err = replica.Set(context.Background(), &schema.SetRequest{
+				KVs: []*schema.KeyValue{{Key: []byte(fmt.Sprintf("otherKey%d", i)), Value: []byte("value")}},
+			})
+			require.NoError(t, err)
+		}
+
+		report, err := VerifyConsistency(context.Background(), rOpts, replica)
+		require.NoError(t, err)
+		require.False(t, report.Consistent())
+		require.True(t, report.TxIDMatches)
+		require.False(t, report.AlhMatches)
+		require.NotEqual(t, report.PrimaryAlh, report.ReplicaAlh)
+		require.Equal(t, primaryState.TxId-1, report.DivergedAtTxID)
+	})
+}
@@ -19,6 +19,7 @@ package replication
 import (
 	"math"
 	"math/rand"
+	"sync"
 	"time"
 )
 
@@ -26,6 +27,26 @@ type Delayer interface {
 	DelayAfter(retries int) time.Duration
 }
 
+// sequenceDelayer is implemented by delayers whose delays depend on the ones they previously returned.
+// As such state belongs to a single sequence of re-attempts, callers retrying independently of each
+// other must use a delayer of their own
+type sequenceDelayer interface {
+	Delayer
+
+	// newSequence returns a delayer with the same settings and a state of its own
+	newSequence() Delayer
+}
+
+// newDelayerSequence returns a delayer to be used by a single sequence of re-attempts,
+// delayers which do not keep any state can be shared thus they are returned as they are
+func newDelayerSequence(delayer Delayer) Delayer {
+	if d, ok := delayer.(sequenceDelayer); ok {
+		return d.newSequence()
+	}
+
+	return delayer
+}
+
 type expBackoff struct {
 	retryMinDelay time.Duration
 	retryMaxDelay time.Duration
@@ -41,3 +62,97 @@ func (exp *expBackoff) DelayAfter(retries int) time.Duration {
 		) * (1.0 - rand.Float64()*exp.retryJitter),
 	)
 }
+
+// NewConstantDelayer creates a delayer pausing re-attempts for the same duration,
+// regardless of how many attempts have already failed
+func NewConstantDelayer(delay time.Duration) Delayer {
+	return &constantDelayer{delay: delay}
+}
+
+type constantDelayer struct {
+	delay time.Duration
+}
+
+func (d *constantDelayer) DelayAfter(retries int) time.Duration {
+	return d.delay
+}
+
+// NewDecorrelatedJitterDelayer creates a delayer following the decorrelated jitter algorithm,
+// each delay is randomly chosen between base and three times the previous one, up to maxDelay.
+// Delays of replicas failing to reach their primary at the same time quickly diverge, spreading
+// their re-attempts. Each replicator keeps track of the previous delay of every sequence of
+// re-attempts separately, thus a delayer can be shared by multiple replicators
+func NewDecorrelatedJitterDelayer(base, maxDelay time.Duration) Delayer {
+	return &decorrelatedJitterDelayer{
+		base:      base,
+		maxDelay:  maxDelay,
+		lastDelay: base,
+	}
+}
+
+type decorrelatedJitterDelayer struct {
+	base     time.Duration
+	maxDelay time.Duration
+
+	lastDelay time.Duration
+	mutex     sync.Mutex
+}
+
+func (d *decorrelatedJitterDelayer) DelayAfter(retries int) time.Duration {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if retries <= 1 {
+		// a new sequence of failures starts over from the base delay
+		d.lastDelay = d.base
+	}
+
+	delay := d.base
+
+	upperBound := 3 * d.lastDelay
+	if upperBound > d.base {
+		delay += time.Duration(rand.Int63n(int64(upperBound - d.base)))
+	}
+
+	if delay > d.maxDelay {
+		delay = d.maxDelay
+	}
+
+	d.lastDelay = delay
+
+	return delay
+}
+
+func (d *decorrelatedJitterDelayer) newSequence() Delayer {
+	return NewDecorrelatedJitterDelayer(d.base, d.maxDelay)
+}
+
+// boundedDelayer enforces an upper bound to the delays returned by the underlying delayer
+// and then shortens them by a random jitter, so delays capped by the upper bound are spread as well
+type boundedDelayer struct {
+	delayer  Delayer
+	maxDelay time.Duration
+	jitter   float64
+}
+
+func (d *boundedDelayer) DelayAfter(retries int) time.Duration {
+	delay := d.delayer.DelayAfter(retries)
+
+	if d.maxDelay > 0 && delay > d.maxDelay {
+		delay = d.maxDelay
+	}
+
+	if d.jitter > 0 {
+		delay = time.Duration(float64(delay) * (1.0 - rand.Float64()*d.jitter))
+	}
+
+	return delay
+}
+
+func (d *boundedDelayer) newSequence() Delayer {
+	return &boundedDelayer{
+		delayer:  newDelayerSequence(d.delayer),
+		maxDelay: d.maxDelay,
+		jitter:   d.jitter,
+	}
+}
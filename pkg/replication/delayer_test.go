@@ -0,0 +1,122 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replication
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoundedDelayer(t *testing.T) {
+	delayer := &boundedDelayer{
+		delayer: &expBackoff{
+			retryMinDelay: time.Second,
+			retryMaxDelay: time.Hour,
+			retryDelayExp: 2,
+		},
+		maxDelay: 10 * time.Second,
+		jitter:   0.25,
+	}
+
+	minCappedDelay := 10 * time.Second
+
+	for retries := 0; retries < 100; retries++ {
+		delay := delayer.DelayAfter(retries)
+		require.LessOrEqual(t, delay, 10*time.Second)
+
+		if retries >= 10 {
+			// the exponential backoff exceeds the max delay
+			require.GreaterOrEqual(t, delay, 7500*time.Millisecond)
+
+			if delay < minCappedDelay {
+				minCappedDelay = delay
+			}
+		}
+	}
+
+	// delays at the cap are spread by the jitter
+	require.Less(t, minCappedDelay, 9500*time.Millisecond)
+
+	delay := delayer.DelayAfter(1)
+	require.GreaterOrEqual(t, delay, 1500*time.Millisecond)
+	require.LessOrEqual(t, delay, 2*time.Second)
+}
+
+func TestConstantDelayer(t *testing.T) {
+	delayer := NewConstantDelayer(5 * time.Second)
+
+	for retries := 0; retries < 100; retries++ {
+		require.Equal(t, 5*time.Second, delayer.DelayAfter(retries))
+	}
+
+	opts := DefaultOptions().WithDelayer(delayer)
+	require.NoError(t, opts.Validate())
+	require.Equal(t, delayer, opts.delayer)
+}
+
+func TestDecorrelatedJitterDelayer(t *testing.T) {
+	delayer := NewDecorrelatedJitterDelayer(100*time.Millisecond, time.Second)
+
+	reachedMaxDelay := false
+
+	for retries := 1; retries <= 100; retries++ {
+		delay := delayer.DelayAfter(retries)
+		require.GreaterOrEqual(t, delay, 100*time.Millisecond)
+		require.LessOrEqual(t, delay, time.Second)
+
+		if retries == 1 {
+			require.Less(t, delay, 300*time.Millisecond)
+		}
+
+		reachedMaxDelay = reachedMaxDelay || delay == time.Second
+	}
+
+	require.True(t, reachedMaxDelay)
+
+	// delays start over from the base delay after a successful attempt
+	delay := delayer.DelayAfter(1)
+	require.GreaterOrEqual(t, delay, 100*time.Millisecond)
+	require.Less(t, delay, 300*time.Millisecond)
+
+	opts := DefaultOptions().WithDelayer(delayer)
+	require.NoError(t, opts.Validate())
+}
+
+func TestDelayerSequences(t *testing.T) {
+	constant := NewConstantDelayer(time.Second)
+	require.Equal(t, constant, newDelayerSequence(constant))
+
+	delayer := &boundedDelayer{
+		delayer:  NewDecorrelatedJitterDelayer(100*time.Millisecond, 10*time.Second),
+		maxDelay: 5 * time.Second,
+	}
+
+	seq1 := newDelayerSequence(delayer)
+	seq2 := newDelayerSequence(delayer)
+
+	// the first sequence keeps failing while the second one starts over after each re-attempt
+	for retries := 1; retries <= 100; retries++ {
+		require.Less(t, seq2.DelayAfter(1), 300*time.Millisecond)
+
+		seq1.DelayAfter(retries)
+
+		// delays are chosen up to three times the previous one of the same sequence
+		require.Less(t, seq2.DelayAfter(2), 900*time.Millisecond)
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replication
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ReplicatorHealth describes whether a replicator is keeping up with its primary
+type ReplicatorHealth struct {
+	Healthy bool   `json:"healthy"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// ReplicationHealth aggregates the health of all the replicators registered in a manager
+type ReplicationHealth struct {
+	Healthy     bool                         `json:"healthy"`
+	Replicators map[string]*ReplicatorHealth `json:"replicators"`
+}
+
+// ReplicationHealth reports replication as unhealthy if any of the registered replicators
+// diverged from its primary or has been failing to fetch transactions for longer than maxFailingDuration
+func (m *ReplicatorManager) ReplicationHealth(maxFailingDuration time.Duration) *ReplicationHealth {
+	health := &ReplicationHealth{
+		Healthy:     true,
+		Replicators: make(map[string]*ReplicatorHealth),
+	}
+
+	for db, status := range m.Status() {
+		replicatorHealth := replicatorHealthOf(status, maxFailingDuration)
+
+		if !replicatorHealth.Healthy {
+			health.Healthy = false
+		}
+
+		health.Replicators[db] = replicatorHealth
+	}
+
+	return health
+}
+
+func replicatorHealthOf(status *ReplicatorStatus, maxFailingDuration time.Duration) *ReplicatorHealth {
+	if status.Diverged {
+		return &ReplicatorHealth{Reason: "replica diverged from primary"}
+	}
+
+	if !status.FailingSince.IsZero() {
+		failingFor := time.Since(status.FailingSince)
+
+		if failingFor > maxFailingDuration {
+			return &ReplicatorHealth{
+				Reason: fmt.Sprintf("failing for %s after %d consecutive failures", failingFor.Round(time.Second), status.ConsecutiveFailures),
+			}
+		}
+	}
+
+	return &ReplicatorHealth{Healthy: true}
+}
+
+// HealthHandlerFunc returns an http handler responding with the replication health as json,
+// along with status code 200 when healthy or 503 otherwise, so it can be used by liveness and readiness probes
+func (m *ReplicatorManager) HealthHandlerFunc(maxFailingDuration time.Duration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		health := m.ReplicationHealth(maxFailingDuration)
+
+		httpStatus := http.StatusOK
+		if !health.Healthy {
+			httpStatus = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(httpStatus)
+		json.NewEncoder(w).Encode(health)
+	}
+}
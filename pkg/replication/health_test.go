@@ -0,0 +1,102 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replication
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/logger"
+	"github.com/codenotary/immudb/pkg/database"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplicationHealth(t *testing.T) {
+	logger := logger.NewSimpleLogger("logger", os.Stdout)
+
+	// no primary is listening on the configured port so fetching keeps failing
+	rOpts := DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(1).
+		WithPrimaryUsername("immudb").
+		WithPrimaryPassword("immudb").
+		WithDelayer(&expBackoff{retryMinDelay: 10 * time.Millisecond, retryMaxDelay: 10 * time.Millisecond, retryDelayExp: 1})
+
+	db, err := database.NewDB("replicated_health_db", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer db.Close()
+
+	txr, err := NewTxReplicator(xid.New(), db, rOpts, logger)
+	require.NoError(t, err)
+
+	m := NewReplicatorManager()
+
+	err = m.Register(txr)
+	require.NoError(t, err)
+
+	health := m.ReplicationHealth(time.Minute)
+	require.True(t, health.Healthy)
+	require.True(t, health.Replicators["replicated_health_db"].Healthy)
+
+	err = txr.Start()
+	require.NoError(t, err)
+	defer txr.Stop()
+
+	require.Eventually(t, func() bool {
+		return !txr.Status().FailingSince.IsZero()
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// failures within the window are tolerated
+	health = m.ReplicationHealth(time.Minute)
+	require.True(t, health.Healthy)
+
+	time.Sleep(10 * time.Millisecond)
+
+	health = m.ReplicationHealth(time.Millisecond)
+	require.False(t, health.Healthy)
+	require.False(t, health.Replicators["replicated_health_db"].Healthy)
+	require.Contains(t, health.Replicators["replicated_health_db"].Reason, "failing for")
+
+	handler := m.HealthHandlerFunc(time.Millisecond)
+
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/replication/health", nil))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	var resp ReplicationHealth
+	err = json.NewDecoder(rec.Body).Decode(&resp)
+	require.NoError(t, err)
+	require.False(t, resp.Healthy)
+	require.False(t, resp.Replicators["replicated_health_db"].Healthy)
+
+	rec = httptest.NewRecorder()
+	m.HealthHandlerFunc(time.Hour)(rec, httptest.NewRequest(http.MethodGet, "/replication/health", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// divergence makes replication unhealthy regardless of the window
+	txr.setDiverged(true)
+
+	health = m.ReplicationHealth(time.Hour)
+	require.False(t, health.Healthy)
+	require.Equal(t, "replica diverged from primary", health.Replicators["replicated_health_db"].Reason)
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replication
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/codenotary/immudb/embedded/multierr"
+)
+
+var ErrReplicatorAlreadyRegistered = errors.New("replicator already registered")
+var ErrReplicatorNotRegistered = errors.New("replicator not registered")
+
+// ReplicatorManager keeps track of the replicators of multiple databases,
+// registered by the name of the replica database, so their lifecycle can be managed together
+type ReplicatorManager struct {
+	mutex       sync.RWMutex
+	replicators map[string]*TxReplicator
+}
+
+func NewReplicatorManager() *ReplicatorManager {
+	return &ReplicatorManager{
+		replicators: make(map[string]*TxReplicator),
+	}
+}
+
+// Register adds a replicator to the manager, a single replicator can be registered per database
+func (m *ReplicatorManager) Register(txr *TxReplicator) error {
+	if txr == nil {
+		return fmt.Errorf("%w: no replicator provided", ErrIllegalArguments)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	db := txr.db.GetName()
+
+	_, ok := m.replicators[db]
+	if ok {
+		return fmt.Errorf("%w: database '%s'", ErrReplicatorAlreadyRegistered, db)
+	}
+
+	m.replicators[db] = txr
+
+	return nil
+}
+
+// Unregister removes the replicator of the database from the manager and returns it.
+// The replicator is not stopped.
+func (m *ReplicatorManager) Unregister(db string) (*TxReplicator, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	txr, ok := m.replicators[db]
+	if !ok {
+		return nil, fmt.Errorf("%w: database '%s'", ErrReplicatorNotRegistered, db)
+	}
+
+	delete(m.replicators, db)
+
+	return txr, nil
+}
+
+// Get returns the replicator registered for the database
+func (m *ReplicatorManager) Get(db string) (*TxReplicator, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	txr, ok := m.replicators[db]
+	return txr, ok
+}
+
+// Databases returns the sorted names of the databases with a registered replicator
+func (m *ReplicatorManager) Databases() []string {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	dbs := make([]string, 0, len(m.replicators))
+	for db := range m.replicators {
+		dbs = append(dbs, db)
+	}
+
+	sort.Strings(dbs)
+
+	return dbs
+}
+
+// StartAll starts every registered replicator which is not already running.
+// All the replicators are attempted even if some of them fail to start.
+func (m *ReplicatorManager) StartAll() error {
+	merr := multierr.NewMultiErr()
+
+	for db, txr := range m.registered() {
+		err := txr.Start()
+		if err != nil && !errors.Is(err, ErrAlreadyRunning) {
+			merr.Append(fmt.Errorf("database '%s': %w", db, err))
+		}
+	}
+
+	return merr.Reduce()
+}
+
+// StopAll stops every registered replicator which is running.
+// All the replicators are attempted even if some of them fail to stop.
+func (m *ReplicatorManager) StopAll() error {
+	merr := multierr.NewMultiErr()
+
+	for db, txr := range m.registered() {
+		err := txr.Stop()
+		if err != nil && !errors.Is(err, ErrAlreadyStopped) {
+			merr.Append(fmt.Errorf("database '%s': %w", db, err))
+		}
+	}
+
+	return merr.Reduce()
+}
+
+// Status returns the status of every registered replicator by database name
+func (m *ReplicatorManager) Status() map[string]*ReplicatorStatus {
+	replicators := m.registered()
+
+	status := make(map[string]*ReplicatorStatus, len(replicators))
+	for db, txr := range replicators {
+		status[db] = txr.Status()
+	}
+
+	return status
+}
+
+// registered returns a copy of the registered replicators so they can be
+// operated on without preventing concurrent registrations
+func (m *ReplicatorManager) registered() map[string]*TxReplicator {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	replicators := make(map[string]*TxReplicator, len(m.replicators))
+	for db, txr := range m.replicators {
+		replicators[db] = txr
+	}
+
+	return replicators
+}
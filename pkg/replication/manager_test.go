@@ -0,0 +1,110 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replication
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/logger"
+	"github.com/codenotary/immudb/pkg/database"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplicatorManager(t *testing.T) {
+	logger := logger.NewSimpleLogger("logger", os.Stdout)
+
+	rOpts := DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(3322).
+		WithPrimaryUsername("immudb").
+		WithPrimaryPassword("immudb").
+		WithDelayer(&expBackoff{retryMinDelay: time.Second, retryMaxDelay: time.Second, retryDelayExp: 1})
+
+	m := NewReplicatorManager()
+
+	err := m.Register(nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 3; i++ {
+		db, err := database.NewDB(fmt.Sprintf("replicated_db%d", i), nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+		require.NoError(t, err)
+		defer db.Close()
+
+		txr, err := NewTxReplicator(xid.New(), db, rOpts, logger)
+		require.NoError(t, err)
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			err := m.Register(txr)
+			require.NoError(t, err)
+		}()
+	}
+
+	wg.Wait()
+
+	require.Equal(t, []string{"replicated_db0", "replicated_db1", "replicated_db2"}, m.Databases())
+
+	txr, ok := m.Get("replicated_db1")
+	require.True(t, ok)
+
+	err = m.Register(txr)
+	require.ErrorIs(t, err, ErrReplicatorAlreadyRegistered)
+
+	err = txr.Start()
+	require.NoError(t, err)
+
+	// replicators already running are skipped
+	err = m.StartAll()
+	require.NoError(t, err)
+
+	status := m.Status()
+	require.Len(t, status, 3)
+
+	for _, s := range status {
+		require.True(t, s.Running)
+	}
+
+	err = m.StopAll()
+	require.NoError(t, err)
+
+	for _, s := range m.Status() {
+		require.False(t, s.Running)
+	}
+
+	unregistered, err := m.Unregister("replicated_db1")
+	require.NoError(t, err)
+	require.Same(t, txr, unregistered)
+
+	_, err = m.Unregister("replicated_db1")
+	require.ErrorIs(t, err, ErrReplicatorNotRegistered)
+
+	_, ok = m.Get("replicated_db1")
+	require.False(t, ok)
+
+	require.Equal(t, []string{"replicated_db0", "replicated_db2"}, m.Databases())
+}
@@ -36,6 +36,35 @@ var (
 		Help:    "histogram of time spent by replicators to replicate a single transaction",
 	}, []string{"db"})
 
+	_metricsConnectTimeHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "immudb_replication_connect_time",
+		Buckets: prometheus.ExponentialBucketsRange(0.001, 10.0, 16),
+		Help:    "histogram of time spent opening a session with the primary",
+	}, []string{"db"})
+
+	_metricsOpenStreamTimeHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "immudb_replication_open_stream_time",
+		Buckets: prometheus.ExponentialBucketsRange(0.001, 10.0, 16),
+		Help:    "histogram of time spent establishing the streams used to export transactions from the primary",
+	}, []string{"db"})
+
+	_metricsExportTxTimeHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "immudb_replication_export_tx_time",
+		Buckets: prometheus.ExponentialBucketsRange(0.001, 10.0, 16),
+		Help:    "histogram of time spent waiting for and reading a transaction exported by the primary",
+	}, []string{"db"})
+
+	_metricsExportTxSizeHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "immudb_replication_export_tx_size",
+		Buckets: prometheus.ExponentialBuckets(256, 4, 10),
+		Help:    "histogram of the size in bytes of transactions exported by the primary",
+	}, []string{"db"})
+
+	_metricsReplicatedTxs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "immudb_replication_replicated_txs",
+		Help: "number of transactions successfully replicated",
+	}, []string{"db"})
+
 	_metricsReplicators = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "immudb_replication_replicators",
 		Help: "number of replicators available",
@@ -61,6 +90,16 @@ var (
 		Help: "the latest know transaction ID committed on the primary node",
 	}, []string{"db"})
 
+	_metricsReplicationLastTxID = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "immudb_replication_last_tx_id",
+		Help: "the latest transaction ID committed on the replica",
+	}, []string{"db"})
+
+	_metricsReplicationLastSyncedAt = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "immudb_replication_last_synced_at",
+		Help: "unix timestamp (in seconds) of the latest successful synchronization with the primary node",
+	}, []string{"db"})
+
 	_metricsAllowCommitUpToTxID = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "immudb_replication_allow_commit_up_to_tx_id",
 		Help: "most recently received confirmation up to which commit id the replica is allowed to durably commit",
@@ -70,11 +109,18 @@ var (
 type metrics struct {
 	txWaitQueueHistogram     prometheus.Observer
 	replicationTimeHistogram prometheus.Observer
+	connectTimeHistogram     prometheus.Observer
+	openStreamTimeHistogram  prometheus.Observer
+	exportTxTimeHistogram    prometheus.Observer
+	exportTxSizeHistogram    prometheus.Observer
 	replicationRetries       prometheus.Counter
+	replicatedTxs            prometheus.Counter
 	replicators              prometheus.Gauge
 	replicatorsActive        prometheus.Gauge
 	replicatorsInRetryDelay  prometheus.Gauge
 	primaryCommittedTxID     prometheus.Gauge
+	lastTxID                 prometheus.Gauge
+	lastSyncedAt             prometheus.Gauge
 	allowCommitUpToTxID      prometheus.Gauge
 }
 
@@ -83,11 +129,18 @@ func metricsForDb(dbName string) metrics {
 	return metrics{
 		txWaitQueueHistogram:     _metricsTxWaitQueueHistogram.WithLabelValues(dbName),
 		replicationTimeHistogram: _metricsReplicationTimeHistogram.WithLabelValues(dbName),
+		connectTimeHistogram:     _metricsConnectTimeHistogram.WithLabelValues(dbName),
+		openStreamTimeHistogram:  _metricsOpenStreamTimeHistogram.WithLabelValues(dbName),
+		exportTxTimeHistogram:    _metricsExportTxTimeHistogram.WithLabelValues(dbName),
+		exportTxSizeHistogram:    _metricsExportTxSizeHistogram.WithLabelValues(dbName),
 		replicationRetries:       _metricsReplicationRetries.WithLabelValues(dbName),
+		replicatedTxs:            _metricsReplicatedTxs.WithLabelValues(dbName),
 		replicators:              _metricsReplicators.WithLabelValues(dbName),
 		replicatorsActive:        _metricsReplicatorsActive.WithLabelValues(dbName),
 		replicatorsInRetryDelay:  _metricsReplicatorsInRetryDelay.WithLabelValues(dbName),
 		primaryCommittedTxID:     _metricsReplicationPrimaryCommittedTxID.WithLabelValues(dbName),
+		lastTxID:                 _metricsReplicationLastTxID.WithLabelValues(dbName),
+		lastSyncedAt:             _metricsReplicationLastSyncedAt.WithLabelValues(dbName),
 		allowCommitUpToTxID:      _metricsAllowCommitUpToTxID.WithLabelValues(dbName),
 	}
 }
@@ -98,6 +151,8 @@ func (m *metrics) reset() {
 	m.replicatorsActive.Set(0)
 	m.replicatorsInRetryDelay.Set(0)
 	m.primaryCommittedTxID.Set(0)
+	m.lastTxID.Set(0)
+	m.lastSyncedAt.Set(0)
 	m.allowCommitUpToTxID.Set(0)
 }
 
@@ -105,3 +160,13 @@ func (m *metrics) reset() {
 func (m *metrics) replicationTimeHistogramTimer() *prometheus.Timer {
 	return prometheus.NewTimer(m.replicationTimeHistogram)
 }
+
+// connectTimeHistogramTimer returns prometheus timer for connectTimeHistogram
+func (m *metrics) connectTimeHistogramTimer() *prometheus.Timer {
+	return prometheus.NewTimer(m.connectTimeHistogram)
+}
+
+// openStreamTimeHistogramTimer returns prometheus timer for openStreamTimeHistogram
+func (m *metrics) openStreamTimeHistogramTimer() *prometheus.Timer {
+	return prometheus.NewTimer(m.openStreamTimeHistogram)
+}
@@ -17,34 +17,145 @@ limitations under the License.
 package replication
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/stream"
+	"github.com/rs/xid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/keepalive"
 )
 
 const DefaultChunkSize int = 64 * 1024 // 64 * 1024 64 KiB
 const DefaultPrefetchTxBufferSize int = 100
 const DefaultReplicationCommitConcurrency int = 10
+const DefaultFetchConcurrency int = 1
 const DefaultAllowTxDiscarding = false
 const DefaultSkipIntegrityCheck = false
 const DefaultWaitForIndexing = false
+const DefaultVerifyAppliedHashes = false
+const DefaultReconnectAfterFailures = 3
+const DefaultPrefetchOverflowPollInterval = time.Second
+
+// PrefetchOverflowPolicy determines how the replicator behaves while the prefetch buffer is full
+type PrefetchOverflowPolicy int
+
+const (
+	// PrefetchOverflowBlock halts any interaction with the primary until there is room in the buffer
+	PrefetchOverflowBlock PrefetchOverflowPolicy = iota
+	// PrefetchOverflowPollState keeps polling the state of the primary while the buffer is full, so lag
+	// and liveness are still tracked. Transactions are not fetched until there is room in the buffer
+	PrefetchOverflowPollState
+)
+
+// Compression algorithms supported when exporting transactions from the primary
+const (
+	StreamCompressionNone = "none"
+	StreamCompressionGzip = "gzip"
+)
+
+const DefaultStreamCompression = StreamCompressionNone
+
+// DivergenceHandler is invoked when the replica diverges from the primary,
+// providing the last known transaction IDs of both the primary and the replica
+type DivergenceHandler func(db string, primaryTxID, replicaTxID uint64)
+
+// ApplyHook is invoked with every transaction applied to the replica,
+// etx holds the transaction as exported by the primary
+type ApplyHook func(txID uint64, etx []byte)
+
+// ClientFactory creates the client used to open a session against the primary,
+// the session is opened by the replicator once the client is created
+type ClientFactory func(opts *client.Options) (client.ImmuClient, error)
+
+// DefaultClientFactory creates a client which is not connected to the primary until a session is opened
+func DefaultClientFactory(opts *client.Options) (client.ImmuClient, error) {
+	return client.NewClient().WithOptions(opts), nil
+}
+
+// RecoveryHandler is invoked when replication succeeds again after failing,
+// downtime is the time elapsed since the first of the consecutive failures
+type RecoveryHandler func(db string, downtime time.Duration)
+
+// EntryFilter selects the entries replicated along with their values, keys are provided as stored
+// by the primary i.e. including the prefix of the kind of entry such as database.SetKeyPrefix.
+//
+// Transactions are replicated as a whole to keep the replica verifiable: a transaction with at least one
+// selected entry is fully replicated, including the values of entries not selected by the filter, while
+// a transaction without any selected entry is replicated without values, only their digests are kept.
+// As headers and entry digests are identical to the ones of the primary, proofs built by a filtered replica
+// remain valid, but the values of filtered entries can not be read from the replica, thus values can not be
+// verified there. Entries used by the sql and document engines, such as catalog entries, must be selected
+// for those engines to be usable on the replica, which should not be promoted as primary while filtering.
+type EntryFilter func(key []byte) bool
+
+// Endpoint is the network address of a server the primary database can be reached through
+type Endpoint struct {
+	Host string `json:"host"`
+	Port int    `json:"port"`
+}
 
 type Options struct {
-	primaryDatabase string
-	primaryHost     string
-	primaryPort     int
-	primaryUsername string
-	primaryPassword string
+	primaryDatabase          string
+	primaryHost              string
+	primaryPort              int
+	primaryFallbackEndpoints []Endpoint
+	primaryUsername          string
+	primaryPassword          string
+
+	serverCertPool *x509.CertPool
+	clientCertPEM  []byte
+	clientKeyPEM   []byte
+	serverName     string
+
+	dialTimeout       time.Duration
+	keepAliveInterval time.Duration
+	keepAliveTimeout  time.Duration
 
-	streamChunkSize int
+	idleReconnectTimeout time.Duration
+
+	streamChunkSize   int
+	autoChunkSize     bool
+	streamCompression string
 
 	prefetchTxBufferSize         int
+	maxInflightBytes             int
+	prefetchOverflowPolicy       PrefetchOverflowPolicy
+	prefetchOverflowPollInterval time.Duration
 	replicationCommitConcurrency int
+	fetchConcurrency             int
+	maxReplicationRetries        int
+	reconnectAfterFailures       int
+
+	allowTxDiscarding   bool
+	skipIntegrityCheck  bool
+	waitForIndexing     bool
+	verifyAppliedHashes bool
+
+	delayer     Delayer
+	maxDelay    time.Duration
+	delayJitter float64
+
+	divergenceHandler DivergenceHandler
+	recoveryHandler   RecoveryHandler
+	applyHook         ApplyHook
+
+	clientFactory ClientFactory
+
+	entryFilter EntryFilter
 
-	allowTxDiscarding  bool
-	skipIntegrityCheck bool
-	waitForIndexing    bool
+	checkpointStore CheckpointStore
 
-	delayer Delayer
+	replicaUUID string
 }
 
 func DefaultOptions() *Options {
@@ -58,11 +169,18 @@ func DefaultOptions() *Options {
 	return &Options{
 		delayer:                      delayer,
 		streamChunkSize:              DefaultChunkSize,
+		streamCompression:            DefaultStreamCompression,
 		prefetchTxBufferSize:         DefaultPrefetchTxBufferSize,
+		prefetchOverflowPolicy:       PrefetchOverflowBlock,
+		prefetchOverflowPollInterval: DefaultPrefetchOverflowPollInterval,
 		replicationCommitConcurrency: DefaultReplicationCommitConcurrency,
+		fetchConcurrency:             DefaultFetchConcurrency,
+		reconnectAfterFailures:       DefaultReconnectAfterFailures,
 		allowTxDiscarding:            DefaultAllowTxDiscarding,
 		skipIntegrityCheck:           DefaultSkipIntegrityCheck,
 		waitForIndexing:              DefaultWaitForIndexing,
+		verifyAppliedHashes:          DefaultVerifyAppliedHashes,
+		clientFactory:                DefaultClientFactory,
 	}
 }
 
@@ -75,21 +193,167 @@ func (opts *Options) Validate() error {
 		return fmt.Errorf("%w: invalid StreamChunkSize", ErrInvalidOptions)
 	}
 
+	if opts.streamCompression != StreamCompressionNone && opts.streamCompression != StreamCompressionGzip {
+		return fmt.Errorf("%w: invalid StreamCompression", ErrInvalidOptions)
+	}
+
 	if opts.prefetchTxBufferSize <= 0 {
 		return fmt.Errorf("%w: invalid PrefetchTxBufferSize", ErrInvalidOptions)
 	}
 
+	if opts.maxInflightBytes < 0 {
+		return fmt.Errorf("%w: invalid MaxInflightBytes", ErrInvalidOptions)
+	}
+
+	if opts.prefetchOverflowPolicy != PrefetchOverflowBlock && opts.prefetchOverflowPolicy != PrefetchOverflowPollState {
+		return fmt.Errorf("%w: invalid PrefetchOverflowPolicy", ErrInvalidOptions)
+	}
+
+	if opts.prefetchOverflowPolicy == PrefetchOverflowPollState && opts.prefetchOverflowPollInterval <= 0 {
+		return fmt.Errorf("%w: invalid PrefetchOverflowPollInterval", ErrInvalidOptions)
+	}
+
 	if opts.replicationCommitConcurrency <= 0 {
 		return fmt.Errorf("%w: invalid ReplicationCommitConcurrency", ErrInvalidOptions)
 	}
 
+	if opts.fetchConcurrency <= 0 {
+		return fmt.Errorf("%w: invalid FetchConcurrency", ErrInvalidOptions)
+	}
+
+	if opts.maxReplicationRetries < 0 {
+		return fmt.Errorf("%w: invalid MaxReplicationRetries", ErrInvalidOptions)
+	}
+
+	if opts.reconnectAfterFailures <= 0 {
+		return fmt.Errorf("%w: invalid ReconnectAfterFailures", ErrInvalidOptions)
+	}
+
 	if opts.delayer == nil {
 		return fmt.Errorf("%w: invalid Delayer", ErrInvalidOptions)
 	}
 
+	if opts.clientFactory == nil {
+		return fmt.Errorf("%w: invalid ClientFactory", ErrInvalidOptions)
+	}
+
+	if (len(opts.clientCertPEM) > 0) != (len(opts.clientKeyPEM) > 0) {
+		return fmt.Errorf("%w: both client certificate and key must be provided", ErrInvalidOptions)
+	}
+
+	if len(opts.clientCertPEM) > 0 {
+		_, err := tls.X509KeyPair(opts.clientCertPEM, opts.clientKeyPEM)
+		if err != nil {
+			return fmt.Errorf("%w: invalid client certificate: %v", ErrInvalidOptions, err)
+		}
+	}
+
+	if opts.dialTimeout < 0 {
+		return fmt.Errorf("%w: invalid DialTimeout", ErrInvalidOptions)
+	}
+
+	if opts.keepAliveInterval < 0 {
+		return fmt.Errorf("%w: invalid KeepAliveInterval", ErrInvalidOptions)
+	}
+
+	if opts.keepAliveTimeout < 0 {
+		return fmt.Errorf("%w: invalid KeepAliveTimeout", ErrInvalidOptions)
+	}
+
+	if opts.idleReconnectTimeout < 0 {
+		return fmt.Errorf("%w: invalid IdleReconnectTimeout", ErrInvalidOptions)
+	}
+
+	if opts.maxDelay < 0 {
+		return fmt.Errorf("%w: invalid MaxDelay", ErrInvalidOptions)
+	}
+
+	if opts.replicaUUID != "" {
+		_, err := xid.FromString(opts.replicaUUID)
+		if err != nil {
+			return fmt.Errorf("%w: invalid ReplicaUUID", ErrInvalidOptions)
+		}
+	}
+
+	if opts.delayJitter < 0 || opts.delayJitter >= 1 {
+		return fmt.Errorf("%w: invalid DelayJitter", ErrInvalidOptions)
+	}
+
+	for _, endpoint := range opts.primaryFallbackEndpoints {
+		if endpoint.Host == "" || endpoint.Port <= 0 {
+			return fmt.Errorf("%w: invalid PrimaryFallbackEndpoints", ErrInvalidOptions)
+		}
+	}
+
 	return nil
 }
 
+// ValidateConnection checks the options can be used to replicate from the primary without starting replication.
+// As the replicator does, a session is opened on the primary database through the first endpoint it can be
+// opened with and, unless the database is empty, its last transaction is exported, thus connectivity,
+// compatibility, credentials and permissions issues are reported before deploying a replica
+func (opts *Options) ValidateConnection(ctx context.Context) error {
+	err := opts.Validate()
+	if err != nil {
+		return err
+	}
+
+	_, err = opts.dialOptions()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidOptions, err)
+	}
+
+	c, endpoint, err := openAnyPrimarySession(ctx, opts, opts.primaryUsername, opts.primaryPassword, nil)
+	if err != nil {
+		return fmt.Errorf("endpoint '%s:%d': %w", endpoint.Host, endpoint.Port, err)
+	}
+	defer c.CloseSession(context.Background())
+
+	err = opts.validateExportTx(ctx, c)
+	if err != nil {
+		return fmt.Errorf("endpoint '%s:%d': %w", endpoint.Host, endpoint.Port, err)
+	}
+
+	return nil
+}
+
+// validateExportTx exports the last transaction committed on the primary, if any
+func (opts *Options) validateExportTx(ctx context.Context, c client.ImmuClient) error {
+	if opts.dialTimeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, opts.dialTimeout)
+		defer cancel()
+	}
+
+	state, err := c.CurrentState(ctx)
+	if err != nil {
+		return err
+	}
+
+	if state.TxId == 0 {
+		// there are no transactions to be exported yet
+		return nil
+	}
+
+	exportTxStream, err := c.StreamExportTx(ctx, opts.exportTxCallOptions()...)
+	if err != nil {
+		return err
+	}
+	defer exportTxStream.CloseSend()
+
+	err = exportTxStream.Send(&schema.ExportTxRequest{
+		Tx:                 state.TxId,
+		SkipIntegrityCheck: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, _, err = stream.NewMsgReceiver(exportTxStream).ReadFully()
+	return err
+}
+
 // WithPrimaryDatabase sets the source database name
 func (o *Options) WithPrimaryDatabase(primaryDatabase string) *Options {
 	o.primaryDatabase = primaryDatabase
@@ -108,6 +372,14 @@ func (o *Options) WithPrimaryPort(primaryPort int) *Options {
 	return o
 }
 
+// WithPrimaryFallbackEndpoints sets additional endpoints the primary database can be reached through.
+// When a connection can not be established, endpoints are attempted in order
+// after the one set with WithPrimaryHost and WithPrimaryPort
+func (o *Options) WithPrimaryFallbackEndpoints(endpoints ...Endpoint) *Options {
+	o.primaryFallbackEndpoints = endpoints
+	return o
+}
+
 // WithPrimaryUsername sets username used for replication
 func (o *Options) WithPrimaryUsername(primaryUsername string) *Options {
 	o.primaryUsername = primaryUsername
@@ -120,24 +392,126 @@ func (o *Options) WithPrimaryPassword(primaryPassword string) *Options {
 	return o
 }
 
+// WithServerTLS enables TLS when connecting to the primary, server certificates are validated using the provided pool
+func (o *Options) WithServerTLS(serverCertPool *x509.CertPool) *Options {
+	o.serverCertPool = serverCertPool
+	return o
+}
+
+// WithClientCert sets the PEM encoded client certificate and key used for mutual TLS authentication
+func (o *Options) WithClientCert(certPEM, keyPEM []byte) *Options {
+	o.clientCertPEM = certPEM
+	o.clientKeyPEM = keyPEM
+	return o
+}
+
+// WithServerName sets the name used to verify the certificate presented by the primary
+func (o *Options) WithServerName(serverName string) *Options {
+	o.serverName = serverName
+	return o
+}
+
+// WithDialTimeout sets the maximum time to establish a connection with the primary, including
+// authentication and database selection, as well as the maximum time to establish each export stream
+func (o *Options) WithDialTimeout(dialTimeout time.Duration) *Options {
+	o.dialTimeout = dialTimeout
+	return o
+}
+
+// WithKeepAliveInterval sets the period of inactivity after which the connection to the primary is pinged
+func (o *Options) WithKeepAliveInterval(keepAliveInterval time.Duration) *Options {
+	o.keepAliveInterval = keepAliveInterval
+	return o
+}
+
+// WithKeepAliveTimeout sets the time to wait for a ping response before the connection is considered broken
+func (o *Options) WithKeepAliveTimeout(keepAliveTimeout time.Duration) *Options {
+	o.keepAliveTimeout = keepAliveTimeout
+	return o
+}
+
+// WithIdleReconnectTimeout sets the time after which the connection with the primary is re-established
+// when neither a transaction is received nor the commit state of the primary advances, so stale connections
+// not detected by keepalive pings are replaced. Zero, the default, disables it
+func (o *Options) WithIdleReconnectTimeout(idleReconnectTimeout time.Duration) *Options {
+	o.idleReconnectTimeout = idleReconnectTimeout
+	return o
+}
+
 // WithStreamChunkSize sets streaming chunk size
 func (o *Options) WithStreamChunkSize(streamChunkSize int) *Options {
 	o.streamChunkSize = streamChunkSize
 	return o
 }
 
+// WithAutoChunkSize enables adapting the chunk size requested to the primary to the size of exported transactions,
+// the stream chunk size is used until transactions are received
+func (o *Options) WithAutoChunkSize(autoChunkSize bool) *Options {
+	o.autoChunkSize = autoChunkSize
+	return o
+}
+
+// WithStreamCompression sets the compression algorithm used by the primary when exporting transactions,
+// the primary must support it. Compression is disabled by default
+func (o *Options) WithStreamCompression(streamCompression string) *Options {
+	o.streamCompression = streamCompression
+	return o
+}
+
 // WithPrefetchTxBufferSize sets tx buffer size
 func (o *Options) WithPrefetchTxBufferSize(prefetchTxBufferSize int) *Options {
 	o.prefetchTxBufferSize = prefetchTxBufferSize
 	return o
 }
 
+// WithMaxInflightBytes sets the total size of prefetched transactions at which fetching from the primary
+// is paused until replicators catch up. The limit is checked before fetching, thus it may be exceeded
+// by the transactions fetched at once, which are always accepted into an empty buffer. Zero, the default, means no limit
+func (o *Options) WithMaxInflightBytes(maxInflightBytes int) *Options {
+	o.maxInflightBytes = maxInflightBytes
+	return o
+}
+
+// WithPrefetchOverflowPolicy sets how the replicator behaves while the prefetch buffer is full
+func (o *Options) WithPrefetchOverflowPolicy(prefetchOverflowPolicy PrefetchOverflowPolicy) *Options {
+	o.prefetchOverflowPolicy = prefetchOverflowPolicy
+	return o
+}
+
+// WithPrefetchOverflowPollInterval sets the interval at which the state of the primary is polled
+// while the prefetch buffer is full, only used with PrefetchOverflowPollState policy
+func (o *Options) WithPrefetchOverflowPollInterval(prefetchOverflowPollInterval time.Duration) *Options {
+	o.prefetchOverflowPollInterval = prefetchOverflowPollInterval
+	return o
+}
+
 // WithReplicationCommitConcurrency sets the number of goroutines doing replication
 func (o *Options) WithReplicationCommitConcurrency(replicationCommitConcurrency int) *Options {
 	o.replicationCommitConcurrency = replicationCommitConcurrency
 	return o
 }
 
+// WithFetchConcurrency sets the number of streams used to concurrently fetch transactions from the primary.
+// Concurrent fetching is only used when synchronous replication is disabled as it requires strict ordering
+func (o *Options) WithFetchConcurrency(fetchConcurrency int) *Options {
+	o.fetchConcurrency = fetchConcurrency
+	return o
+}
+
+// WithMaxReplicationRetries sets the number of times replicating a transaction is retried before replication
+// is stopped, as the transaction can not be applied to the replica. Retries are unlimited when set to zero
+func (o *Options) WithMaxReplicationRetries(maxReplicationRetries int) *Options {
+	o.maxReplicationRetries = maxReplicationRetries
+	return o
+}
+
+// WithReconnectAfterFailures sets the number of consecutive fetching failures after which
+// the connection with the primary is re-established, 1 means reconnecting after every failure
+func (o *Options) WithReconnectAfterFailures(reconnectAfterFailures int) *Options {
+	o.reconnectAfterFailures = reconnectAfterFailures
+	return o
+}
+
 // WithAllowTxDiscarding enable auto discarding of precommitted transactions
 func (o *Options) WithAllowTxDiscarding(allowTxDiscarding bool) *Options {
 	o.allowTxDiscarding = allowTxDiscarding
@@ -156,8 +530,154 @@ func (o *Options) WithWaitForIndexing(waitForIndexing bool) *Options {
 	return o
 }
 
+// WithVerifyAppliedHashes enables the comparison of the hash of every transaction applied to the replica
+// against the one calculated by the primary, so divergence is detected as soon as a transaction is applied
+// instead of when the primary reports its commit state, which only happens with synchronous replication
+func (o *Options) WithVerifyAppliedHashes(verifyAppliedHashes bool) *Options {
+	o.verifyAppliedHashes = verifyAppliedHashes
+	return o
+}
+
 // WithDelayer sets delayer used to pause re-attempts
 func (o *Options) WithDelayer(delayer Delayer) *Options {
 	o.delayer = delayer
 	return o
 }
+
+// WithMaxDelay sets the maximum delay between re-attempts, no cap is enforced when set to zero
+func (o *Options) WithMaxDelay(maxDelay time.Duration) *Options {
+	o.maxDelay = maxDelay
+	return o
+}
+
+// WithDelayJitter sets the random jitter applied to re-attempt delays i.e. 0.25 means delays are randomly
+// shortened by up to 25%. Jitter is applied after the max delay, so capped delays are spread as well
+func (o *Options) WithDelayJitter(delayJitter float64) *Options {
+	o.delayJitter = delayJitter
+	return o
+}
+
+// WithDivergenceHandler sets the handler invoked before the replicator stops due to divergence from the primary
+func (o *Options) WithDivergenceHandler(divergenceHandler DivergenceHandler) *Options {
+	o.divergenceHandler = divergenceHandler
+	return o
+}
+
+// WithRecoveryHandler sets the handler invoked when replication recovers from consecutive failures
+func (o *Options) WithRecoveryHandler(recoveryHandler RecoveryHandler) *Options {
+	o.recoveryHandler = recoveryHandler
+	return o
+}
+
+// WithApplyHook sets a hook invoked after each transaction is successfully replicated.
+// The hook is called synchronously by the replicator which applied the transaction, thus
+// slow hooks backpressure replication. The provided bytes must not be modified.
+func (o *Options) WithApplyHook(applyHook ApplyHook) *Options {
+	o.applyHook = applyHook
+	return o
+}
+
+// WithClientFactory sets the factory of the clients used to connect to the primary,
+// so the transport used to reach the primary can be customized
+func (o *Options) WithClientFactory(clientFactory ClientFactory) *Options {
+	o.clientFactory = clientFactory
+	return o
+}
+
+// WithEntryFilter sets the filter selecting the entries replicated along with their values,
+// every value is replicated when no filter is set. See EntryFilter for the implications of filtering
+func (o *Options) WithEntryFilter(entryFilter EntryFilter) *Options {
+	o.entryFilter = entryFilter
+	return o
+}
+
+// WithCheckpointStore sets the store used to persist replication progress. When synchronous replication
+// is disabled, the replicator resumes from the stored checkpoint after a restart, as long as it's not
+// ahead of the precommit state of the replica. The checkpoint is saved after each applied transaction
+func (o *Options) WithCheckpointStore(checkpointStore CheckpointStore) *Options {
+	o.checkpointStore = checkpointStore
+	return o
+}
+
+// WithReplicaUUID sets the identifier the replica reports to the primary, overriding the one
+// provided when creating the replicator. A stable identifier lets the primary keep track of
+// the replica across restarts when synchronous replication is enabled
+func (o *Options) WithReplicaUUID(replicaUUID string) *Options {
+	o.replicaUUID = replicaUUID
+	return o
+}
+
+// primaryEndpoints returns the endpoints the primary database can be reached through in order of preference
+func (o *Options) primaryEndpoints() []Endpoint {
+	endpoints := make([]Endpoint, 0, 1+len(o.primaryFallbackEndpoints))
+
+	endpoints = append(endpoints, Endpoint{Host: o.primaryHost, Port: o.primaryPort})
+	endpoints = append(endpoints, o.primaryFallbackEndpoints...)
+
+	return endpoints
+}
+
+// exportTxCallOptions returns the grpc call options used to export transactions from the primary
+func (o *Options) exportTxCallOptions() []grpc.CallOption {
+	if o.streamCompression == StreamCompressionGzip {
+		return []grpc.CallOption{grpc.UseCompressor(gzip.Name)}
+	}
+
+	return nil
+}
+
+// tlsConfig returns the TLS configuration used to connect to the primary,
+// a nil config is returned when plaintext connections must be used
+func (o *Options) tlsConfig() (*tls.Config, error) {
+	if o.serverCertPool == nil && len(o.clientCertPEM) == 0 {
+		return nil, nil
+	}
+
+	config := &tls.Config{
+		RootCAs:    o.serverCertPool,
+		ServerName: o.serverName,
+	}
+
+	if len(o.clientCertPEM) > 0 {
+		cert, err := tls.X509KeyPair(o.clientCertPEM, o.clientKeyPEM)
+		if err != nil {
+			return nil, err
+		}
+
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+// dialOptions returns the grpc dial options used to connect to the primary
+func (o *Options) dialOptions() ([]grpc.DialOption, error) {
+	tlsConfig, err := o.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	var dialOptions []grpc.DialOption
+
+	if tlsConfig == nil {
+		dialOptions = append(dialOptions, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	} else {
+		dialOptions = append(dialOptions, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	}
+
+	if o.dialTimeout > 0 {
+		dialOptions = append(dialOptions, grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff:           backoff.DefaultConfig,
+			MinConnectTimeout: o.dialTimeout,
+		}))
+	}
+
+	if o.keepAliveInterval > 0 || o.keepAliveTimeout > 0 {
+		dialOptions = append(dialOptions, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:    o.keepAliveInterval,
+			Timeout: o.keepAliveTimeout,
+		}))
+	}
+
+	return dialOptions, nil
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replication
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Milliseconds is a duration serialized as a number of milliseconds
+type Milliseconds int64
+
+func toMilliseconds(d time.Duration) Milliseconds {
+	return Milliseconds(d / time.Millisecond)
+}
+
+func (ms Milliseconds) duration() time.Duration {
+	return time.Duration(ms) * time.Millisecond
+}
+
+// Kinds of delayers supported by the json representation of the options
+const (
+	DelayerExponentialBackoff = "exponentialBackoff"
+	DelayerConstant           = "constant"
+	DelayerDecorrelatedJitter = "decorrelatedJitter"
+)
+
+type delayerJSON struct {
+	Kind     string       `json:"kind"`
+	MinDelay Milliseconds `json:"minDelay,omitempty"` // ms, base delay of decorrelated jitter delayers
+	MaxDelay Milliseconds `json:"maxDelay,omitempty"` // ms
+	Exponent float64      `json:"exponent,omitempty"`
+	Jitter   float64      `json:"jitter,omitempty"`
+}
+
+type optionsJSON struct {
+	PrimaryDatabase          string     `json:"primaryDatabase"`
+	PrimaryHost              string     `json:"primaryHost"`
+	PrimaryPort              int        `json:"primaryPort"`
+	PrimaryFallbackEndpoints []Endpoint `json:"primaryFallbackEndpoints,omitempty"`
+	PrimaryUsername          string     `json:"primaryUsername"`
+
+	ServerName string `json:"serverName,omitempty"`
+
+	DialTimeout          Milliseconds `json:"dialTimeout"`          // ms
+	KeepAliveInterval    Milliseconds `json:"keepAliveInterval"`    // ms
+	KeepAliveTimeout     Milliseconds `json:"keepAliveTimeout"`     // ms
+	IdleReconnectTimeout Milliseconds `json:"idleReconnectTimeout"` // ms
+
+	StreamChunkSize   int    `json:"streamChunkSize"`
+	AutoChunkSize     bool   `json:"autoChunkSize"`
+	StreamCompression string `json:"streamCompression"`
+
+	PrefetchTxBufferSize         int                    `json:"prefetchTxBufferSize"`
+	MaxInflightBytes             int                    `json:"maxInflightBytes"`
+	PrefetchOverflowPolicy       PrefetchOverflowPolicy `json:"prefetchOverflowPolicy"`
+	PrefetchOverflowPollInterval Milliseconds           `json:"prefetchOverflowPollInterval"` // ms
+	ReplicationCommitConcurrency int                    `json:"replicationCommitConcurrency"`
+	FetchConcurrency             int                    `json:"fetchConcurrency"`
+	MaxReplicationRetries        int                    `json:"maxReplicationRetries"`
+	ReconnectAfterFailures       int                    `json:"reconnectAfterFailures"`
+
+	AllowTxDiscarding   bool `json:"allowTxDiscarding"`
+	SkipIntegrityCheck  bool `json:"skipIntegrityCheck"`
+	WaitForIndexing     bool `json:"waitForIndexing"`
+	VerifyAppliedHashes bool `json:"verifyAppliedHashes"`
+
+	Delayer     *delayerJSON `json:"delayer,omitempty"`
+	MaxDelay    Milliseconds `json:"maxDelay"` // ms
+	DelayJitter float64      `json:"delayJitter"`
+
+	ReplicaUUID string `json:"replicaUUID,omitempty"`
+}
+
+// MarshalJSON encodes the options as json so they can be stored along with the deployment configuration.
+// Secrets, i.e. the password and the client certificate key, are never included, and neither are the
+// server certificate pool, handlers, hooks, client factory, entry filter and checkpoint store.
+// An error is returned when a custom delayer is set, only the ones provided by this package are supported
+func (o *Options) MarshalJSON() ([]byte, error) {
+	delayer, err := delayerToJSON(o.delayer)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := o.toJSON()
+	opts.Delayer = delayer
+
+	return json.Marshal(opts)
+}
+
+// UnmarshalJSON sets the options encoded with MarshalJSON. Options not present in the json,
+// including the ones MarshalJSON never includes such as the password, keep their current value,
+// so secrets can be loaded separately and set with their corresponding With* method
+func (o *Options) UnmarshalJSON(data []byte) error {
+	opts := o.toJSON()
+
+	err := json.Unmarshal(data, &opts)
+	if err != nil {
+		return err
+	}
+
+	if opts.Delayer != nil {
+		delayer, err := opts.Delayer.toDelayer()
+		if err != nil {
+			return err
+		}
+
+		o.delayer = delayer
+	}
+
+	o.primaryDatabase = opts.PrimaryDatabase
+	o.primaryHost = opts.PrimaryHost
+	o.primaryPort = opts.PrimaryPort
+	o.primaryFallbackEndpoints = opts.PrimaryFallbackEndpoints
+	o.primaryUsername = opts.PrimaryUsername
+
+	o.serverName = opts.ServerName
+
+	o.dialTimeout = opts.DialTimeout.duration()
+	o.keepAliveInterval = opts.KeepAliveInterval.duration()
+	o.keepAliveTimeout = opts.KeepAliveTimeout.duration()
+	o.idleReconnectTimeout = opts.IdleReconnectTimeout.duration()
+
+	o.streamChunkSize = opts.StreamChunkSize
+	o.autoChunkSize = opts.AutoChunkSize
+	o.streamCompression = opts.StreamCompression
+
+	o.prefetchTxBufferSize = opts.PrefetchTxBufferSize
+	o.maxInflightBytes = opts.MaxInflightBytes
+	o.prefetchOverflowPolicy = opts.PrefetchOverflowPolicy
+	o.prefetchOverflowPollInterval = opts.PrefetchOverflowPollInterval.duration()
+	o.replicationCommitConcurrency = opts.ReplicationCommitConcurrency
+	o.fetchConcurrency = opts.FetchConcurrency
+	o.maxReplicationRetries = opts.MaxReplicationRetries
+	o.reconnectAfterFailures = opts.ReconnectAfterFailures
+
+	o.allowTxDiscarding = opts.AllowTxDiscarding
+	o.skipIntegrityCheck = opts.SkipIntegrityCheck
+	o.waitForIndexing = opts.WaitForIndexing
+	o.verifyAppliedHashes = opts.VerifyAppliedHashes
+
+	o.maxDelay = opts.MaxDelay.duration()
+	o.delayJitter = opts.DelayJitter
+
+	o.replicaUUID = opts.ReplicaUUID
+
+	return nil
+}
+
+// toJSON returns the json representation of the options, except for the delayer
+func (o *Options) toJSON() optionsJSON {
+	return optionsJSON{
+		PrimaryDatabase:          o.primaryDatabase,
+		PrimaryHost:              o.primaryHost,
+		PrimaryPort:              o.primaryPort,
+		PrimaryFallbackEndpoints: o.primaryFallbackEndpoints,
+		PrimaryUsername:          o.primaryUsername,
+
+		ServerName: o.serverName,
+
+		DialTimeout:          toMilliseconds(o.dialTimeout),
+		KeepAliveInterval:    toMilliseconds(o.keepAliveInterval),
+		KeepAliveTimeout:     toMilliseconds(o.keepAliveTimeout),
+		IdleReconnectTimeout: toMilliseconds(o.idleReconnectTimeout),
+
+		StreamChunkSize:   o.streamChunkSize,
+		AutoChunkSize:     o.autoChunkSize,
+		StreamCompression: o.streamCompression,
+
+		PrefetchTxBufferSize:         o.prefetchTxBufferSize,
+		MaxInflightBytes:             o.maxInflightBytes,
+		PrefetchOverflowPolicy:       o.prefetchOverflowPolicy,
+		PrefetchOverflowPollInterval: toMilliseconds(o.prefetchOverflowPollInterval),
+		ReplicationCommitConcurrency: o.replicationCommitConcurrency,
+		FetchConcurrency:             o.fetchConcurrency,
+		MaxReplicationRetries:        o.maxReplicationRetries,
+		ReconnectAfterFailures:       o.reconnectAfterFailures,
+
+		AllowTxDiscarding:   o.allowTxDiscarding,
+		SkipIntegrityCheck:  o.skipIntegrityCheck,
+		WaitForIndexing:     o.waitForIndexing,
+		VerifyAppliedHashes: o.verifyAppliedHashes,
+
+		MaxDelay:    toMilliseconds(o.maxDelay),
+		DelayJitter: o.delayJitter,
+
+		ReplicaUUID: o.replicaUUID,
+	}
+}
+
+func delayerToJSON(delayer Delayer) (*delayerJSON, error) {
+	switch d := delayer.(type) {
+	case nil:
+		return nil, nil
+	case *expBackoff:
+		return &delayerJSON{
+			Kind:     DelayerExponentialBackoff,
+			MinDelay: toMilliseconds(d.retryMinDelay),
+			MaxDelay: toMilliseconds(d.retryMaxDelay),
+			Exponent: d.retryDelayExp,
+			Jitter:   d.retryJitter,
+		}, nil
+	case *constantDelayer:
+		return &delayerJSON{
+			Kind:     DelayerConstant,
+			MinDelay: toMilliseconds(d.delay),
+		}, nil
+	case *decorrelatedJitterDelayer:
+		return &delayerJSON{
+			Kind:     DelayerDecorrelatedJitter,
+			MinDelay: toMilliseconds(d.base),
+			MaxDelay: toMilliseconds(d.maxDelay),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: custom delayers can not be encoded", ErrIllegalArguments)
+}
+
+func (d *delayerJSON) toDelayer() (Delayer, error) {
+	switch d.Kind {
+	case DelayerExponentialBackoff:
+		return &expBackoff{
+			retryMinDelay: d.MinDelay.duration(),
+			retryMaxDelay: d.MaxDelay.duration(),
+			retryDelayExp: d.Exponent,
+			retryJitter:   d.Jitter,
+		}, nil
+	case DelayerConstant:
+		return NewConstantDelayer(d.MinDelay.duration()), nil
+	case DelayerDecorrelatedJitter:
+		return NewDecorrelatedJitterDelayer(d.MinDelay.duration(), d.MaxDelay.duration()), nil
+	}
+
+	return nil, fmt.Errorf("%w: unknown delayer kind '%s'", ErrInvalidOptions, d.Kind)
+}
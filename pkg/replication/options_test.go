@@ -17,9 +17,17 @@ limitations under the License.
 package replication
 
 import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"os"
 	"testing"
 	"time"
 
+	"github.com/codenotary/immudb/embedded/logger"
+	"github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/database"
 	"github.com/stretchr/testify/require"
 )
 
@@ -34,35 +42,292 @@ func TestOptions(t *testing.T) {
 		retryJitter:   0.1,
 	}
 
+	checkpointStore := &FileCheckpointStore{dir: t.TempDir()}
+
 	opts.WithPrimaryDatabase("defaultdb").
 		WithPrimaryHost("127.0.0.1").
 		WithPrimaryPort(3322).
+		WithPrimaryFallbackEndpoints(Endpoint{Host: "127.0.0.2", Port: 3323}).
 		WithPrimaryUsername("immudbUsr").
 		WithPrimaryPassword("immdubPwd").
 		WithStreamChunkSize(DefaultChunkSize).
+		WithStreamCompression(StreamCompressionGzip).
+		WithAutoChunkSize(true).
 		WithPrefetchTxBufferSize(DefaultPrefetchTxBufferSize).
+		WithMaxInflightBytes(1 << 20).
+		WithPrefetchOverflowPolicy(PrefetchOverflowPollState).
+		WithPrefetchOverflowPollInterval(100 * time.Millisecond).
 		WithReplicationCommitConcurrency(DefaultReplicationCommitConcurrency).
+		WithFetchConcurrency(4).
+		WithMaxReplicationRetries(5).
+		WithReconnectAfterFailures(1).
 		WithAllowTxDiscarding(true).
 		WithSkipIntegrityCheck(true).
 		WithWaitForIndexing(true).
-		WithDelayer(delayer)
+		WithVerifyAppliedHashes(true).
+		WithDelayer(delayer).
+		WithDialTimeout(5 * time.Second).
+		WithKeepAliveInterval(10 * time.Second).
+		WithKeepAliveTimeout(20 * time.Second).
+		WithIdleReconnectTimeout(time.Minute).
+		WithMaxDelay(time.Minute).
+		WithDelayJitter(0.25).
+		WithDivergenceHandler(func(db string, primaryTxID, replicaTxID uint64) {}).
+		WithRecoveryHandler(func(db string, downtime time.Duration) {}).
+		WithApplyHook(func(txID uint64, etx []byte) {}).
+		WithClientFactory(DefaultClientFactory).
+		WithEntryFilter(func(key []byte) bool { return true }).
+		WithCheckpointStore(checkpointStore).
+		WithReplicaUUID("9m4e2mr0ui3e8a215n4g")
 
 	require.Equal(t, "defaultdb", opts.primaryDatabase)
 	require.Equal(t, "127.0.0.1", opts.primaryHost)
 	require.Equal(t, 3322, opts.primaryPort)
+	require.Equal(t, []Endpoint{{Host: "127.0.0.1", Port: 3322}, {Host: "127.0.0.2", Port: 3323}}, opts.primaryEndpoints())
 	require.Equal(t, "immudbUsr", opts.primaryUsername)
 	require.Equal(t, "immdubPwd", opts.primaryPassword)
 	require.Equal(t, DefaultChunkSize, opts.streamChunkSize)
+	require.Equal(t, StreamCompressionGzip, opts.streamCompression)
+	require.True(t, opts.autoChunkSize)
+	require.Len(t, opts.exportTxCallOptions(), 1)
 	require.Equal(t, DefaultPrefetchTxBufferSize, opts.prefetchTxBufferSize)
+	require.Equal(t, 1<<20, opts.maxInflightBytes)
+	require.Equal(t, PrefetchOverflowPollState, opts.prefetchOverflowPolicy)
+	require.Equal(t, 100*time.Millisecond, opts.prefetchOverflowPollInterval)
 	require.Equal(t, DefaultReplicationCommitConcurrency, opts.replicationCommitConcurrency)
+	require.Equal(t, 4, opts.fetchConcurrency)
+	require.Equal(t, 5, opts.maxReplicationRetries)
+	require.Equal(t, 1, opts.reconnectAfterFailures)
 	require.True(t, opts.allowTxDiscarding)
 	require.True(t, opts.skipIntegrityCheck)
 	require.True(t, opts.waitForIndexing)
+	require.True(t, opts.verifyAppliedHashes)
 	require.Equal(t, delayer, opts.delayer)
+	require.Equal(t, 5*time.Second, opts.dialTimeout)
+	require.Equal(t, 10*time.Second, opts.keepAliveInterval)
+	require.Equal(t, 20*time.Second, opts.keepAliveTimeout)
+	require.Equal(t, time.Minute, opts.idleReconnectTimeout)
+	require.Equal(t, time.Minute, opts.maxDelay)
+	require.Equal(t, 0.25, opts.delayJitter)
+	require.NotNil(t, opts.divergenceHandler)
+	require.NotNil(t, opts.recoveryHandler)
+	require.NotNil(t, opts.applyHook)
+	require.NotNil(t, opts.clientFactory)
+	require.NotNil(t, opts.entryFilter)
+	require.Equal(t, checkpointStore, opts.checkpointStore)
+	require.Equal(t, "9m4e2mr0ui3e8a215n4g", opts.replicaUUID)
+
+	require.NoError(t, opts.Validate())
+
+	opts.WithStreamCompression("zstd")
+	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
+
+	opts.WithStreamCompression(StreamCompressionNone)
+	require.NoError(t, opts.Validate())
+	require.Empty(t, opts.exportTxCallOptions())
+
+	opts.WithMaxReplicationRetries(-1)
+	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
+
+	opts.WithMaxReplicationRetries(0)
+	require.NoError(t, opts.Validate())
+
+	opts.WithReconnectAfterFailures(0)
+	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
+
+	opts.WithReconnectAfterFailures(DefaultReconnectAfterFailures)
+	require.NoError(t, opts.Validate())
+
+	opts.WithIdleReconnectTimeout(-1)
+	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
+
+	opts.WithIdleReconnectTimeout(0)
+	require.NoError(t, opts.Validate())
+
+	opts.WithMaxInflightBytes(-1)
+	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
+
+	opts.WithMaxInflightBytes(0)
+	require.NoError(t, opts.Validate())
+
+	opts.WithPrefetchOverflowPolicy(PrefetchOverflowPollState + 1)
+	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
 
+	opts.WithPrefetchOverflowPolicy(PrefetchOverflowPollState).WithPrefetchOverflowPollInterval(0)
+	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
+
+	opts.WithPrefetchOverflowPolicy(PrefetchOverflowBlock)
 	require.NoError(t, opts.Validate())
 
+	opts.WithDelayJitter(1)
+	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
+
+	opts.WithDelayJitter(0).WithMaxDelay(-time.Second)
+	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
+
+	opts.WithMaxDelay(0).WithDialTimeout(-time.Second)
+	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
+
+	opts.WithDialTimeout(0).WithPrimaryFallbackEndpoints(Endpoint{Host: "127.0.0.2"})
+	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
+
+	opts.WithPrimaryFallbackEndpoints().WithReplicaUUID("invalid")
+	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
+
+	opts.WithReplicaUUID("")
+	require.Equal(t, []Endpoint{{Host: "127.0.0.1", Port: 3322}}, opts.primaryEndpoints())
+
+	dialOptions, err := opts.WithDialTimeout(time.Second).dialOptions()
+	require.NoError(t, err)
+	require.Len(t, dialOptions, 3)
+
 	defaultOpts := DefaultOptions()
 	require.NotNil(t, defaultOpts)
 	require.NoError(t, defaultOpts.Validate())
+
+	tlsConfig, err := defaultOpts.tlsConfig()
+	require.NoError(t, err)
+	require.Nil(t, tlsConfig)
+}
+
+func TestOptionsTLS(t *testing.T) {
+	certPool := x509.NewCertPool()
+
+	opts := DefaultOptions().
+		WithServerTLS(certPool).
+		WithServerName("primary.immudb.io")
+
+	require.NoError(t, opts.Validate())
+	require.Equal(t, certPool, opts.serverCertPool)
+	require.Equal(t, "primary.immudb.io", opts.serverName)
+
+	tlsConfig, err := opts.tlsConfig()
+	require.NoError(t, err)
+	require.NotNil(t, tlsConfig)
+	require.Equal(t, certPool, tlsConfig.RootCAs)
+	require.Equal(t, "primary.immudb.io", tlsConfig.ServerName)
+	require.Empty(t, tlsConfig.Certificates)
+
+	opts.WithClientCert([]byte("cert"), nil)
+	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
+
+	opts.WithClientCert([]byte("cert"), []byte("key"))
+	require.ErrorIs(t, opts.Validate(), ErrInvalidOptions)
+}
+
+func TestOptionsJSON(t *testing.T) {
+	opts := DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(3322).
+		WithPrimaryFallbackEndpoints(Endpoint{Host: "127.0.0.2", Port: 3323}).
+		WithPrimaryUsername("immudbUsr").
+		WithPrimaryPassword("immdubPwd").
+		WithPrefetchOverflowPolicy(PrefetchOverflowPollState).
+		WithPrefetchOverflowPollInterval(100 * time.Millisecond).
+		WithReplicationCommitConcurrency(5).
+		WithFetchConcurrency(4).
+		WithIdleReconnectTimeout(time.Minute).
+		WithMaxDelay(30 * time.Second).
+		WithDelayJitter(0.25).
+		WithReplicaUUID("9m4e2mr0ui3e8a215n4g")
+
+	t.Run("secrets should not be encoded", func(t *testing.T) {
+		bs, err := json.Marshal(opts)
+		require.NoError(t, err)
+		require.NotContains(t, string(bs), "immdubPwd")
+	})
+
+	t.Run("options should be preserved when round-tripped", func(t *testing.T) {
+		delayers := []Delayer{
+			opts.delayer,
+			NewConstantDelayer(time.Second),
+			NewDecorrelatedJitterDelayer(time.Second, time.Minute),
+		}
+
+		for _, delayer := range delayers {
+			opts.WithDelayer(delayer)
+
+			bs, err := json.Marshal(opts)
+			require.NoError(t, err)
+
+			decoded := DefaultOptions().WithPrimaryPassword("immdubPwd")
+
+			err = json.Unmarshal(bs, decoded)
+			require.NoError(t, err)
+
+			require.NotNil(t, decoded.clientFactory)
+
+			// functions can not be compared
+			expected, actual := *opts, *decoded
+			expected.clientFactory, actual.clientFactory = nil, nil
+
+			require.Equal(t, expected, actual)
+		}
+	})
+
+	t.Run("options not present should keep their values", func(t *testing.T) {
+		decoded := DefaultOptions()
+
+		err := json.Unmarshal([]byte(`{"primaryHost": "10.0.0.1", "fetchConcurrency": 2}`), decoded)
+		require.NoError(t, err)
+		require.Equal(t, "10.0.0.1", decoded.primaryHost)
+		require.Equal(t, 2, decoded.fetchConcurrency)
+		require.Equal(t, DefaultOptions().delayer, decoded.delayer)
+		require.Equal(t, DefaultReplicationCommitConcurrency, decoded.replicationCommitConcurrency)
+
+		err = json.Unmarshal([]byte(`{"delayer": {"kind": "linear"}}`), decoded)
+		require.ErrorIs(t, err, ErrInvalidOptions)
+	})
+
+	t.Run("custom delayers can not be encoded", func(t *testing.T) {
+		_, err := json.Marshal(DefaultOptions().WithDelayer(&boundedDelayer{delayer: NewConstantDelayer(time.Second)}))
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+}
+
+func TestOptionsValidateConnection(t *testing.T) {
+	logger := logger.NewSimpleLogger("logger", os.Stdout)
+
+	primary, err := database.NewDB("primarydb", nil, database.DefaultOption().WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer primary.Close()
+
+	errFactory := errors.New("simulated factory failure")
+
+	fallbackEndpoint := Endpoint{Host: "127.0.0.2", Port: 3323}
+
+	optsWithClient := func(c client.ImmuClient) *Options {
+		return DefaultOptions().
+			WithPrimaryDatabase("primarydb").
+			WithPrimaryHost("127.0.0.1").
+			WithPrimaryPort(3322).
+			WithPrimaryFallbackEndpoints(fallbackEndpoint).
+			WithClientFactory(func(opts *client.Options) (client.ImmuClient, error) {
+				if opts.Address != fallbackEndpoint.Host {
+					return nil, errFactory
+				}
+				return c, nil
+			})
+	}
+
+	t.Run("primary reachable through a fallback endpoint", func(t *testing.T) {
+		c := &dbClient{db: primary}
+
+		err := optsWithClient(c).ValidateConnection(context.Background())
+		require.NoError(t, err)
+		require.True(t, c.sessionClosed)
+	})
+
+	t.Run("incompatible primary", func(t *testing.T) {
+		err := optsWithClient(&serverInfoClient{version: "v1.4.1"}).ValidateConnection(context.Background())
+		require.ErrorIs(t, err, ErrIncompatiblePrimary)
+	})
+
+	t.Run("unreachable primary", func(t *testing.T) {
+		err := optsWithClient(nil).
+			WithPrimaryFallbackEndpoints().
+			ValidateConnection(context.Background())
+		require.ErrorIs(t, err, errFactory)
+	})
 }
@@ -23,16 +23,22 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/codenotary/immudb/embedded/logger"
+	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/client"
 	"github.com/codenotary/immudb/pkg/database"
 	"github.com/codenotary/immudb/pkg/stream"
 	"github.com/rs/xid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 var ErrIllegalArguments = errors.New("illegal arguments")
@@ -42,53 +48,146 @@ var ErrAlreadyStopped = errors.New("already stopped")
 var ErrReplicaDivergedFromPrimary = errors.New("replica diverged from primary")
 var ErrNoSynchronousReplicationOnPrimary = errors.New("primary is not running with synchronous replication")
 var ErrInvalidReplicationMetadata = errors.New("invalid replication metadata retrieved")
+var ErrTxDiscardingNotAllowed = errors.New("transaction discarding is not allowed")
+var ErrMaxReplicationRetriesExceeded = errors.New("max replication retries exceeded")
+var ErrSyncReplicationNotSupported = errors.New("not supported with synchronous replication")
+var ErrAlreadyPaused = errors.New("already paused")
+var ErrNotPaused = errors.New("not paused")
+var ErrAppliedTxHashMismatch = fmt.Errorf("%w: applied transaction hash mismatch", ErrReplicaDivergedFromPrimary)
+var ErrIncompatiblePrimary = errors.New("primary is not compatible with replica")
+
+// MinPrimaryVersion is the oldest version of the primary supporting the replication protocol used by replicas
+const MinPrimaryVersion = "1.5.0"
 
 type prefetchTxEntry struct {
 	data    []byte
 	addedAt time.Time
 }
 
+// ReplicatorStatus describes the current state of a replicator
+type ReplicatorStatus struct {
+	Running             bool
+	Paused              bool
+	Connected           bool
+	Diverged            bool
+	PrimaryDB           string
+	LastTx              uint64
+	ConsecutiveFailures int
+	FailingSince        time.Time // zero unless the last attempt to fetch from the primary failed
+	SyncReplication     bool
+	ChunkSize           int
+	InflightBytes       int // total size of the prefetched transactions waiting to be replicated
+}
+
+type exportTxStream struct {
+	stream   schema.ImmuService_StreamExportTxClient
+	receiver stream.MsgReceiver
+}
+
 type TxReplicator struct {
 	uuid xid.ID
 
 	db   database.DB
 	opts *Options
 
-	_primaryDB string // just a string denoting primary database i.e. db@host:port
+	_primaryDB string   // just a string denoting primary database i.e. db@host:port, guarded by both mutex and statsMutex
+	endpoint   Endpoint // endpoint of the primary currently or lastly connected to, guarded by both mutex and statsMutex
 
-	logger logger.Logger
+	logger *logger.FieldLogger // attaches the name of the replica database to every message
 
 	context    context.Context
 	cancelFunc context.CancelFunc
 
+	// fetching can be cancelled independently so to let replicators drain the buffer
+	fetchCancelFunc context.CancelFunc
+
 	client client.ImmuClient
 
 	streamSrvFactory stream.ServiceFactory
 
-	exportTxStream         schema.ImmuService_StreamExportTxClient
+	exportTxStream         schema.ImmuService_StreamExportTxClient // guarded by both mutex and statsMutex
 	exportTxStreamReceiver stream.MsgReceiver
 
+	// additional streams used to fetch transactions concurrently,
+	// only used when synchronous replication is disabled
+	concurrentExportTxStreams []*exportTxStream
+
+	// export streams are bound to a dedicated context, cancelled on disconnection
+	cancelExportTxStreams context.CancelFunc
+
 	lastTx uint64
 
-	prefetchTxBuffer       chan prefetchTxEntry // buffered channel of exported txs
+	// with sync replication, a commit allowance received from the primary is only passed to the replica
+	// once the transaction has been applied, until then it's kept pending
+	pendingCommitMutex sync.Mutex
+	pendingCommitTxID  uint64
+	pendingCommitAlh   [sha256.Size]byte
+
+	// id of the last transaction saved into the checkpoint store, saves never move the checkpoint backwards
+	checkpointMutex sync.Mutex
+	checkpointTxID  uint64
+
+	// credentials used to authenticate against the primary are guarded by a dedicated mutex,
+	// so they can be updated without waiting for an in-progress fetch to complete
+	credentialsMutex   sync.Mutex
+	primaryUsername    string
+	primaryPassword    string
+	credentialsUpdated bool
+
+	prefetchTxBuffer      chan prefetchTxEntry // buffered channel of exported txs
+	inflightBytes         int                  // total size of the txs in prefetchTxBuffer, guarded by statsMutex
+	prefetchHighWatermark int64                // max number of buffered txs before fetching is paused
+	prefetchTxReleased    chan struct{}        // signals a buffered tx was picked up by a replicator
+	replicatorsWg         sync.WaitGroup
+
+	// the pool of replicators can be resized while running, replicatorsMutex guards
+	// the pool along with the closing and re-creation of prefetchTxBuffer
+	replicatorsMutex       sync.Mutex
 	replicationConcurrency int
+	replicatorQuits        []chan struct{} // one per running replicator, nil while stopped
+	replicationFailure     chan error      // reports a transaction that could not be replicated
+
+	replicatedTxCount uint64 // accessed atomically
+
+	chunkSize int64   // chunk size requested to the primary, accessed atomically
+	avgTxSize float64 // moving average of the size of exported transactions, guarded by mutex
 
 	allowTxDiscarding  bool
 	skipIntegrityCheck bool
 	waitForIndexing    bool
 
 	delayer             Delayer
-	consecutiveFailures int
+	consecutiveFailures int       // guarded by both mutex and statsMutex
+	failingSince        time.Time // guarded by both mutex and statsMutex
+	diverged            bool      // guarded by statsMutex
 
-	running bool
+	running bool // guarded by both mutex and statsMutex
+
+	// while paused, neither fetching nor replication make progress but the session
+	// with the primary is kept open. resumed is closed once replication is resumed
+	paused  bool          // guarded by statsMutex
+	resumed chan struct{} // guarded by statsMutex
 
 	mutex sync.Mutex
 
+	// observable fields are guarded by a dedicated mutex so they can be
+	// read without waiting for an in-progress fetch to complete
+	statsMutex   sync.RWMutex
+	primaryTxID  uint64 // last transaction known to be committed on the primary
+	replicaTxID  uint64 // last transaction committed on the replica
+	fetchedTxID  uint64 // last transaction fetched when the primary state was last recorded
+	lastSyncedAt time.Time
+
+	// last time a transaction was received or the primary commit state advanced since connecting
+	lastProgressAt time.Time // guarded by statsMutex
+
 	metrics metrics
 }
 
-func NewTxReplicator(uuid xid.ID, db database.DB, opts *Options, logger logger.Logger) (*TxReplicator, error) {
-	if db == nil || logger == nil {
+// NewTxReplicator creates a replicator of the primary database set in opts into db. The replica reports uuid to
+// the primary as its identifier, unless one is set with WithReplicaUUID, in which case the configured one is used
+func NewTxReplicator(uuid xid.ID, db database.DB, opts *Options, log logger.Logger) (*TxReplicator, error) {
+	if db == nil || log == nil {
 		return nil, fmt.Errorf("%w: no database or logger provided", ErrIllegalArguments)
 	}
 
@@ -97,63 +196,109 @@ func NewTxReplicator(uuid xid.ID, db database.DB, opts *Options, logger logger.L
 		return nil, err
 	}
 
+	if opts.replicaUUID != "" {
+		// the configured identifier takes precedence, it was already validated
+		uuid, _ = xid.FromString(opts.replicaUUID)
+	}
+
+	delayer := opts.delayer
+
+	if opts.maxDelay > 0 || opts.delayJitter > 0 {
+		delayer = &boundedDelayer{
+			delayer:  opts.delayer,
+			maxDelay: opts.maxDelay,
+			jitter:   opts.delayJitter,
+		}
+	}
+
 	return &TxReplicator{
 		uuid:                   uuid,
 		db:                     db,
 		opts:                   opts,
-		logger:                 logger,
+		logger:                 logger.With(log, "db", db.GetName()),
 		_primaryDB:             fullAddress(opts.primaryDatabase, opts.primaryHost, opts.primaryPort),
+		endpoint:               opts.primaryEndpoints()[0],
 		streamSrvFactory:       stream.NewStreamServiceFactory(opts.streamChunkSize),
+		primaryUsername:        opts.primaryUsername,
+		primaryPassword:        opts.primaryPassword,
 		prefetchTxBuffer:       make(chan prefetchTxEntry, opts.prefetchTxBufferSize),
+		prefetchHighWatermark:  int64(opts.prefetchTxBufferSize),
+		prefetchTxReleased:     make(chan struct{}, 1),
 		replicationConcurrency: opts.replicationCommitConcurrency,
 		allowTxDiscarding:      opts.allowTxDiscarding,
 		skipIntegrityCheck:     opts.skipIntegrityCheck,
 		waitForIndexing:        opts.waitForIndexing,
-		delayer:                opts.delayer,
+		delayer:                newDelayerSequence(delayer), // used by the fetching loop only
+		chunkSize:              int64(opts.streamChunkSize),
 		metrics:                metricsForDb(db.GetName()),
 	}, nil
 }
 
-func (txr *TxReplicator) handleError(err error) (terminate bool) {
-	txr.mutex.Lock()
-	defer txr.mutex.Unlock()
-
+func (txr *TxReplicator) handleError(ctx context.Context, err error) (terminate bool) {
 	if err == nil {
-		txr.consecutiveFailures = 0
+		txr.handleSuccess()
 		return false
 	}
 
-	if errors.Is(err, ErrAlreadyStopped) || errors.Is(err, ErrReplicaDivergedFromPrimary) {
+	txr.mutex.Lock()
+	defer txr.mutex.Unlock()
+
+	if errors.Is(err, ErrAlreadyStopped) ||
+		errors.Is(err, ErrReplicaDivergedFromPrimary) ||
+		errors.Is(err, ErrMaxReplicationRetriesExceeded) ||
+		errors.Is(err, ErrIncompatiblePrimary) {
 		return true
 	}
 
-	txr.consecutiveFailures++
+	txr.setConsecutiveFailures(txr.consecutiveFailures + 1)
+
+	txr.logger.With("primary", txr._primaryDB, "consecutive_failures", txr.consecutiveFailures).
+		Warningf("Replication error. Reason: %s", err.Error())
+
+	if isSessionLost(err) {
+		txr.disconnect()
 
-	txr.logger.Infof("Replication error on database '%s' from '%s' (%d consecutive failures). Reason: %s",
-		txr.db.GetName(),
-		txr._primaryDB,
-		txr.consecutiveFailures,
-		err.Error())
+		if txr.consecutiveFailures == 1 {
+			// the session expired or was closed by the primary while replication was progressing,
+			// a new one is opened right away. Backoff is only applied if it keeps failing
+			return false
+		}
+	}
 
 	timer := time.NewTimer(txr.delayer.DelayAfter(txr.consecutiveFailures))
 	defer timer.Stop()
 
 	select {
-	case <-txr.context.Done():
+	case <-ctx.Done():
 		timer.Stop()
 		return true
 	case <-timer.C:
 	}
 
-	retryableError := !strings.Contains(err.Error(), "no session found")
-
-	if txr.consecutiveFailures >= 3 || !retryableError {
+	if txr.consecutiveFailures >= txr.opts.reconnectAfterFailures {
 		txr.disconnect()
 	}
 
 	return false
 }
 
+// isSessionLost returns true if the error is caused by the session on the primary
+// being expired or closed, so it can be recovered by reconnecting
+func isSessionLost(err error) bool {
+	if status.Code(err) == codes.Unauthenticated {
+		return true
+	}
+
+	return strings.Contains(err.Error(), "no session found") ||
+		strings.Contains(err.Error(), "session not found")
+}
+
+// isRemoteError reports whether err matches target, errors returned by the primary
+// are received as gRPC statuses so they are matched by the message of target
+func isRemoteError(err, target error) bool {
+	return errors.Is(err, target) || strings.Contains(err.Error(), target.Error())
+}
+
 func (txr *TxReplicator) Start() error {
 	txr.mutex.Lock()
 	defer txr.mutex.Unlock()
@@ -162,302 +307,1376 @@ func (txr *TxReplicator) Start() error {
 		return ErrAlreadyRunning
 	}
 
-	txr.logger.Infof("Initializing replication from '%s' to '%s'...", txr._primaryDB, txr.db.GetName())
+	txr.logger.With("primary", txr._primaryDB).Info("Initializing replication...")
 
 	txr.context, txr.cancelFunc = context.WithCancel(context.Background())
 
-	txr.running = true
+	fetchContext, fetchCancelFunc := context.WithCancel(txr.context)
+	txr.fetchCancelFunc = fetchCancelFunc
 
-	go func() {
-		txr.logger.Infof("Replication for '%s' started fetching transaction from '%s'...", txr.db.GetName(), txr._primaryDB)
+	// replicators report a transaction that could not be replicated so fetching is terminated
+	replicationFailure := make(chan error, 1)
+
+	txr.setRunning(true)
+	txr.setDiverged(false)
+
+	go func(ctx context.Context) {
+		txr.primaryLogger().Info("Replication started fetching transactions...")
 
 		var err error
 
 		for {
-			err := txr.fetchNextTx()
-			if txr.handleError(err) {
+			err = txr.waitWhilePaused(ctx)
+			if err == nil {
+				err = txr.waitForPrefetchCapacity(ctx)
+			}
+			if err == nil {
+				err = txr.fetchNextTx(ctx)
+			}
+
+			select {
+			case err = <-replicationFailure:
+			default:
+			}
+
+			if txr.handleError(ctx, err) {
 				break
 			}
 		}
 
-		txr.logger.Infof("Replication for '%s' stopped fetching transaction from '%s'", txr.db.GetName(), txr._primaryDB)
+		txr.primaryLogger().Info("Replication stopped fetching transactions")
 
 		if errors.Is(err, ErrReplicaDivergedFromPrimary) {
+			txr.setDiverged(true)
+
+			if txr.opts.divergenceHandler != nil {
+				primaryTxID, replicaTxID, _ := txr.Lag()
+				txr.opts.divergenceHandler(txr.db.GetName(), primaryTxID, replicaTxID)
+			}
+
 			txr.Stop()
 		}
-	}()
+
+		if errors.Is(err, ErrMaxReplicationRetriesExceeded) || errors.Is(err, ErrIncompatiblePrimary) {
+			txr.primaryLogger().Errorf("Replication is being stopped. Reason: %s", err.Error())
+
+			txr.Stop()
+		}
+	}(fetchContext)
 
 	txr.metrics.reset()
 
+	txr.pendingCommitMutex.Lock()
+	txr.pendingCommitTxID = 0
+	txr.pendingCommitMutex.Unlock()
+
+	txr.replicatorsMutex.Lock()
+
+	// buffer is closed when replication is stopped thus it must be re-created
+	txr.statsMutex.Lock()
+	txr.prefetchTxBuffer = make(chan prefetchTxEntry, txr.opts.prefetchTxBufferSize)
+	txr.inflightBytes = 0
+	txr.statsMutex.Unlock()
+
+	txr.replicationFailure = replicationFailure
+	txr.replicatorQuits = nil
+
 	for i := 0; i < txr.replicationConcurrency; i++ {
-		go func() {
-			txr.metrics.replicators.Inc()
-			defer txr.metrics.replicators.Dec()
+		txr.startReplicator()
+	}
+
+	txr.replicatorsMutex.Unlock()
+
+	txr.logger.With("primary", txr._primaryDB).Info("Replication successfully initialized")
+
+	return nil
+}
+
+// startReplicator starts a replicator applying the transactions buffered in prefetchTxBuffer,
+// it must be called while holding replicatorsMutex
+func (txr *TxReplicator) startReplicator() {
+	quit := make(chan struct{})
+	txr.replicatorQuits = append(txr.replicatorQuits, quit)
+
+	prefetchTxBuffer := txr.prefetchTxBuffer
+	replicationFailure := txr.replicationFailure
+	fetchCancelFunc := txr.fetchCancelFunc
+
+	txr.replicatorsWg.Add(1)
+
+	go func() {
+		defer txr.replicatorsWg.Done()
+
+		txr.metrics.replicators.Inc()
+		defer txr.metrics.replicators.Dec()
+
+		for {
+			var etx prefetchTxEntry
+			var ok bool
+
+			select {
+			case <-quit:
+				return
+			case etx, ok = <-prefetchTxBuffer:
+			}
+
+			if !ok {
+				return
+			}
+
+			txr.releasePrefetchedTx(prefetchTxBuffer, etx)
+
+			txr.metrics.txWaitQueueHistogram.Observe(time.Since(etx.addedAt).Seconds())
 
-			for etx := range txr.prefetchTxBuffer {
-				txr.metrics.txWaitQueueHistogram.Observe(time.Since(etx.addedAt).Seconds())
+			if txr.waitWhilePaused(txr.context) != nil {
+				return
+			}
+
+			select {
+			case txr.prefetchTxReleased <- struct{}{}:
+			default:
+			}
 
-				if !txr.replicateSingleTx(etx.data) {
-					break
+			err := txr.replicateSingleTx(etx.data)
+			if errors.Is(err, ErrMaxReplicationRetriesExceeded) || errors.Is(err, ErrReplicaDivergedFromPrimary) {
+				select {
+				case replicationFailure <- err:
+				default:
 				}
+
+				fetchCancelFunc()
 			}
-		}()
+			if err != nil {
+				return
+			}
+		}
+	}()
+}
+
+// SetCommitConcurrency sets the number of replicators concurrently applying fetched transactions.
+// While running, replicators are started or stopped accordingly. Replicators being stopped
+// complete the replication of the transaction they're processing, if any, before exiting
+func (txr *TxReplicator) SetCommitConcurrency(n int) error {
+	if n <= 0 {
+		return fmt.Errorf("%w: commit concurrency must be greater than zero", ErrIllegalArguments)
+	}
+
+	txr.replicatorsMutex.Lock()
+	defer txr.replicatorsMutex.Unlock()
+
+	txr.replicationConcurrency = n
+
+	if txr.replicatorQuits == nil {
+		// the new concurrency will be used once replication is started
+		return nil
+	}
+
+	for len(txr.replicatorQuits) < n {
+		txr.startReplicator()
 	}
 
-	txr.logger.Infof("Replication from '%s' to '%s' successfully initialized", txr._primaryDB, txr.db.GetName())
+	for len(txr.replicatorQuits) > n {
+		last := len(txr.replicatorQuits) - 1
+
+		close(txr.replicatorQuits[last])
+		txr.replicatorQuits = txr.replicatorQuits[:last]
+	}
 
 	return nil
 }
 
-func (txr *TxReplicator) replicateSingleTx(data []byte) bool {
+// CommitConcurrency returns the number of replicators concurrently applying fetched transactions
+func (txr *TxReplicator) CommitConcurrency() int {
+	txr.replicatorsMutex.Lock()
+	defer txr.replicatorsMutex.Unlock()
+
+	return txr.replicationConcurrency
+}
+
+// stopReplicators closes prefetchTxBuffer so replicators exit once it's drained
+func (txr *TxReplicator) stopReplicators() {
+	txr.replicatorsMutex.Lock()
+	defer txr.replicatorsMutex.Unlock()
+
+	close(txr.prefetchTxBuffer)
+	txr.replicatorQuits = nil
+}
+
+// replicateSingleTx applies an exported transaction to the replica, replication is retried
+// until it succeeds, the replicator is stopped or the max number of retries is exceeded
+func (txr *TxReplicator) replicateSingleTx(data []byte) error {
 	txr.metrics.replicatorsActive.Inc()
 	defer txr.metrics.replicatorsActive.Dec()
 	defer txr.metrics.replicationTimeHistogramTimer().ObserveDuration()
 
 	consecutiveFailures := 0
 
+	// delays depend on the failures of this transaction only, not on the ones of other replicators
+	var delayer Delayer
+
 	// replication must be retried as many times as necessary
 	for {
-		_, err := txr.db.ReplicateTx(txr.context, data, txr.skipIntegrityCheck, txr.waitForIndexing)
+		hdr, err := txr.replicateTx(data)
+		if err == nil && txr.opts.verifyAppliedHashes {
+			err := verifyAppliedTx(data, hdr)
+			if err != nil {
+				txr.logger.With("tx", hdr.Id).Errorf("Replica diverged from primary. Reason: %s", err.Error())
+				return err
+			}
+		}
 		if err == nil {
+			atomic.AddUint64(&txr.replicatedTxCount, 1)
+			txr.metrics.replicatedTxs.Inc()
+
+			if txr.opts.applyHook != nil {
+				txr.opts.applyHook(hdr.Id, data)
+			}
+
+			if !txr.db.IsSyncReplicationEnabled() {
+				txr.saveCheckpoint(hdr.Id)
+			} else {
+				err := txr.applyPendingCommitAllowance(hdr.Id)
+				if errors.Is(err, ErrReplicaDivergedFromPrimary) {
+					return err
+				}
+				if err != nil {
+					// the allowance is sent again by the primary along with the next fetched transaction
+					txr.primaryLogger().Warningf("Failed to apply pending commit allowance. Reason: %s", err.Error())
+				}
+			}
+
+			txr.updateReplicaTxID()
+
 			break // transaction successfully replicated
 		}
 		if errors.Is(err, ErrAlreadyStopped) {
-			return false
+			return err
 		}
 
-		if strings.Contains(err.Error(), "tx already committed") {
+		if errors.Is(err, database.ErrTxAlreadyCommitted) {
 			break // transaction successfully replicated
 		}
 
-		txr.logger.Infof("Failed to replicate transaction from '%s' to '%s'. Reason: %s", txr._primaryDB, txr.db.GetName(), err.Error())
+		txr.primaryLogger().Warningf("Failed to replicate transaction. Reason: %s", err.Error())
 
 		consecutiveFailures++
 
-		if !txr.replicationFailureDelay(consecutiveFailures) {
-			return false
+		if txr.opts.maxReplicationRetries > 0 && consecutiveFailures > txr.opts.maxReplicationRetries {
+			return fmt.Errorf("%w: %v", ErrMaxReplicationRetriesExceeded, err)
+		}
+
+		if delayer == nil {
+			delayer = newDelayerSequence(txr.delayer)
+		}
+
+		if !txr.replicationFailureDelay(delayer, consecutiveFailures) {
+			return ErrAlreadyStopped
 		}
 	}
 
-	return true
+	return nil
 }
 
-func (txr *TxReplicator) replicationFailureDelay(consecutiveFailures int) bool {
-	txr.metrics.replicationRetries.Inc()
+// replicateTx applies an exported transaction to the replica, its values are truncated
+// beforehand unless any of its entries is selected by the entry filter, when set
+func (txr *TxReplicator) replicateTx(etx []byte) (*schema.TxHeader, error) {
+	if txr.opts.entryFilter != nil {
+		var err error
 
-	txr.metrics.replicatorsInRetryDelay.Inc()
-	defer txr.metrics.replicatorsInRetryDelay.Dec()
+		etx, err = filterExportedTx(etx, txr.opts.entryFilter)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	timer := time.NewTimer(txr.delayer.DelayAfter(consecutiveFailures))
-	defer timer.Stop()
+	return txr.db.ReplicateTx(txr.context, etx, txr.skipIntegrityCheck, txr.waitForIndexing)
+}
 
-	select {
-	case <-txr.context.Done():
-		timer.Stop()
-		return false
-	case <-timer.C:
-		return true
+func filterExportedTx(etx []byte, entryFilter EntryFilter) ([]byte, error) {
+	keys, err := store.ExportedTxKeys(etx)
+	if err != nil {
+		return nil, err
 	}
-}
 
-func fullAddress(db, address string, port int) string {
-	return fmt.Sprintf("%s@%s:%d", db, address, port)
-}
+	if len(keys) == 0 {
+		// transactions only holding metadata have no values to be filtered
+		return etx, nil
+	}
 
-func (txr *TxReplicator) connect() error {
-	txr.logger.Infof("Connecting to '%s':'%d' for database '%s'...",
-		txr.opts.primaryHost,
-		txr.opts.primaryPort,
-		txr.db.GetName())
+	for _, key := range keys {
+		if entryFilter(key) {
+			return etx, nil
+		}
+	}
 
-	opts := client.DefaultOptions().
-		WithAddress(txr.opts.primaryHost).
-		WithPort(txr.opts.primaryPort).
-		WithDisableIdentityCheck(true)
+	return store.TruncateExportedTx(etx)
+}
 
-	txr.client = client.NewClient().WithOptions(opts)
+// verifyAppliedTx checks the header of a transaction applied to the replica matches
+// the header calculated by the primary, which is included in the exported transaction
+func verifyAppliedTx(etx []byte, appliedHdr *schema.TxHeader) error {
+	if len(etx) < 4 {
+		return fmt.Errorf("%w: invalid exported transaction", ErrIllegalArguments)
+	}
 
-	err := txr.client.OpenSession(
-		txr.context, []byte(txr.opts.primaryUsername), []byte(txr.opts.primaryPassword), txr.opts.primaryDatabase)
-	if err != nil {
-		return err
+	hdrLen := int(binary.BigEndian.Uint32(etx))
+
+	if len(etx) < 4+hdrLen {
+		return fmt.Errorf("%w: invalid exported transaction", ErrIllegalArguments)
 	}
 
-	txr.logger.Infof("Connection to '%s':'%d' for database '%s' successfully established",
-		txr.opts.primaryHost,
-		txr.opts.primaryPort,
-		txr.db.GetName())
+	var primaryHdr store.TxHeader
 
-	txr.exportTxStream, err = txr.client.StreamExportTx(txr.context)
+	err := primaryHdr.ReadFrom(etx[4 : 4+hdrLen])
 	if err != nil {
 		return err
 	}
 
-	txr.exportTxStreamReceiver = txr.streamSrvFactory.NewMsgReceiver(txr.exportTxStream)
+	replicaHdr := schema.TxHeaderFromProto(appliedHdr)
+
+	if replicaHdr.ID != primaryHdr.ID || replicaHdr.Alh() != primaryHdr.Alh() {
+		return fmt.Errorf("%w: tx %d", ErrAppliedTxHashMismatch, primaryHdr.ID)
+	}
 
 	return nil
 }
 
-func (txr *TxReplicator) disconnect() {
-	if txr.client == nil {
-		return
+// resumeFromCheckpoint returns the id of the transaction replication is resumed after. The stored checkpoint
+// is only honoured if the replica already holds the transaction, otherwise replication would skip transactions
+func (txr *TxReplicator) resumeFromCheckpoint(precommittedTxID uint64) uint64 {
+	if txr.opts.checkpointStore == nil {
+		return precommittedTxID
 	}
 
-	txr.logger.Infof("Disconnecting from '%s':'%d' for database '%s'...", txr.opts.primaryHost, txr.opts.primaryPort, txr.db.GetName())
+	checkpointTxID, ok, err := txr.opts.checkpointStore.LoadCheckpoint(txr.checkpointPrimaryDB(), txr.db.GetName())
+	if err != nil {
+		txr.logger.Warningf("Failed to load replication checkpoint. Reason: %s", err.Error())
+		return precommittedTxID
+	}
+	if !ok {
+		return precommittedTxID
+	}
 
-	if txr.exportTxStream != nil {
-		txr.exportTxStream.CloseSend()
-		txr.exportTxStream = nil
+	if checkpointTxID > precommittedTxID {
+		txr.logger.With("checkpoint_tx", checkpointTxID, "precommitted_tx", precommittedTxID).
+			Warning("Replication checkpoint is ahead of the replica, resuming from its precommit state")
+		return precommittedTxID
 	}
 
-	txr.client.CloseSession(txr.context)
-	txr.client = nil
+	txr.logger.With("checkpoint_tx", checkpointTxID).Info("Resuming replication from checkpoint")
 
-	txr.logger.Infof("Disconnected from '%s':'%d' for database '%s'", txr.opts.primaryHost, txr.opts.primaryPort, txr.db.GetName())
+	return checkpointTxID
 }
 
-func (txr *TxReplicator) fetchNextTx() error {
-	txr.mutex.Lock()
-	defer txr.mutex.Unlock()
-
-	if !txr.running {
-		return ErrAlreadyStopped
+// saveCheckpoint persists the id of an applied transaction, failures are logged
+// but don't stop replication as the checkpoint is saved again with the next transaction
+func (txr *TxReplicator) saveCheckpoint(txID uint64) {
+	if txr.opts.checkpointStore == nil {
+		return
 	}
 
-	if txr.exportTxStream == nil {
-		err := txr.connect()
-		if err != nil {
-			return err
-		}
+	txr.checkpointMutex.Lock()
+	defer txr.checkpointMutex.Unlock()
+
+	// transactions may be applied by concurrent replicators
+	if txID <= txr.checkpointTxID {
+		return
 	}
 
-	commitState, err := txr.db.CurrentState()
+	err := txr.opts.checkpointStore.SaveCheckpoint(txr.checkpointPrimaryDB(), txr.db.GetName(), txID)
 	if err != nil {
-		return err
+		txr.logger.With("tx", txID).Warningf("Failed to save replication checkpoint. Reason: %s", err.Error())
+		return
 	}
 
-	syncReplicationEnabled := txr.db.IsSyncReplicationEnabled()
+	txr.checkpointTxID = txID
+}
 
-	if txr.lastTx == 0 {
-		txr.lastTx = commitState.PrecommittedTxId
-	}
+// checkpointPrimaryDB identifies the primary database in the checkpoint store, the configured
+// endpoint is used so checkpoints are kept when connecting through a fallback endpoint
+func (txr *TxReplicator) checkpointPrimaryDB() string {
+	return fullAddress(txr.opts.primaryDatabase, txr.opts.primaryHost, txr.opts.primaryPort)
+}
 
-	nextTx := txr.lastTx + 1
+func (txr *TxReplicator) replicationFailureDelay(delayer Delayer, consecutiveFailures int) bool {
+	txr.metrics.replicationRetries.Inc()
 
-	var state *schema.ReplicaState
+	txr.metrics.replicatorsInRetryDelay.Inc()
+	defer txr.metrics.replicatorsInRetryDelay.Dec()
 
-	if syncReplicationEnabled {
-		state = &schema.ReplicaState{
-			UUID:             txr.uuid.String(),
-			CommittedTxID:    commitState.TxId,
-			CommittedAlh:     commitState.TxHash,
-			PrecommittedTxID: commitState.PrecommittedTxId,
-			PrecommittedAlh:  commitState.PrecommittedTxHash,
-		}
-	}
+	timer := time.NewTimer(delayer.DelayAfter(consecutiveFailures))
+	defer timer.Stop()
 
-	req := &schema.ExportTxRequest{
-		Tx:                 nextTx,
-		ReplicaState:       state,
-		AllowPreCommitted:  syncReplicationEnabled,
-		SkipIntegrityCheck: txr.skipIntegrityCheck,
+	select {
+	case <-txr.context.Done():
+		timer.Stop()
+		return false
+	case <-timer.C:
+		return true
 	}
-	if err != nil {
-		return err
+}
+
+// prefetchTx buffers an exported transaction to be applied by replicators,
+// it blocks while the buffer is full
+func (txr *TxReplicator) prefetchTx(etx []byte) {
+	txr.statsMutex.Lock()
+	txr.inflightBytes += len(etx)
+	prefetchTxBuffer := txr.prefetchTxBuffer
+	txr.statsMutex.Unlock()
+
+	prefetchTxBuffer <- prefetchTxEntry{
+		data:    etx,
+		addedAt: time.Now(),
 	}
+}
 
-	txr.exportTxStream.Send(req)
+// releasePrefetchedTx discounts a transaction taken from the buffer by a replicator. Transactions
+// taken from a buffer discarded when replication was restarted are no longer accounted
+func (txr *TxReplicator) releasePrefetchedTx(prefetchTxBuffer chan prefetchTxEntry, etx prefetchTxEntry) {
+	txr.statsMutex.Lock()
+	defer txr.statsMutex.Unlock()
 
-	etx, emd, err := txr.exportTxStreamReceiver.ReadFully()
-	if err != nil {
-		defer txr.disconnect()
+	if prefetchTxBuffer == txr.prefetchTxBuffer {
+		txr.inflightBytes -= len(etx.data)
 	}
+}
 
-	if err != nil && !errors.Is(err, io.EOF) {
-		if strings.Contains(err.Error(), "replica commit state diverged from primary") {
-			txr.logger.Errorf("replica commit state at '%s' diverged from primary's", txr.db.GetName())
-			return ErrReplicaDivergedFromPrimary
-		}
+// waitForPrefetchCapacity blocks until both the number and the total size of buffered transactions
+// are below their high watermarks or the replicator is stopped.
+// The state of the primary is periodically polled meanwhile, if required by the overflow policy
+func (txr *TxReplicator) waitForPrefetchCapacity(ctx context.Context) error {
+	if txr.hasPrefetchCapacity() {
+		return nil
+	}
 
-		if strings.Contains(err.Error(), "replica precommit state diverged from primary") {
-			if !txr.allowTxDiscarding {
-				txr.logger.Errorf("replica precommit state at '%s' diverged from primary's", txr.db.GetName())
-				return ErrReplicaDivergedFromPrimary
-			}
+	var poll <-chan time.Time
 
-			txr.logger.Infof("discarding precommit txs since %d from '%s'. Reason: %s", nextTx, txr.db.GetName(), err.Error())
+	if txr.opts.prefetchOverflowPolicy == PrefetchOverflowPollState {
+		ticker := time.NewTicker(txr.opts.prefetchOverflowPollInterval)
+		defer ticker.Stop()
 
-			err = txr.db.DiscardPrecommittedTxsSince(commitState.TxId + 1)
+		poll = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ErrAlreadyStopped
+		case <-txr.prefetchTxReleased:
+		case <-poll:
+			err := txr.pollPrimaryState(ctx)
 			if err != nil {
 				return err
 			}
+		}
 
-			txr.lastTx = commitState.TxId
-
-			txr.logger.Infof("precommit txs successfully discarded from '%s'", txr.db.GetName())
-
+		if txr.hasPrefetchCapacity() {
 			return nil
 		}
-
-		return err
 	}
+}
 
-	if syncReplicationEnabled {
-		bMayCommitUpToTxID, ok := emd["may-commit-up-to-txid-bin"]
-		if !ok {
-			return ErrNoSynchronousReplicationOnPrimary
-		}
+func (txr *TxReplicator) hasPrefetchCapacity() bool {
+	txr.statsMutex.RLock()
+	bufferLen := len(txr.prefetchTxBuffer)
+	inflightBytes := txr.inflightBytes
+	txr.statsMutex.RUnlock()
 
-		bmayCommitUpToAlh, ok := emd["may-commit-up-to-alh-bin"]
-		if !ok {
-			return ErrNoSynchronousReplicationOnPrimary
-		}
+	if txr.opts.maxInflightBytes > 0 && inflightBytes >= txr.opts.maxInflightBytes {
+		return false
+	}
 
-		bCommittedTxID, ok := emd["committed-txid-bin"]
-		if !ok {
-			return ErrNoSynchronousReplicationOnPrimary
-		}
+	return int64(bufferLen) < atomic.LoadInt64(&txr.prefetchHighWatermark)
+}
 
-		if len(bMayCommitUpToTxID) != 8 ||
-			len(bmayCommitUpToAlh) != sha256.Size ||
-			len(bCommittedTxID) != 8 {
-			return ErrInvalidReplicationMetadata
-		}
+// pollPrimaryState records the latest transaction committed on the primary without fetching any transaction,
+// nothing is polled while disconnected as the connection is re-established when fetching is resumed
+func (txr *TxReplicator) pollPrimaryState(ctx context.Context) error {
+	txr.mutex.Lock()
+	defer txr.mutex.Unlock()
+
+	if !txr.running || ctx.Err() != nil {
+		return ErrAlreadyStopped
+	}
+
+	if txr.client == nil {
+		return nil
+	}
+
+	state, err := txr.client.CurrentState(ctx)
+	if err != nil {
+		return err
+	}
+
+	txr.updateLag(state.TxId)
+
+	return nil
+}
+
+// UUID returns the identifier the replica reports to the primary
+func (txr *TxReplicator) UUID() xid.ID {
+	return txr.uuid
+}
+
+// Pause halts fetching and replication of transactions while keeping the session with
+// the primary open, so replication can be resumed without reconnecting.
+// Transactions being replicated when the replicator is paused are completed.
+func (txr *TxReplicator) Pause() error {
+	txr.statsMutex.Lock()
+	defer txr.statsMutex.Unlock()
+
+	if !txr.running {
+		return ErrAlreadyStopped
+	}
+
+	if txr.paused {
+		return ErrAlreadyPaused
+	}
+
+	txr.paused = true
+	txr.resumed = make(chan struct{})
+
+	txr.logger.Info("Replication paused")
+
+	return nil
+}
+
+// Resume continues fetching and replication of transactions after a call to Pause
+func (txr *TxReplicator) Resume() error {
+	txr.statsMutex.Lock()
+	defer txr.statsMutex.Unlock()
+
+	if !txr.paused {
+		return ErrNotPaused
+	}
+
+	txr.resume()
+
+	txr.logger.Info("Replication resumed")
+
+	return nil
+}
+
+// resume must be called while holding statsMutex
+func (txr *TxReplicator) resume() {
+	if !txr.paused {
+		return
+	}
+
+	txr.paused = false
+	close(txr.resumed)
+}
+
+// waitWhilePaused blocks until the replicator is resumed or the context is done
+func (txr *TxReplicator) waitWhilePaused(ctx context.Context) error {
+	txr.statsMutex.RLock()
+	paused, resumed := txr.paused, txr.resumed
+	txr.statsMutex.RUnlock()
+
+	if !paused {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ErrAlreadyStopped
+	case <-resumed:
+		return nil
+	}
+}
+
+// BufferStats returns the number of prefetched transactions waiting to be replicated
+// and the capacity of the prefetch buffer
+func (txr *TxReplicator) BufferStats() (length, capacity int) {
+	txr.statsMutex.RLock()
+	defer txr.statsMutex.RUnlock()
+
+	return len(txr.prefetchTxBuffer), cap(txr.prefetchTxBuffer)
+}
+
+// SetPrefetchHighWatermark sets the number of buffered transactions at which
+// fetching from the primary is paused until replicators catch up
+func (txr *TxReplicator) SetPrefetchHighWatermark(n int) error {
+	if n <= 0 || n > txr.opts.prefetchTxBufferSize {
+		return fmt.Errorf("%w: high watermark must be between 1 and %d", ErrIllegalArguments, txr.opts.prefetchTxBufferSize)
+	}
+
+	atomic.StoreInt64(&txr.prefetchHighWatermark, int64(n))
+
+	// wake up the fetcher in case it's waiting with a lower watermark
+	select {
+	case txr.prefetchTxReleased <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+func fullAddress(db, address string, port int) string {
+	return fmt.Sprintf("%s@%s:%d", db, address, port)
+}
+
+func (txr *TxReplicator) connect(ctx context.Context) error {
+	txr.credentialsMutex.Lock()
+	username, password := txr.primaryUsername, txr.primaryPassword
+	txr.credentialsUpdated = false
+	txr.credentialsMutex.Unlock()
+
+	err := txr.openSession(ctx, username, password)
+	if err != nil {
+		return err
+	}
+
+	defer txr.metrics.openStreamTimeHistogramTimer().ObserveDuration()
+
+	// streams are identified so the primary can limit the number of streams used by each replica
+	ctx = metadata.AppendToOutgoingContext(ctx, "replica-uuid", txr.uuid.String())
+
+	ctx, txr.cancelExportTxStreams = context.WithCancel(ctx)
+
+	exportStream, err := txr.openExportTxStream(ctx)
+	if err != nil {
+		// the connection is re-established from scratch in the next attempt
+		txr.disconnect()
+		return err
+	}
+
+	txr.statsMutex.Lock()
+	txr.exportTxStream = exportStream
+	txr.lastProgressAt = time.Now()
+	txr.statsMutex.Unlock()
+
+	txr.exportTxStreamReceiver = txr.streamSrvFactory.NewMsgReceiver(txr.exportTxStream)
+
+	if !txr.db.IsSyncReplicationEnabled() {
+		for i := 1; i < txr.opts.fetchConcurrency; i++ {
+			concurrentStream, err := txr.openExportTxStream(ctx)
+			if err != nil {
+				txr.disconnect()
+				return err
+			}
+
+			txr.concurrentExportTxStreams = append(txr.concurrentExportTxStreams, &exportTxStream{
+				stream:   concurrentStream,
+				receiver: txr.streamSrvFactory.NewMsgReceiver(concurrentStream),
+			})
+		}
+	}
+
+	return nil
+}
+
+// openExportTxStream opens a stream bound to ctx to export transactions from the primary. The context is
+// cancelled if the stream is not established within the dial timeout, as a stream can't outlive its context
+func (txr *TxReplicator) openExportTxStream(ctx context.Context) (schema.ImmuService_StreamExportTxClient, error) {
+	if txr.opts.dialTimeout == 0 {
+		return txr.client.StreamExportTx(ctx, txr.opts.exportTxCallOptions()...)
+	}
+
+	timer := time.AfterFunc(txr.opts.dialTimeout, txr.cancelExportTxStreams)
+
+	exportStream, err := txr.client.StreamExportTx(ctx, txr.opts.exportTxCallOptions()...)
+
+	if !timer.Stop() {
+		return nil, fmt.Errorf("%w: export stream not established within %s", context.DeadlineExceeded, txr.opts.dialTimeout)
+	}
+
+	return exportStream, err
+}
+
+func (txr *TxReplicator) openSession(ctx context.Context, username, password string) error {
+	defer txr.metrics.connectTimeHistogramTimer().ObserveDuration()
+
+	c, endpoint, err := openAnyPrimarySession(ctx, txr.opts, username, password, func(endpoint Endpoint, err error) {
+		txr.endpointLogger(endpoint).Warningf("Failed to connect. Reason: %s", err.Error())
+	})
+	if err != nil {
+		return err
+	}
+
+	txr.client = c
+
+	txr.statsMutex.Lock()
+	txr.endpoint = endpoint
+	txr._primaryDB = fullAddress(txr.opts.primaryDatabase, endpoint.Host, endpoint.Port)
+	txr.statsMutex.Unlock()
+
+	txr.endpointLogger(endpoint).Debug("Connection successfully established")
+
+	return nil
+}
+
+// openAnyPrimarySession opens a session with the primary database through the first of its endpoints it can be
+// opened with, endpoints are attempted in order. onFailure, when not nil, is called after each failed attempt,
+// the error of the last one is returned when the session can't be opened through any endpoint
+func openAnyPrimarySession(
+	ctx context.Context,
+	opts *Options,
+	username, password string,
+	onFailure func(endpoint Endpoint, err error),
+) (c client.ImmuClient, endpoint Endpoint, err error) {
+	for _, endpoint = range opts.primaryEndpoints() {
+		c, err = openPrimarySession(ctx, opts, endpoint, username, password)
+		if err == nil {
+			return c, endpoint, nil
+		}
+
+		if onFailure != nil {
+			onFailure(endpoint, err)
+		}
+	}
+
+	return nil, endpoint, err
+}
+
+// openPrimarySession opens a session with the primary database reachable through the endpoint,
+// the session is closed right away if the primary does not support the replication protocol
+func openPrimarySession(ctx context.Context, opts *Options, endpoint Endpoint, username, password string) (client.ImmuClient, error) {
+	clientOpts := client.DefaultOptions().
+		WithAddress(endpoint.Host).
+		WithPort(endpoint.Port).
+		WithDisableIdentityCheck(true)
+
+	dialOptions, err := opts.dialOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	clientOpts.WithDialOptions(dialOptions)
+
+	c, err := opts.clientFactory(clientOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.dialTimeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, opts.dialTimeout)
+		defer cancel()
+	}
+
+	err = c.OpenSession(ctx, []byte(username), []byte(password), opts.primaryDatabase)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkPrimaryCompatibility(ctx, c)
+	if err != nil {
+		c.CloseSession(context.Background())
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// checkPrimaryCompatibility ensures the primary supports the replication protocol. Primaries reporting
+// a version which can not be parsed, such as development builds, are considered to be compatible
+func checkPrimaryCompatibility(ctx context.Context, c client.ImmuClient) error {
+	info, err := c.ServerInfo(ctx, &schema.ServerInfoRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		// server info is provided since long before the replication protocol was introduced
+		return fmt.Errorf("%w: primary version is older than %s", ErrIncompatiblePrimary, MinPrimaryVersion)
+	}
+	if err != nil {
+		return err
+	}
+
+	primaryVersion, ok := parseVersion(info.Version)
+	if !ok {
+		return nil
+	}
+
+	minVersion, _ := parseVersion(MinPrimaryVersion)
+
+	for i := range primaryVersion {
+		if primaryVersion[i] > minVersion[i] {
+			return nil
+		}
+
+		if primaryVersion[i] < minVersion[i] {
+			return fmt.Errorf("%w: primary version is %s while at least %s is required", ErrIncompatiblePrimary, info.Version, MinPrimaryVersion)
+		}
+	}
+
+	return nil
+}
+
+// parseVersion returns the major, minor and patch numbers of a version such as v1.5.0 or 1.5.0-RC1,
+// pre-release and build suffixes are ignored
+func parseVersion(version string) (v [3]int, ok bool) {
+	version = strings.TrimPrefix(version, "v")
+
+	if i := strings.IndexAny(version, "-+"); i >= 0 {
+		version = version[:i]
+	}
+
+	parts := strings.Split(version, ".")
+	if len(parts) != len(v) {
+		return v, false
+	}
+
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return v, false
+		}
+
+		v[i] = n
+	}
+
+	return v, true
+}
+
+// UpdateCredentials sets the credentials used to authenticate against the primary.
+// An in-progress fetch is not interrupted, the replicator reconnects using the new
+// credentials before fetching the next transaction
+func (txr *TxReplicator) UpdateCredentials(username, password string) {
+	txr.credentialsMutex.Lock()
+	defer txr.credentialsMutex.Unlock()
+
+	txr.primaryUsername = username
+	txr.primaryPassword = password
+	txr.credentialsUpdated = true
+}
+
+func (txr *TxReplicator) hasUpdatedCredentials() bool {
+	txr.credentialsMutex.Lock()
+	defer txr.credentialsMutex.Unlock()
+
+	return txr.credentialsUpdated
+}
+
+func (txr *TxReplicator) disconnect() {
+	if txr.client == nil {
+		return
+	}
+
+	txr.endpointLogger(txr.endpoint).Debug("Disconnecting...")
+
+	if txr.exportTxStream != nil {
+		txr.exportTxStream.CloseSend()
+
+		txr.statsMutex.Lock()
+		txr.exportTxStream = nil
+		txr.statsMutex.Unlock()
+	}
+
+	for _, s := range txr.concurrentExportTxStreams {
+		s.stream.CloseSend()
+	}
+	txr.concurrentExportTxStreams = nil
+
+	if txr.cancelExportTxStreams != nil {
+		txr.cancelExportTxStreams()
+		txr.cancelExportTxStreams = nil
+	}
+
+	txr.client.CloseSession(txr.context)
+	txr.client = nil
+
+	txr.endpointLogger(txr.endpoint).Debug("Disconnected")
+}
+
+func (txr *TxReplicator) fetchNextTx(ctx context.Context) error {
+	txr.mutex.Lock()
+	defer txr.mutex.Unlock()
+
+	// the context is checked as well so to prevent a fetcher from a previous run
+	// from resuming after the replicator has been restarted
+	if !txr.running || ctx.Err() != nil {
+		return ErrAlreadyStopped
+	}
+
+	if txr.exportTxStream != nil && txr.hasUpdatedCredentials() {
+		txr.logger.With("primary", txr._primaryDB).Info("Reconnecting with updated credentials")
+		txr.disconnect()
+	}
+
+	if txr.exportTxStream != nil && txr.isIdle() {
+		txr.logger.With("primary", txr._primaryDB).
+			Infof("Reconnecting as no progress was made for %s", txr.opts.idleReconnectTimeout)
+		txr.disconnect()
+	}
+
+	if txr.exportTxStream == nil {
+		err := txr.connect(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	commitState, err := txr.db.CurrentState()
+	if err != nil {
+		return err
+	}
+
+	syncReplicationEnabled := txr.db.IsSyncReplicationEnabled()
+
+	if txr.lastTx == 0 {
+		txr.lastTx = commitState.PrecommittedTxId
+
+		if !syncReplicationEnabled {
+			txr.lastTx = txr.resumeFromCheckpoint(commitState.PrecommittedTxId)
+		}
+	}
+
+	nextTx := txr.lastTx + 1
+
+	if !syncReplicationEnabled && len(txr.concurrentExportTxStreams) > 0 {
+		return txr.fetchNextTxsConcurrently(ctx, nextTx)
+	}
+
+	var state *schema.ReplicaState
+
+	if syncReplicationEnabled {
+		state = &schema.ReplicaState{
+			UUID:             txr.uuid.String(),
+			CommittedTxID:    commitState.TxId,
+			CommittedAlh:     commitState.TxHash,
+			PrecommittedTxID: commitState.PrecommittedTxId,
+			PrecommittedAlh:  commitState.PrecommittedTxHash,
+		}
+	}
+
+	req := &schema.ExportTxRequest{
+		Tx:                 nextTx,
+		ReplicaState:       state,
+		AllowPreCommitted:  syncReplicationEnabled,
+		SkipIntegrityCheck: txr.skipIntegrityCheck,
+		ChunkSize:          uint32(atomic.LoadInt64(&txr.chunkSize)),
+	}
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+
+	txr.exportTxStream.Send(req)
+
+	etx, emd, err := txr.exportTxStreamReceiver.ReadFully()
+	if err != nil {
+		defer txr.disconnect()
+	}
+
+	txr.observeExportedTx(nextTx, etx, time.Since(start))
+
+	if err != nil && !errors.Is(err, io.EOF) {
+		if isRemoteError(err, database.ErrReplicaCommitStateDiverged) {
+			txr.logger.Error("replica commit state diverged from primary's")
+			return ErrReplicaDivergedFromPrimary
+		}
+
+		if isRemoteError(err, database.ErrReplicaPrecommitStateDiverged) {
+			if !txr.allowTxDiscarding {
+				txr.logger.Error("replica precommit state diverged from primary's")
+				return ErrReplicaDivergedFromPrimary
+			}
+
+			txr.logger.With("since_tx", nextTx).Infof("discarding precommit txs. Reason: %s", err.Error())
+
+			err = txr.db.DiscardPrecommittedTxsSince(commitState.TxId + 1)
+			if err != nil {
+				return err
+			}
+
+			txr.lastTx = commitState.TxId
+
+			txr.logger.Info("precommit txs successfully discarded")
+
+			return nil
+		}
+
+		return err
+	}
+
+	var primaryTxID uint64
+
+	if syncReplicationEnabled {
+		md, err := parseSyncReplicationMetadata(emd)
+		if err != nil {
+			return err
+		}
+
+		txr.metrics.allowCommitUpToTxID.Set(float64(md.mayCommitUpToTxID))
+
+		primaryTxID = md.committedTxID
+
+		if md.mayCommitUpToTxID > commitState.TxId {
+			err = txr.allowCommitUpTo(md.mayCommitUpToTxID, md.mayCommitUpToAlh)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(etx) > 0 {
+		// the transaction is counted as fetched before buffering, which may block while the buffer is full,
+		// so the commit state of the primary is tracked regardless of it
+		txr.lastTx++
+	}
+
+	if !syncReplicationEnabled {
+		// without sync replication the primary does not report its commit state along with exported transactions.
+		// It's only requested after fetching a transaction, so polls of a caught up replica do not issue it
+		primaryTxID = txr.lastTx
+
+		if len(etx) > 0 {
+			primaryTxID, err = txr.lastPrimaryTxID(ctx, txr.lastTx+1)
+			if err != nil {
+				// it's not a replication error, the primary is known to have committed the fetched transactions
+				// and the connection is re-established when fetching the next one if needed
+				txr.primaryLogger().Debugf("Failed to get the state of the primary. Reason: %s", err.Error())
+				primaryTxID = txr.lastTx
+			}
+		}
+	}
+
+	txr.updateLag(primaryTxID)
+
+	if len(etx) > 0 {
+		txr.adaptChunkSize(len(etx))
+
+		// in some cases the transaction is not provided but only the primary commit state
+		txr.prefetchTx(etx)
+	}
+
+	return nil
+}
+
+// lastPrimaryTxID returns the last transaction known to be committed on the primary. The current state of the
+// primary is only requested when it's not already known to have committed the given transaction, so while
+// the replica is catching up a single request is made for all the transactions committed in the meantime
+func (txr *TxReplicator) lastPrimaryTxID(ctx context.Context, txID uint64) (uint64, error) {
+	txr.statsMutex.RLock()
+	primaryTxID := txr.primaryTxID
+	txr.statsMutex.RUnlock()
+
+	if primaryTxID >= txID {
+		return primaryTxID, nil
+	}
+
+	state, err := txr.client.CurrentState(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return state.TxId, nil
+}
+
+// allowCommitUpTo lets the replica commit up to the given transaction if it was already applied,
+// otherwise the allowance is kept pending until a replicator applies the transaction
+func (txr *TxReplicator) allowCommitUpTo(txID uint64, alh [sha256.Size]byte) error {
+	txr.pendingCommitMutex.Lock()
+	defer txr.pendingCommitMutex.Unlock()
+
+	// the state is read while holding the lock, so a replicator concurrently applying the
+	// transaction is either seen as done here or it finds the allowance pending afterwards
+	state, err := txr.db.CurrentState()
+	if err != nil {
+		return err
+	}
+
+	if state.PrecommittedTxId < txID {
+		if txID > txr.pendingCommitTxID {
+			txr.pendingCommitTxID = txID
+			txr.pendingCommitAlh = alh
+		}
+
+		return nil
+	}
+
+	if txr.pendingCommitTxID <= txID {
+		txr.pendingCommitTxID = 0
+	}
+
+	return txr.allowCommitUpToAppliedTx(txID, alh)
+}
+
+// applyPendingCommitAllowance passes the pending commit allowance to the replica once the
+// transaction it refers to was applied, given the replica precommits transactions in order
+func (txr *TxReplicator) applyPendingCommitAllowance(appliedTxID uint64) error {
+	txr.pendingCommitMutex.Lock()
+	defer txr.pendingCommitMutex.Unlock()
+
+	if txr.pendingCommitTxID == 0 || txr.pendingCommitTxID > appliedTxID {
+		return nil
+	}
+
+	txID := txr.pendingCommitTxID
+	txr.pendingCommitTxID = 0
+
+	return txr.allowCommitUpToAppliedTx(txID, txr.pendingCommitAlh)
+}
+
+// allowCommitUpToAppliedTx must be called while holding pendingCommitMutex
+func (txr *TxReplicator) allowCommitUpToAppliedTx(txID uint64, alh [sha256.Size]byte) error {
+	err := txr.db.AllowCommitUpto(txID, alh)
+	if errors.Is(err, database.ErrReplicaCommitStateDiverged) {
+		txr.logger.Error("replica commit state diverged from primary's")
+		return ErrReplicaDivergedFromPrimary
+	}
+
+	return err
+}
+
+// syncReplicationMetadata is the commit state sent by the primary along with exported transactions
+// when synchronous replication is enabled
+type syncReplicationMetadata struct {
+	mayCommitUpToTxID uint64
+	mayCommitUpToAlh  [sha256.Size]byte
+	committedTxID     uint64
+}
+
+// parseSyncReplicationMetadata decodes the commit state sent by the primary. ErrNoSynchronousReplicationOnPrimary
+// is returned when no metadata is sent at all, while incomplete or malformed metadata, i.e. sent by an
+// incompatible version of the primary, results in ErrInvalidReplicationMetadata
+func parseSyncReplicationMetadata(emd map[string][]byte) (*syncReplicationMetadata, error) {
+	expectedLengths := []struct {
+		key string
+		len int
+	}{
+		{key: "may-commit-up-to-txid-bin", len: 8},
+		{key: "may-commit-up-to-alh-bin", len: sha256.Size},
+		{key: "committed-txid-bin", len: 8},
+	}
+
+	missing := 0
+
+	for _, e := range expectedLengths {
+		if _, ok := emd[e.key]; !ok {
+			missing++
+		}
+	}
+
+	if missing == len(expectedLengths) {
+		return nil, ErrNoSynchronousReplicationOnPrimary
+	}
+
+	for _, e := range expectedLengths {
+		v, ok := emd[e.key]
+		if !ok {
+			return nil, fmt.Errorf("%w: '%s' is missing", ErrInvalidReplicationMetadata, e.key)
+		}
+
+		if len(v) != e.len {
+			return nil, fmt.Errorf("%w: '%s' is expected to be %d bytes long but it's %d bytes long",
+				ErrInvalidReplicationMetadata, e.key, e.len, len(v))
+		}
+	}
 
-		mayCommitUpToTxID := binary.BigEndian.Uint64(bMayCommitUpToTxID)
-		committedTxID := binary.BigEndian.Uint64(bCommittedTxID)
+	md := &syncReplicationMetadata{
+		mayCommitUpToTxID: binary.BigEndian.Uint64(emd["may-commit-up-to-txid-bin"]),
+		committedTxID:     binary.BigEndian.Uint64(emd["committed-txid-bin"]),
+	}
 
-		var mayCommitUpToAlh [sha256.Size]byte
-		copy(mayCommitUpToAlh[:], bmayCommitUpToAlh)
+	copy(md.mayCommitUpToAlh[:], emd["may-commit-up-to-alh-bin"])
 
-		txr.metrics.primaryCommittedTxID.Set(float64(committedTxID))
-		txr.metrics.allowCommitUpToTxID.Set(float64(mayCommitUpToTxID))
+	return md, nil
+}
 
-		if mayCommitUpToTxID > commitState.TxId {
-			err = txr.db.AllowCommitUpto(mayCommitUpToTxID, mayCommitUpToAlh)
-			if err != nil {
-				if strings.Contains(err.Error(), "replica commit state diverged from") {
-					txr.logger.Errorf("replica commit state at '%s' diverged from primary's", txr.db.GetName())
-					return ErrReplicaDivergedFromPrimary
-				}
+// observeExportedTx records the time spent waiting for and reading a transaction from the primary,
+// the size of the transaction is recorded as well unless the primary had no transaction to export
+func (txr *TxReplicator) observeExportedTx(tx uint64, etx []byte, elapsed time.Duration) {
+	txr.metrics.exportTxTimeHistogram.Observe(elapsed.Seconds())
 
-				return err
+	if len(etx) == 0 {
+		return
+	}
+
+	txr.metrics.exportTxSizeHistogram.Observe(float64(len(etx)))
+
+	txr.logger.With("tx", tx, "size", len(etx), "elapsed", elapsed).Debug("Transaction exported by the primary")
+}
+
+// chunkSizeAdaptationWeight is the weight given to the size of each exported transaction
+// in the moving average used to adapt the chunk size
+const chunkSizeAdaptationWeight = 0.1
+
+// adaptChunkSize is a no-op unless auto chunk size mode is enabled. In such case, it updates the moving average
+// of the size of exported transactions and sets the chunk size so an average transaction is sent in a single chunk
+func (txr *TxReplicator) adaptChunkSize(txSize int) {
+	if !txr.opts.autoChunkSize {
+		return
+	}
+
+	if txr.avgTxSize == 0 {
+		txr.avgTxSize = float64(txSize)
+	} else {
+		txr.avgTxSize += chunkSizeAdaptationWeight * (float64(txSize) - txr.avgTxSize)
+	}
+
+	// the first chunk includes the size of the transaction
+	requiredSize := int(txr.avgTxSize) + 8
+
+	chunkSize := stream.MinChunkSize
+	for chunkSize < requiredSize && chunkSize < stream.MaxChunkSize {
+		chunkSize *= 2
+	}
+
+	atomic.StoreInt64(&txr.chunkSize, int64(chunkSize))
+}
+
+// fetchNextTxsConcurrently fetches consecutive transactions starting from nextTx using
+// multiple streams. Only the transactions contiguous to the last fetched one are buffered,
+// the rest will be fetched again in subsequent iterations.
+func (txr *TxReplicator) fetchNextTxsConcurrently(ctx context.Context, nextTx uint64) error {
+	streams := append(
+		[]*exportTxStream{{stream: txr.exportTxStream, receiver: txr.exportTxStreamReceiver}},
+		txr.concurrentExportTxStreams...,
+	)
+
+	// streams only request transactions already committed on the primary, otherwise the ones fetched by
+	// the rest of the streams would not be buffered until the primary commits the requested transaction.
+	// The first stream always requests the next transaction, waiting for it to be committed if needed
+	lastTxID := nextTx + uint64(len(streams)) - 1
+
+	primaryTxID, err := txr.lastPrimaryTxID(ctx, lastTxID)
+	if err != nil {
+		return err
+	}
+
+	if primaryTxID < lastTxID {
+		n := 1
+
+		if primaryTxID > nextTx {
+			n = int(primaryTxID-nextTx) + 1
+		}
+
+		streams = streams[:n]
+	}
+
+	etxs := make([][]byte, len(streams))
+	errs := make([]error, len(streams))
+
+	chunkSize := uint32(atomic.LoadInt64(&txr.chunkSize))
+
+	var wg sync.WaitGroup
+
+	for i, s := range streams {
+		wg.Add(1)
+
+		go func(i int, s *exportTxStream) {
+			defer wg.Done()
+
+			errs[i] = s.stream.Send(&schema.ExportTxRequest{
+				Tx:                 nextTx + uint64(i),
+				SkipIntegrityCheck: txr.skipIntegrityCheck,
+				ChunkSize:          chunkSize,
+			})
+			if errs[i] != nil {
+				return
 			}
+
+			start := time.Now()
+
+			etxs[i], _, errs[i] = s.receiver.ReadFully()
+
+			txr.observeExportedTx(nextTx+uint64(i), etxs[i], time.Since(start))
+		}(i, s)
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			// streams will be re-established in the next iteration
+			defer txr.disconnect()
+			break
 		}
 	}
 
-	if len(etx) > 0 {
-		// in some cases the transaction is not provided but only the primary commit state
-		txr.prefetchTxBuffer <- prefetchTxEntry{
-			data:    etx,
-			addedAt: time.Now(),
+	for _, err := range errs {
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+	}
+
+	for i, etx := range etxs {
+		if len(etx) == 0 || errs[i] != nil {
+			break
 		}
+
+		txr.adaptChunkSize(len(etx))
+
+		txr.prefetchTx(etx)
 		txr.lastTx++
 	}
 
+	if txr.lastTx > primaryTxID {
+		// the primary committed the transaction the first stream was waiting for
+		primaryTxID = txr.lastTx
+	}
+
+	txr.updateLag(primaryTxID)
+
 	return nil
 }
 
+// updateLag records the latest known primary state, even empty exports are
+// considered as a successful synchronization as they confirm liveness
+func (txr *TxReplicator) updateLag(primaryTxID uint64) {
+	txr.statsMutex.Lock()
+
+	if primaryTxID > txr.primaryTxID || txr.lastTx > txr.fetchedTxID {
+		txr.lastProgressAt = time.Now()
+	}
+
+	if primaryTxID > txr.primaryTxID {
+		txr.primaryTxID = primaryTxID
+	}
+
+	txr.fetchedTxID = txr.lastTx
+	txr.lastSyncedAt = time.Now()
+
+	txr.metrics.primaryCommittedTxID.Set(float64(txr.primaryTxID))
+	txr.metrics.lastSyncedAt.Set(float64(txr.lastSyncedAt.Unix()))
+
+	txr.statsMutex.Unlock()
+
+	txr.updateReplicaTxID()
+}
+
+// updateReplicaTxID records the last transaction committed on the replica
+func (txr *TxReplicator) updateReplicaTxID() {
+	state, err := txr.db.CurrentState()
+	if err != nil {
+		return
+	}
+
+	txr.statsMutex.Lock()
+	defer txr.statsMutex.Unlock()
+
+	txr.replicaTxID = state.TxId
+
+	txr.metrics.lastTxID.Set(float64(txr.replicaTxID))
+}
+
+// isIdle returns true when the idle reconnect timeout is enabled and elapsed since the last progress
+func (txr *TxReplicator) isIdle() bool {
+	if txr.opts.idleReconnectTimeout == 0 {
+		return false
+	}
+
+	txr.statsMutex.RLock()
+	defer txr.statsMutex.RUnlock()
+
+	return time.Since(txr.lastProgressAt) >= txr.opts.idleReconnectTimeout
+}
+
+// Lag returns the most recent transaction known to be committed on the primary,
+// the last transaction committed on the replica and the last time the replica
+// successfully synchronized with the primary.
+// It's safe to call it while the replicator is running.
+func (txr *TxReplicator) Lag() (primaryTxID, replicaTxID uint64, lastSyncedAt time.Time) {
+	txr.updateReplicaTxID()
+
+	txr.statsMutex.RLock()
+	defer txr.statsMutex.RUnlock()
+
+	return txr.primaryTxID, txr.replicaTxID, txr.lastSyncedAt
+}
+
 func (txr *TxReplicator) Stop() error {
 	if txr.cancelFunc != nil {
 		txr.cancelFunc()
@@ -470,15 +1689,365 @@ func (txr *TxReplicator) Stop() error {
 		return ErrAlreadyStopped
 	}
 
-	txr.logger.Infof("Stopping replication of database '%s'...", txr.db.GetName())
+	txr.logger.Info("Stopping replication...")
 
-	close(txr.prefetchTxBuffer)
+	txr.stopReplicators()
+
+	txr.disconnect()
+
+	txr.setRunning(false)
+
+	txr.logger.Info("Replication successfully stopped")
+
+	return nil
+}
+
+// StopGraceful stops fetching transactions from the primary but lets replicators
+// apply the already fetched ones before stopping. If the context is done before
+// the buffer is drained, in-flight replication is aborted.
+func (txr *TxReplicator) StopGraceful(ctx context.Context) error {
+	if txr.fetchCancelFunc != nil {
+		txr.fetchCancelFunc()
+	}
+
+	txr.mutex.Lock()
+
+	if !txr.running {
+		txr.mutex.Unlock()
+		return ErrAlreadyStopped
+	}
+
+	txr.logger.Info("Gracefully stopping replication...")
+
+	// replicators will exit once all buffered transactions are replicated
+	txr.stopReplicators()
 
 	txr.disconnect()
 
-	txr.running = false
+	txr.setRunning(false)
+
+	txr.mutex.Unlock()
+
+	replicatorsDone := make(chan struct{})
+
+	go func() {
+		txr.replicatorsWg.Wait()
+		close(replicatorsDone)
+	}()
+
+	defer txr.cancelFunc()
+
+	select {
+	case <-ctx.Done():
+		txr.logger.Warning("Replication stopped before buffered transactions were replicated")
+		return ctx.Err()
+	case <-replicatorsDone:
+	}
+
+	txr.logger.Info("Replication successfully stopped")
+
+	return nil
+}
+
+// CatchUpTo synchronously replicates transactions from the primary until targetTxID is committed
+// in the replica or the context is done. No background fetching nor replication is started,
+// thus it can only be called while the replicator is stopped. Transactions not yet committed
+// on the primary are requested again following the configured delays.
+func (txr *TxReplicator) CatchUpTo(ctx context.Context, targetTxID uint64) error {
+	txr.mutex.Lock()
+	defer txr.mutex.Unlock()
+
+	if txr.running {
+		return ErrAlreadyRunning
+	}
+
+	if txr.db.IsSyncReplicationEnabled() {
+		return ErrSyncReplicationNotSupported
+	}
+
+	txr.logger.With("primary", txr._primaryDB, "target_tx", targetTxID).Info("Catching up replication...")
+
+	txr.context, txr.cancelFunc = context.WithCancel(ctx)
+	defer txr.cancelFunc()
+	defer txr.disconnect()
+
+	var delayer Delayer
+	emptyExports := 0
+
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		commitState, err := txr.db.CurrentState()
+		if err != nil {
+			return err
+		}
+
+		if commitState.TxId >= targetTxID {
+			break
+		}
+
+		nextTx := commitState.TxId + 1
+
+		etx, err := txr.exportTx(ctx, nextTx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		if len(etx) == 0 {
+			// the transaction is not yet committed on the primary or the stream was closed,
+			// in both cases it's requested again after a delay growing while it keeps happening
+			emptyExports++
+
+			if delayer == nil {
+				delayer = newDelayerSequence(txr.delayer)
+			}
+
+			timer := time.NewTimer(delayer.DelayAfter(emptyExports))
+
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+
+			continue
+		}
+
+		emptyExports = 0
+
+		txr.adaptChunkSize(len(etx))
+
+		err = txr.replicateSingleTx(etx)
+		if errors.Is(err, ErrAlreadyStopped) {
+			return ctx.Err()
+		}
+		if err != nil {
+			return err
+		}
+
+		txr.lastTx = nextTx
+		txr.updateLag(nextTx)
+	}
+
+	txr.logger.With("primary", txr._primaryDB, "target_tx", targetTxID).Info("Replication caught up")
+
+	return nil
+}
+
+// exportTx fetches a single transaction from the primary, connecting to it if needed
+func (txr *TxReplicator) exportTx(ctx context.Context, tx uint64) ([]byte, error) {
+	if txr.exportTxStream == nil {
+		err := txr.connect(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+
+	err := txr.exportTxStream.Send(&schema.ExportTxRequest{
+		Tx:                 tx,
+		SkipIntegrityCheck: txr.skipIntegrityCheck,
+		ChunkSize:          uint32(atomic.LoadInt64(&txr.chunkSize)),
+	})
+	if err != nil {
+		txr.disconnect()
+		return nil, err
+	}
+
+	etx, _, err := txr.exportTxStreamReceiver.ReadFully()
+	if err != nil {
+		txr.disconnect()
+		return nil, err
+	}
+
+	txr.observeExportedTx(tx, etx, time.Since(start))
+
+	return etx, nil
+}
+
+// Resync recovers a replica whose precommitted transactions diverged from the primary.
+// Precommitted transactions are discarded down to the current commit state and
+// replication is resumed from that point. It can be called while the replicator is stopped.
+func (txr *TxReplicator) Resync(ctx context.Context) error {
+	if !txr.allowTxDiscarding {
+		return ErrTxDiscardingNotAllowed
+	}
+
+	err := txr.Stop()
+	if err != nil && !errors.Is(err, ErrAlreadyStopped) {
+		return err
+	}
+
+	// wait for in-flight transactions to be completed before discarding
+	replicatorsDone := make(chan struct{})
+
+	go func() {
+		txr.replicatorsWg.Wait()
+		close(replicatorsDone)
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-replicatorsDone:
+	}
+
+	txr.mutex.Lock()
+
+	commitState, err := txr.db.CurrentState()
+	if err != nil {
+		txr.mutex.Unlock()
+		return err
+	}
+
+	if commitState.PrecommittedTxId > commitState.TxId {
+		txr.logger.With("since_tx", commitState.TxId+1).Info("discarding precommit txs")
+
+		err = txr.db.DiscardPrecommittedTxsSince(commitState.TxId + 1)
+		if err != nil {
+			txr.mutex.Unlock()
+			return err
+		}
+	}
+
+	txr.reset()
+
+	txr.mutex.Unlock()
+
+	return txr.Start()
+}
+
+// Reset clears the failure and divergence state of the replicator, and makes the next
+// start resume replication from the current state of the replica instead of the last
+// fetched transaction. It can only be called while the replicator is stopped.
+func (txr *TxReplicator) Reset() error {
+	txr.mutex.Lock()
+	defer txr.mutex.Unlock()
+
+	if txr.running {
+		return ErrAlreadyRunning
+	}
 
-	txr.logger.Infof("Replication of database '%s' successfully stopped", txr.db.GetName())
+	txr.reset()
 
 	return nil
 }
+
+// reset must be called while holding mutex
+func (txr *TxReplicator) reset() {
+	// next fetch will resume from the current commit state
+	txr.lastTx = 0
+	txr.setConsecutiveFailures(0)
+
+	txr.checkpointMutex.Lock()
+	txr.checkpointTxID = 0
+	txr.checkpointMutex.Unlock()
+
+	txr.setDiverged(false)
+}
+
+// handleSuccess resets the count of consecutive failures, the recovery handler
+// is invoked without holding any lock so it may safely call into the replicator
+func (txr *TxReplicator) handleSuccess() {
+	txr.mutex.Lock()
+	failingSince := txr.failingSince
+	txr.setConsecutiveFailures(0)
+	txr.mutex.Unlock()
+
+	if failingSince.IsZero() || txr.opts.recoveryHandler == nil {
+		return
+	}
+
+	txr.opts.recoveryHandler(txr.db.GetName(), time.Since(failingSince))
+}
+
+func (txr *TxReplicator) setRunning(running bool) {
+	txr.statsMutex.Lock()
+	defer txr.statsMutex.Unlock()
+
+	txr.running = running
+
+	// a stopped replicator is no longer paused, so buffered transactions can be drained
+	if !running {
+		txr.resume()
+	}
+}
+
+func (txr *TxReplicator) setConsecutiveFailures(consecutiveFailures int) {
+	txr.statsMutex.Lock()
+	defer txr.statsMutex.Unlock()
+
+	if consecutiveFailures == 0 {
+		txr.failingSince = time.Time{}
+	} else if txr.consecutiveFailures == 0 {
+		txr.failingSince = time.Now()
+	}
+
+	txr.consecutiveFailures = consecutiveFailures
+}
+
+func (txr *TxReplicator) setDiverged(diverged bool) {
+	txr.statsMutex.Lock()
+	defer txr.statsMutex.Unlock()
+
+	txr.diverged = diverged
+}
+
+// ReplicatedTxCount returns the number of transactions successfully replicated since the replicator was created
+func (txr *TxReplicator) ReplicatedTxCount() uint64 {
+	return atomic.LoadUint64(&txr.replicatedTxCount)
+}
+
+// ReplicationRate returns the number of transactions replicated per second
+// between two samples of ReplicatedTxCount taken elapsed time apart
+func ReplicationRate(prevCount, count uint64, elapsed time.Duration) float64 {
+	if elapsed <= 0 || count < prevCount {
+		return 0
+	}
+
+	return float64(count-prevCount) / elapsed.Seconds()
+}
+
+// primaryLogger returns a logger attaching the primary database currently or lastly connected to
+func (txr *TxReplicator) primaryLogger() *logger.FieldLogger {
+	return txr.logger.With("primary", txr.primaryDB())
+}
+
+func (txr *TxReplicator) endpointLogger(endpoint Endpoint) *logger.FieldLogger {
+	return txr.logger.With("host", endpoint.Host, "port", endpoint.Port)
+}
+
+// primaryDB returns the primary database along with the endpoint currently or lastly connected to
+func (txr *TxReplicator) primaryDB() string {
+	txr.statsMutex.RLock()
+	defer txr.statsMutex.RUnlock()
+
+	return txr._primaryDB
+}
+
+// Status returns the current state of the replicator.
+// It's safe to call it while the replicator is running.
+func (txr *TxReplicator) Status() *ReplicatorStatus {
+	txr.statsMutex.RLock()
+	defer txr.statsMutex.RUnlock()
+
+	return &ReplicatorStatus{
+		Running:             txr.running,
+		Paused:              txr.paused,
+		Connected:           txr.exportTxStream != nil,
+		Diverged:            txr.diverged,
+		PrimaryDB:           txr._primaryDB,
+		LastTx:              txr.replicaTxID,
+		ConsecutiveFailures: txr.consecutiveFailures,
+		FailingSince:        txr.failingSince,
+		SyncReplication:     txr.db.IsSyncReplicationEnabled(),
+		ChunkSize:           int(atomic.LoadInt64(&txr.chunkSize)),
+		InflightBytes:       txr.inflightBytes,
+	}
+}
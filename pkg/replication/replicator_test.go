@@ -17,13 +17,30 @@ limitations under the License.
 package replication
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/logger"
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client"
 	"github.com/codenotary/immudb/pkg/database"
+	"github.com/codenotary/immudb/pkg/stream"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/rs/xid"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestReplication(t *testing.T) {
@@ -45,18 +62,915 @@ func TestReplication(t *testing.T) {
 	db, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(path), logger)
 	require.NoError(t, err)
 
-	txReplicator, err := NewTxReplicator(xid.New(), db, rOpts, logger)
+	uuid := xid.New()
+
+	txReplicator, err := NewTxReplicator(uuid, db, rOpts, logger)
+	require.NoError(t, err)
+
+	require.Equal(t, uuid, txReplicator.UUID())
+	require.Zero(t, txReplicator.ReplicatedTxCount())
+
+	primaryTxID, replicaTxID, lastSyncedAt := txReplicator.Lag()
+	require.Zero(t, primaryTxID)
+	require.Zero(t, replicaTxID)
+	require.True(t, lastSyncedAt.IsZero())
+
+	bufferLen, bufferCap := txReplicator.BufferStats()
+	require.Zero(t, bufferLen)
+	require.Equal(t, DefaultPrefetchTxBufferSize, bufferCap)
+
+	err = txReplicator.SetPrefetchHighWatermark(0)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = txReplicator.SetPrefetchHighWatermark(DefaultPrefetchTxBufferSize + 1)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = txReplicator.SetPrefetchHighWatermark(DefaultPrefetchTxBufferSize / 2)
 	require.NoError(t, err)
 
 	err = txReplicator.Stop()
 	require.ErrorIs(t, err, ErrAlreadyStopped)
 
+	status := txReplicator.Status()
+	require.False(t, status.Running)
+	require.False(t, status.Connected)
+	require.Equal(t, "defaultdb@127.0.0.1:3322", status.PrimaryDB)
+	require.False(t, status.SyncReplication)
+	require.Equal(t, DefaultChunkSize, status.ChunkSize)
+
 	err = txReplicator.Start()
 	require.NoError(t, err)
 
+	require.True(t, txReplicator.Status().Running)
+
 	err = txReplicator.Start()
 	require.ErrorIs(t, err, ErrAlreadyRunning)
 
 	err = txReplicator.Stop()
 	require.NoError(t, err)
+
+	err = txReplicator.StopGraceful(context.Background())
+	require.ErrorIs(t, err, ErrAlreadyStopped)
+
+	err = txReplicator.Start()
+	require.NoError(t, err)
+
+	err = txReplicator.StopGraceful(context.Background())
+	require.NoError(t, err)
+}
+
+func TestReplicationReplicaUUID(t *testing.T) {
+	replicaUUID := xid.New()
+
+	rOpts := DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(3322).
+		WithReplicaUUID(replicaUUID.String())
+
+	logger := logger.NewSimpleLogger("logger", os.Stdout)
+
+	db, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer db.Close()
+
+	uuid := xid.New()
+
+	txReplicator, err := NewTxReplicator(uuid, db, DefaultOptions(), logger)
+	require.NoError(t, err)
+	require.Equal(t, uuid, txReplicator.UUID())
+
+	// the configured identifier takes precedence over the provided one
+	txReplicator, err = NewTxReplicator(uuid, db, rOpts, logger)
+	require.NoError(t, err)
+	require.Equal(t, replicaUUID, txReplicator.UUID())
+
+	_, err = NewTxReplicator(xid.New(), db, rOpts.WithReplicaUUID("invalid"), logger)
+	require.ErrorIs(t, err, ErrInvalidOptions)
+}
+
+func TestReplicationClientFactory(t *testing.T) {
+	errFactory := errors.New("simulated factory failure")
+
+	var endpoints []Endpoint
+
+	rOpts := DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(3322).
+		WithPrimaryFallbackEndpoints(Endpoint{Host: "127.0.0.2", Port: 3323}).
+		WithClientFactory(func(opts *client.Options) (client.ImmuClient, error) {
+			endpoints = append(endpoints, Endpoint{Host: opts.Address, Port: opts.Port})
+			return nil, errFactory
+		})
+
+	logger := logger.NewSimpleLogger("logger", os.Stdout)
+
+	db, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer db.Close()
+
+	txReplicator, err := NewTxReplicator(xid.New(), db, rOpts, logger)
+	require.NoError(t, err)
+
+	err = txReplicator.connect(context.Background())
+	require.ErrorIs(t, err, errFactory)
+	require.Equal(t, rOpts.primaryEndpoints(), endpoints)
+
+	_, err = NewTxReplicator(xid.New(), db, rOpts.WithClientFactory(nil), logger)
+	require.ErrorIs(t, err, ErrInvalidOptions)
+}
+
+type serverInfoClient struct {
+	client.ImmuClient
+
+	version       string
+	err           error
+	sessionClosed bool
+}
+
+func (c *serverInfoClient) OpenSession(ctx context.Context, user []byte, pass []byte, database string) error {
+	return nil
+}
+
+func (c *serverInfoClient) CloseSession(ctx context.Context) error {
+	c.sessionClosed = true
+	return nil
+}
+
+func (c *serverInfoClient) ServerInfo(ctx context.Context, req *schema.ServerInfoRequest) (*schema.ServerInfoResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &schema.ServerInfoResponse{Version: c.version}, nil
+}
+
+// blockingStreamClient never establishes export streams until their context is done
+type blockingStreamClient struct {
+	serverInfoClient
+}
+
+func (c *blockingStreamClient) StreamExportTx(ctx context.Context, opts ...grpc.CallOption) (schema.ImmuService_StreamExportTxClient, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestReplicationStreamDialTimeout(t *testing.T) {
+	c := &blockingStreamClient{}
+
+	rOpts := DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(3322).
+		WithDialTimeout(10 * time.Millisecond).
+		WithClientFactory(func(opts *client.Options) (client.ImmuClient, error) {
+			return c, nil
+		})
+
+	logger := logger.NewSimpleLogger("logger", os.Stdout)
+
+	db, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer db.Close()
+
+	txReplicator, err := NewTxReplicator(xid.New(), db, rOpts, logger)
+	require.NoError(t, err)
+
+	err = txReplicator.connect(context.Background())
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.True(t, c.sessionClosed)
+	require.Nil(t, txReplicator.client)
+}
+
+func TestReplicationIncompatiblePrimary(t *testing.T) {
+	logger := logger.NewSimpleLogger("logger", os.Stdout)
+
+	db, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer db.Close()
+
+	connectTo := func(c *serverInfoClient) error {
+		rOpts := DefaultOptions().
+			WithPrimaryDatabase("defaultdb").
+			WithPrimaryHost("127.0.0.1").
+			WithPrimaryPort(3322).
+			WithClientFactory(func(opts *client.Options) (client.ImmuClient, error) {
+				return c, nil
+			})
+
+		txReplicator, err := NewTxReplicator(xid.New(), db, rOpts, logger)
+		require.NoError(t, err)
+
+		return txReplicator.connect(context.Background())
+	}
+
+	t.Run("primary without server info", func(t *testing.T) {
+		c := &serverInfoClient{err: status.Error(codes.Unimplemented, "unknown method ServerInfo")}
+
+		err := connectTo(c)
+		require.ErrorIs(t, err, ErrIncompatiblePrimary)
+		require.True(t, c.sessionClosed)
+	})
+
+	t.Run("primary with an older version", func(t *testing.T) {
+		c := &serverInfoClient{version: "v1.4.1"}
+
+		err := connectTo(c)
+		require.ErrorIs(t, err, ErrIncompatiblePrimary)
+		require.True(t, c.sessionClosed)
+	})
+
+	t.Run("server info failure", func(t *testing.T) {
+		errServerInfo := status.Error(codes.Unavailable, "simulated failure")
+		c := &serverInfoClient{err: errServerInfo}
+
+		err := connectTo(c)
+		require.ErrorIs(t, err, errServerInfo)
+		require.NotErrorIs(t, err, ErrIncompatiblePrimary)
+	})
+
+	for _, version := range []string{"", "1.5.0", "v1.5.0-RC1", "1.9.2", "v2.0.0", "dev"} {
+		t.Run("compatible primary version "+version, func(t *testing.T) {
+			err := checkPrimaryCompatibility(context.Background(), &serverInfoClient{version: version})
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("incompatible primary stops replication", func(t *testing.T) {
+		txReplicator, err := NewTxReplicator(xid.New(), db, DefaultOptions(), logger)
+		require.NoError(t, err)
+
+		terminate := txReplicator.handleError(context.Background(), fmt.Errorf("%w: simulated", ErrIncompatiblePrimary))
+		require.True(t, terminate)
+	})
+}
+
+func TestParseVersion(t *testing.T) {
+	for _, c := range []struct {
+		version  string
+		expected [3]int
+		ok       bool
+	}{
+		{"1.5.0", [3]int{1, 5, 0}, true},
+		{"v1.9.2", [3]int{1, 9, 2}, true},
+		{"v1.5.0-RC1", [3]int{1, 5, 0}, true},
+		{"1.5.0+build.1", [3]int{1, 5, 0}, true},
+		{"", [3]int{}, false},
+		{"1.5", [3]int{}, false},
+		{"1.x.0", [3]int{}, false},
+		{"1.5.0.1", [3]int{}, false},
+	} {
+		v, ok := parseVersion(c.version)
+		require.Equal(t, c.ok, ok, c.version)
+		if ok {
+			require.Equal(t, c.expected, v, c.version)
+		}
+	}
+}
+
+func TestReplicationResync(t *testing.T) {
+	path := t.TempDir()
+
+	logger := logger.NewSimpleLogger("logger", os.Stdout)
+
+	db, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(path), logger)
+	require.NoError(t, err)
+
+	rOpts := DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(3322).
+		WithPrimaryUsername("immudb").
+		WithPrimaryPassword("immudb")
+
+	txReplicator, err := NewTxReplicator(xid.New(), db, rOpts, logger)
+	require.NoError(t, err)
+
+	err = txReplicator.Resync(context.Background())
+	require.ErrorIs(t, err, ErrTxDiscardingNotAllowed)
+
+	txReplicator, err = NewTxReplicator(xid.New(), db, rOpts.WithAllowTxDiscarding(true), logger)
+	require.NoError(t, err)
+
+	err = txReplicator.Resync(context.Background())
+	require.NoError(t, err)
+
+	err = txReplicator.Resync(context.Background())
+	require.NoError(t, err)
+
+	err = txReplicator.Stop()
+	require.NoError(t, err)
+}
+
+func TestIsSessionLost(t *testing.T) {
+	require.True(t, isSessionLost(status.Error(codes.Unauthenticated, "Please login")))
+	require.True(t, isSessionLost(errors.New("no session found")))
+	require.True(t, isSessionLost(status.Error(codes.Unknown, "session not found")))
+
+	require.False(t, isSessionLost(errors.New("connection refused")))
+	require.False(t, isSessionLost(status.Error(codes.Unavailable, "transport is closing")))
+}
+
+func TestReplicationRate(t *testing.T) {
+	require.Equal(t, 50.0, ReplicationRate(100, 200, 2*time.Second))
+	require.Zero(t, ReplicationRate(100, 100, time.Second))
+	require.Zero(t, ReplicationRate(100, 200, 0))
+	require.Zero(t, ReplicationRate(200, 100, time.Second))
+}
+
+func TestAdaptChunkSize(t *testing.T) {
+	logger := logger.NewSimpleLogger("logger", os.Stdout)
+
+	db, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer db.Close()
+
+	t.Run("fixed chunk size", func(t *testing.T) {
+		txReplicator, err := NewTxReplicator(xid.New(), db, DefaultOptions(), logger)
+		require.NoError(t, err)
+
+		txReplicator.adaptChunkSize(1024 * 1024)
+		require.Equal(t, DefaultChunkSize, txReplicator.Status().ChunkSize)
+	})
+
+	t.Run("auto chunk size", func(t *testing.T) {
+		txReplicator, err := NewTxReplicator(xid.New(), db, DefaultOptions().WithAutoChunkSize(true), logger)
+		require.NoError(t, err)
+		require.Equal(t, DefaultChunkSize, txReplicator.Status().ChunkSize)
+
+		txReplicator.adaptChunkSize(100)
+		require.Equal(t, stream.MinChunkSize, txReplicator.Status().ChunkSize)
+
+		for i := 0; i < 100; i++ {
+			txReplicator.adaptChunkSize(100_000)
+		}
+		require.Equal(t, 128*1024, txReplicator.Status().ChunkSize)
+
+		for i := 0; i < 100; i++ {
+			txReplicator.adaptChunkSize(10 * stream.MaxChunkSize)
+		}
+		require.Equal(t, stream.MaxChunkSize, txReplicator.Status().ChunkSize)
+	})
+}
+
+func TestIsRemoteError(t *testing.T) {
+	require.True(t, isRemoteError(database.ErrReplicaCommitStateDiverged, database.ErrReplicaCommitStateDiverged))
+	require.True(t, isRemoteError(status.Error(codes.Unknown, database.ErrReplicaPrecommitStateDiverged.Error()), database.ErrReplicaPrecommitStateDiverged))
+
+	require.False(t, isRemoteError(database.ErrReplicaCommitStateDiverged, database.ErrReplicaPrecommitStateDiverged))
+	require.False(t, isRemoteError(status.Error(codes.Unavailable, "transport is closing"), database.ErrReplicaCommitStateDiverged))
+}
+
+func TestParseSyncReplicationMetadata(t *testing.T) {
+	_, err := parseSyncReplicationMetadata(nil)
+	require.ErrorIs(t, err, ErrNoSynchronousReplicationOnPrimary)
+
+	var alh [sha256.Size]byte
+	alh[0] = 1
+
+	emd := map[string][]byte{
+		"may-commit-up-to-txid-bin": {0, 0, 0, 0, 0, 0, 0, 2},
+		"may-commit-up-to-alh-bin":  alh[:],
+		"committed-txid-bin":        {0, 0, 0, 0, 0, 0, 0, 1},
+	}
+
+	md, err := parseSyncReplicationMetadata(emd)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), md.mayCommitUpToTxID)
+	require.Equal(t, alh, md.mayCommitUpToAlh)
+	require.Equal(t, uint64(1), md.committedTxID)
+
+	emd["committed-txid-bin"] = []byte{1}
+
+	_, err = parseSyncReplicationMetadata(emd)
+	require.ErrorIs(t, err, ErrInvalidReplicationMetadata)
+	require.Contains(t, err.Error(), "committed-txid-bin")
+
+	delete(emd, "may-commit-up-to-alh-bin")
+
+	_, err = parseSyncReplicationMetadata(emd)
+	require.ErrorIs(t, err, ErrInvalidReplicationMetadata)
+	require.Contains(t, err.Error(), "may-commit-up-to-alh-bin")
+}
+
+func TestObserveExportedTx(t *testing.T) {
+	rOpts := DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(3322)
+
+	logger := logger.NewSimpleLogger("logger", os.Stdout)
+
+	db, err := database.NewDB("replicated_observed_db", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer db.Close()
+
+	txReplicator, err := NewTxReplicator(xid.New(), db, rOpts, logger)
+	require.NoError(t, err)
+
+	sampleCount := func(h *prometheus.HistogramVec) uint64 {
+		var m dto.Metric
+
+		err := h.WithLabelValues("replicated_observed_db").(prometheus.Histogram).Write(&m)
+		require.NoError(t, err)
+
+		return m.GetHistogram().GetSampleCount()
+	}
+
+	timeSamples := sampleCount(_metricsExportTxTimeHistogram)
+	sizeSamples := sampleCount(_metricsExportTxSizeHistogram)
+
+	// empty exports are timed but they have no size
+	txReplicator.observeExportedTx(1, nil, time.Millisecond)
+	require.Equal(t, timeSamples+1, sampleCount(_metricsExportTxTimeHistogram))
+	require.Equal(t, sizeSamples, sampleCount(_metricsExportTxSizeHistogram))
+
+	txReplicator.observeExportedTx(1, make([]byte, 1024), time.Millisecond)
+	require.Equal(t, timeSamples+2, sampleCount(_metricsExportTxTimeHistogram))
+	require.Equal(t, sizeSamples+1, sampleCount(_metricsExportTxSizeHistogram))
+}
+
+func TestReplicationCommitConcurrency(t *testing.T) {
+	rOpts := DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(3322).
+		WithReplicationCommitConcurrency(2)
+
+	logger := logger.NewSimpleLogger("logger", os.Stdout)
+
+	db, err := database.NewDB("replicated_concurrency_db", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer db.Close()
+
+	txReplicator, err := NewTxReplicator(xid.New(), db, rOpts, logger)
+	require.NoError(t, err)
+
+	runningReplicators := func() int {
+		return int(testutil.ToFloat64(txReplicator.metrics.replicators))
+	}
+
+	err = txReplicator.SetCommitConcurrency(0)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = txReplicator.Start()
+	require.NoError(t, err)
+
+	require.Equal(t, 2, txReplicator.CommitConcurrency())
+	require.Eventually(t, func() bool { return runningReplicators() == 2 }, 10*time.Second, 10*time.Millisecond)
+
+	err = txReplicator.SetCommitConcurrency(5)
+	require.NoError(t, err)
+	require.Equal(t, 5, txReplicator.CommitConcurrency())
+	require.Eventually(t, func() bool { return runningReplicators() == 5 }, 10*time.Second, 10*time.Millisecond)
+
+	err = txReplicator.SetCommitConcurrency(1)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return runningReplicators() == 1 }, 10*time.Second, 10*time.Millisecond)
+
+	err = txReplicator.Stop()
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return runningReplicators() == 0 }, 10*time.Second, 10*time.Millisecond)
+
+	// the concurrency set while stopped is used once replication is started
+	err = txReplicator.SetCommitConcurrency(3)
+	require.NoError(t, err)
+	require.Zero(t, runningReplicators())
+
+	err = txReplicator.Start()
+	require.NoError(t, err)
+	defer txReplicator.Stop()
+
+	require.Eventually(t, func() bool { return runningReplicators() == 3 }, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestVerifyAppliedTx(t *testing.T) {
+	hdr := &store.TxHeader{
+		ID:       2,
+		Ts:       time.Now().Unix(),
+		Version:  1,
+		NEntries: 1,
+		BlTxID:   1,
+	}
+	hdr.PrevAlh[0] = 1
+	hdr.Eh[0] = 2
+	hdr.BlRoot[0] = 3
+
+	bs, err := hdr.Bytes()
+	require.NoError(t, err)
+
+	etx := make([]byte, 4+len(bs))
+	binary.BigEndian.PutUint32(etx, uint32(len(bs)))
+	copy(etx[4:], bs)
+
+	err = verifyAppliedTx(etx, schema.TxHeaderToProto(hdr))
+	require.NoError(t, err)
+
+	err = verifyAppliedTx(etx[:2], schema.TxHeaderToProto(hdr))
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = verifyAppliedTx(etx[:len(etx)-1], schema.TxHeaderToProto(hdr))
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	divergedHdr := *hdr
+	divergedHdr.Eh[0] = 4
+
+	err = verifyAppliedTx(etx, schema.TxHeaderToProto(&divergedHdr))
+	require.ErrorIs(t, err, ErrAppliedTxHashMismatch)
+	require.ErrorIs(t, err, ErrReplicaDivergedFromPrimary)
+}
+
+func TestReplicationRecoveryHandler(t *testing.T) {
+	var recoveredDBs []string
+	var downtimes []time.Duration
+
+	rOpts := DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(3322).
+		WithDelayer(NewConstantDelayer(time.Millisecond)).
+		WithRecoveryHandler(func(db string, downtime time.Duration) {
+			recoveredDBs = append(recoveredDBs, db)
+			downtimes = append(downtimes, downtime)
+		})
+
+	logger := logger.NewSimpleLogger("logger", os.Stdout)
+
+	db, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer db.Close()
+
+	txReplicator, err := NewTxReplicator(xid.New(), db, rOpts, logger)
+	require.NoError(t, err)
+
+	// successes without previous failures are not recoveries
+	terminate := txReplicator.handleError(context.Background(), nil)
+	require.False(t, terminate)
+	require.Empty(t, recoveredDBs)
+
+	for i := 0; i < 3; i++ {
+		terminate := txReplicator.handleError(context.Background(), errors.New("simulated failure"))
+		require.False(t, terminate)
+	}
+
+	terminate = txReplicator.handleError(context.Background(), nil)
+	require.False(t, terminate)
+	require.Equal(t, []string{"replicated_defaultdb"}, recoveredDBs)
+	require.GreaterOrEqual(t, downtimes[0], 3*time.Millisecond)
+	require.Zero(t, txReplicator.Status().ConsecutiveFailures)
+
+	terminate = txReplicator.handleError(context.Background(), nil)
+	require.False(t, terminate)
+	require.Len(t, recoveredDBs, 1)
+}
+
+type stateClient struct {
+	client.ImmuClient
+	txID uint64
+}
+
+func (c *stateClient) CurrentState(ctx context.Context) (*schema.ImmutableState, error) {
+	return &schema.ImmutableState{TxId: c.txID}, nil
+}
+
+func TestPrefetchOverflowPollState(t *testing.T) {
+	rOpts := DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(3322).
+		WithPrefetchTxBufferSize(1).
+		WithPrefetchOverflowPolicy(PrefetchOverflowPollState).
+		WithPrefetchOverflowPollInterval(time.Millisecond)
+
+	logger := logger.NewSimpleLogger("logger", os.Stdout)
+
+	db, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer db.Close()
+
+	txReplicator, err := NewTxReplicator(xid.New(), db, rOpts, logger)
+	require.NoError(t, err)
+
+	txReplicator.setRunning(true)
+	txReplicator.client = &stateClient{txID: 42}
+
+	// capacity is available without polling the primary
+	err = txReplicator.waitForPrefetchCapacity(context.Background())
+	require.NoError(t, err)
+
+	primaryTxID, _, _ := txReplicator.Lag()
+	require.Zero(t, primaryTxID)
+
+	txReplicator.prefetchTxBuffer <- prefetchTxEntry{data: []byte{1}, addedAt: time.Now()}
+
+	waitErr := make(chan error)
+
+	go func() {
+		waitErr <- txReplicator.waitForPrefetchCapacity(context.Background())
+	}()
+
+	require.Eventually(t, func() bool {
+		primaryTxID, _, _ := txReplicator.Lag()
+		return primaryTxID == 42
+	}, 5*time.Second, time.Millisecond)
+
+	// the buffer is still full
+	select {
+	case err := <-waitErr:
+		require.FailNow(t, "unexpected capacity", err)
+	default:
+	}
+
+	<-txReplicator.prefetchTxBuffer
+	txReplicator.prefetchTxReleased <- struct{}{}
+
+	require.NoError(t, <-waitErr)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	txReplicator.prefetchTxBuffer <- prefetchTxEntry{data: []byte{1}, addedAt: time.Now()}
+	cancel()
+
+	err = txReplicator.waitForPrefetchCapacity(ctx)
+	require.ErrorIs(t, err, ErrAlreadyStopped)
+}
+
+func TestMaxInflightBytes(t *testing.T) {
+	rOpts := DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(3322).
+		WithPrefetchTxBufferSize(10).
+		WithMaxInflightBytes(100)
+
+	logger := logger.NewSimpleLogger("logger", os.Stdout)
+
+	db, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer db.Close()
+
+	txReplicator, err := NewTxReplicator(xid.New(), db, rOpts, logger)
+	require.NoError(t, err)
+
+	prefetchTxBuffer := txReplicator.prefetchTxBuffer
+
+	// a transaction bigger than the limit is accepted into an empty buffer
+	txReplicator.prefetchTx(make([]byte, 150))
+	require.Equal(t, 150, txReplicator.Status().InflightBytes)
+	require.False(t, txReplicator.hasPrefetchCapacity())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = txReplicator.waitForPrefetchCapacity(ctx)
+	require.ErrorIs(t, err, ErrAlreadyStopped)
+
+	txReplicator.releasePrefetchedTx(prefetchTxBuffer, <-prefetchTxBuffer)
+	require.Zero(t, txReplicator.Status().InflightBytes)
+	require.True(t, txReplicator.hasPrefetchCapacity())
+
+	txReplicator.prefetchTx(make([]byte, 60))
+	require.True(t, txReplicator.hasPrefetchCapacity())
+
+	txReplicator.prefetchTx(make([]byte, 40))
+	require.Equal(t, 100, txReplicator.Status().InflightBytes)
+	require.False(t, txReplicator.hasPrefetchCapacity())
+
+	txReplicator.releasePrefetchedTx(prefetchTxBuffer, <-prefetchTxBuffer)
+	require.Equal(t, 40, txReplicator.Status().InflightBytes)
+	require.True(t, txReplicator.hasPrefetchCapacity())
+
+	// transactions taken from a discarded buffer are not accounted
+	txReplicator.prefetchTxBuffer = make(chan prefetchTxEntry, 10)
+	txReplicator.inflightBytes = 0
+
+	txReplicator.releasePrefetchedTx(prefetchTxBuffer, <-prefetchTxBuffer)
+	require.Zero(t, txReplicator.Status().InflightBytes)
+}
+
+func TestReplicationEntryFilter(t *testing.T) {
+	logger := logger.NewSimpleLogger("logger", os.Stdout)
+
+	primaryDB, err := database.NewDB("defaultdb", nil, database.DefaultOption().WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer primaryDB.Close()
+
+	for _, key := range []string{"tenant1/key", "tenant2/key"} {
+		_, err = primaryDB.Set(context.Background(), &schema.SetRequest{
+			KVs: []*schema.KeyValue{{Key: []byte(key), Value: []byte("value")}},
+		})
+		require.NoError(t, err)
+	}
+
+	replicaDB, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer replicaDB.Close()
+
+	rOpts := DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(3322).
+		WithEntryFilter(func(key []byte) bool {
+			return bytes.HasPrefix(key, database.WrapWithPrefix([]byte("tenant1/"), database.SetKeyPrefix))
+		})
+
+	txReplicator, err := NewTxReplicator(xid.New(), replicaDB, rOpts, logger)
+	require.NoError(t, err)
+
+	txReplicator.context = context.Background()
+
+	primaryState, err := primaryDB.CurrentState()
+	require.NoError(t, err)
+
+	for txID := uint64(1); txID <= primaryState.TxId; txID++ {
+		etx, _, _, err := primaryDB.ExportTxByID(context.Background(), &schema.ExportTxRequest{Tx: txID})
+		require.NoError(t, err)
+
+		hdr, err := txReplicator.replicateTx(etx)
+		require.NoError(t, err)
+
+		err = verifyAppliedTx(etx, hdr)
+		require.NoError(t, err)
+	}
+
+	replicaState, err := replicaDB.CurrentState()
+	require.NoError(t, err)
+	require.Equal(t, primaryState.TxId, replicaState.TxId)
+	require.Equal(t, primaryState.TxHash, replicaState.TxHash)
+
+	entry, err := replicaDB.Get(context.Background(), &schema.KeyRequest{Key: []byte("tenant1/key"), SinceTx: primaryState.TxId})
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), entry.Value)
+
+	// the key is replicated but not its value
+	_, err = replicaDB.Get(context.Background(), &schema.KeyRequest{Key: []byte("tenant2/key"), SinceTx: primaryState.TxId})
+	require.Error(t, err)
+	require.NotErrorIs(t, err, store.ErrKeyNotFound)
+
+	_, err = filterExportedTx([]byte{1}, rOpts.entryFilter)
+	require.ErrorIs(t, err, store.ErrIllegalArguments)
+}
+
+func TestReplicationReset(t *testing.T) {
+	rOpts := DefaultOptions().
+		WithPrimaryDatabase("defaultdb").
+		WithPrimaryHost("127.0.0.1").
+		WithPrimaryPort(3322).
+		WithDelayer(&expBackoff{retryMinDelay: time.Millisecond, retryMaxDelay: time.Millisecond, retryDelayExp: 1})
+
+	logger := logger.NewSimpleLogger("logger", os.Stdout)
+
+	db, err := database.NewDB("replicated_defaultdb", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer db.Close()
+
+	txReplicator, err := NewTxReplicator(xid.New(), db, rOpts, logger)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		terminate := txReplicator.handleError(context.Background(), errors.New("simulated failure"))
+		require.False(t, terminate)
+	}
+
+	txReplicator.lastTx = 10
+	txReplicator.setDiverged(true)
+
+	status := txReplicator.Status()
+	require.Equal(t, 3, status.ConsecutiveFailures)
+	require.False(t, status.FailingSince.IsZero())
+	require.True(t, status.Diverged)
+
+	err = txReplicator.Reset()
+	require.NoError(t, err)
+
+	status = txReplicator.Status()
+	require.Zero(t, status.ConsecutiveFailures)
+	require.True(t, status.FailingSince.IsZero())
+	require.False(t, status.Diverged)
+	require.Zero(t, txReplicator.lastTx)
+
+	err = txReplicator.Start()
+	require.NoError(t, err)
+
+	err = txReplicator.Reset()
+	require.ErrorIs(t, err, ErrAlreadyRunning)
+
+	err = txReplicator.Stop()
+	require.NoError(t, err)
+}
+
+func TestSyncReplicationCommitAllowedOnceApplied(t *testing.T) {
+	logger := logger.NewSimpleLogger("logger", os.Stdout)
+
+	primary, err := database.NewDB("primarydb", nil, database.DefaultOption().WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer primary.Close()
+
+	for i := 0; i < 3; i++ {
+		_, err = primary.Set(context.Background(), &schema.SetRequest{
+			KVs: []*schema.KeyValue{{Key: []byte(fmt.Sprintf("key%d", i)), Value: []byte("value")}},
+		})
+		require.NoError(t, err)
+	}
+
+	primaryState, err := primary.CurrentState()
+	require.NoError(t, err)
+
+	exportTx := func(txID uint64) []byte {
+		etx, _, _, err := primary.ExportTxByID(context.Background(), &schema.ExportTxRequest{Tx: txID})
+		require.NoError(t, err)
+		return etx
+	}
+
+	replica, err := database.NewDB("replicadb", nil, database.DefaultOption().AsReplica(true).WithSyncReplication(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer replica.Close()
+
+	txReplicator, err := NewTxReplicator(xid.New(), replica, DefaultOptions(), logger)
+	require.NoError(t, err)
+
+	txReplicator.context = context.Background()
+
+	lastTxID := primaryState.TxId
+
+	// the allowance is received before the replica applied the transaction
+	var primaryAlh [sha256.Size]byte
+	copy(primaryAlh[:], primaryState.TxHash)
+
+	err = txReplicator.allowCommitUpTo(lastTxID, primaryAlh)
+	require.NoError(t, err)
+	require.Equal(t, lastTxID, txReplicator.pendingCommitTxID)
+
+	for txID := uint64(1); txID < lastTxID; txID++ {
+		err = txReplicator.replicateSingleTx(exportTx(txID))
+		require.NoError(t, err)
+
+		replicaState, err := replica.CurrentState()
+		require.NoError(t, err)
+		require.Zero(t, replicaState.TxId)
+		require.Equal(t, txID, replicaState.PrecommittedTxId)
+	}
+
+	err = txReplicator.replicateSingleTx(exportTx(lastTxID))
+	require.NoError(t, err)
+	require.Zero(t, txReplicator.pendingCommitTxID)
+
+	require.Eventually(t, func() bool {
+		replicaState, err := replica.CurrentState()
+		require.NoError(t, err)
+		return replicaState.TxId == lastTxID
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// a pending allowance not matching the applied transaction is detected once it's applied
+	_, err = primary.Set(context.Background(), &schema.SetRequest{
+		KVs: []*schema.KeyValue{{Key: []byte("key"), Value: []byte("value")}},
+	})
+	require.NoError(t, err)
+
+	err = txReplicator.allowCommitUpTo(lastTxID+1, sha256.Sum256([]byte("diverged")))
+	require.NoError(t, err)
+
+	err = txReplicator.replicateSingleTx(exportTx(lastTxID + 1))
+	require.ErrorIs(t, err, ErrReplicaDivergedFromPrimary)
+}
+
+func TestReplicationIdleDetection(t *testing.T) {
+	logger := logger.NewSimpleLogger("logger", os.Stdout)
+
+	db, err := database.NewDB("replicated_idle_db", nil, database.DefaultOption().AsReplica(true).WithDBRootPath(t.TempDir()), logger)
+	require.NoError(t, err)
+	defer db.Close()
+
+	t.Run("idle detection should be disabled by default", func(t *testing.T) {
+		txReplicator, err := NewTxReplicator(xid.New(), db, DefaultOptions(), logger)
+		require.NoError(t, err)
+
+		require.False(t, txReplicator.isIdle())
+	})
+
+	t.Run("replicator should be idle when no progress is made for the timeout", func(t *testing.T) {
+		txReplicator, err := NewTxReplicator(xid.New(), db, DefaultOptions().WithIdleReconnectTimeout(time.Minute), logger)
+		require.NoError(t, err)
+
+		txReplicator.lastProgressAt = time.Now()
+		require.False(t, txReplicator.isIdle())
+
+		txReplicator.lastProgressAt = time.Now().Add(-2 * time.Minute)
+		require.True(t, txReplicator.isIdle())
+
+		// empty exports confirm liveness but are not considered progress
+		txReplicator.updateLag(0)
+		require.True(t, txReplicator.isIdle())
+
+		// an advance of the commit state of the primary is progress
+		txReplicator.updateLag(1)
+		require.False(t, txReplicator.isIdle())
+
+		txReplicator.lastProgressAt = time.Now().Add(-2 * time.Minute)
+
+		// as it is fetching a new transaction
+		txReplicator.lastTx++
+		txReplicator.updateLag(1)
+		require.False(t, txReplicator.isIdle())
+	})
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/codenotary/immudb/pkg/api/protomodel"
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/database"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 // work-around until a DBManager is in-place, taking care of all db-related stuff
@@ -256,6 +257,10 @@ func (d *closedDB) GetCollection(ctx context.Context, req *protomodel.GetCollect
 	return nil, store.ErrAlreadyClosed
 }
 
+func (d *closedDB) CollectionStats(ctx context.Context, collectionName string) (*document.CollectionStats, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
 func (d *closedDB) GetCollections(ctx context.Context, req *protomodel.GetCollectionsRequest) (*protomodel.GetCollectionsResponse, error) {
 	return nil, store.ErrAlreadyClosed
 }
@@ -264,6 +269,10 @@ func (d *closedDB) UpdateCollection(ctx context.Context, req *protomodel.UpdateC
 	return nil, store.ErrAlreadyClosed
 }
 
+func (d *closedDB) AlterCollection(ctx context.Context, req *protomodel.AlterCollectionRequest) (*protomodel.AlterCollectionResponse, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
 func (d *closedDB) DeleteCollection(ctx context.Context, req *protomodel.DeleteCollectionRequest) (*protomodel.DeleteCollectionResponse, error) {
 	return nil, store.ErrAlreadyClosed
 }
@@ -276,6 +285,10 @@ func (d *closedDB) DeleteIndex(ctx context.Context, req *protomodel.DeleteIndexR
 	return nil, store.ErrAlreadyClosed
 }
 
+func (d *closedDB) NewDocumentTx(ctx context.Context) (*document.DocumentTx, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
 func (d *closedDB) InsertDocuments(ctx context.Context, req *protomodel.InsertDocumentsRequest) (*protomodel.InsertDocumentsResponse, error) {
 	return nil, store.ErrAlreadyClosed
 }
@@ -288,7 +301,7 @@ func (d *closedDB) AuditDocument(ctx context.Context, req *protomodel.AuditDocum
 	return nil, store.ErrAlreadyClosed
 }
 
-func (d *closedDB) SearchDocuments(ctx context.Context, query *protomodel.Query, offset int64) (document.DocumentReader, error) {
+func (d *closedDB) SearchDocuments(ctx context.Context, query *protomodel.Query, offset int64, fields []string) (document.DocumentReader, error) {
 	return nil, store.ErrAlreadyClosed
 }
 
@@ -296,10 +309,34 @@ func (d *closedDB) CountDocuments(ctx context.Context, req *protomodel.CountDocu
 	return nil, store.ErrAlreadyClosed
 }
 
+func (d *closedDB) AggregateDocuments(ctx context.Context, req *protomodel.AggregateDocumentsRequest) (*protomodel.AggregateDocumentsResponse, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
 func (d *closedDB) ProofDocument(ctx context.Context, req *protomodel.ProofDocumentRequest) (*protomodel.ProofDocumentResponse, error) {
 	return nil, store.ErrAlreadyClosed
 }
 
+func (d *closedDB) GetDocumentByKey(ctx context.Context, collectionName string, keyValues []*structpb.Value) (*protomodel.DocumentAtRevision, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
+func (d *closedDB) GetDocuments(ctx context.Context, collectionName string, keys [][]*structpb.Value) ([]*protomodel.DocumentAtRevision, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
+func (d *closedDB) GetDocumentHistory(ctx context.Context, collectionName string, keyValues []*structpb.Value, limit int, offset uint64) ([]*protomodel.DocumentAtRevision, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
+func (d *closedDB) StreamSearchDocuments(ctx context.Context, query *protomodel.Query, fields []string, fn func(revision *protomodel.DocumentAtRevision) error) error {
+	return store.ErrAlreadyClosed
+}
+
+func (d *closedDB) GetDocumentVerified(ctx context.Context, req *protomodel.GetDocumentVerifiedRequest) (*protomodel.GetDocumentVerifiedResponse, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
 func (d *closedDB) DeleteDocuments(ctx context.Context, req *protomodel.DeleteDocumentsRequest) (*protomodel.DeleteDocumentsResponse, error) {
 	return nil, store.ErrAlreadyClosed
 }
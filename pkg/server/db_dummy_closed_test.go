@@ -176,12 +176,18 @@ func TestDummyClosedDatabase(t *testing.T) {
 	_, err = cdb.GetCollection(context.Background(), nil)
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 
+	_, err = cdb.CollectionStats(context.Background(), "")
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
 	_, err = cdb.GetCollections(context.Background(), nil)
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 
 	_, err = cdb.UpdateCollection(context.Background(), nil)
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 
+	_, err = cdb.AlterCollection(context.Background(), nil)
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
 	_, err = cdb.DeleteCollection(context.Background(), nil)
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 
@@ -191,6 +197,9 @@ func TestDummyClosedDatabase(t *testing.T) {
 	_, err = cdb.DeleteIndex(context.Background(), nil)
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 
+	_, err = cdb.NewDocumentTx(context.Background())
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
 	_, err = cdb.InsertDocuments(context.Background(), nil)
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 
@@ -200,15 +209,33 @@ func TestDummyClosedDatabase(t *testing.T) {
 	_, err = cdb.AuditDocument(context.Background(), nil)
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 
-	_, err = cdb.SearchDocuments(context.Background(), nil, 0)
+	_, err = cdb.SearchDocuments(context.Background(), nil, 0, nil)
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 
 	_, err = cdb.CountDocuments(context.Background(), nil)
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 
+	_, err = cdb.AggregateDocuments(context.Background(), nil)
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
 	_, err = cdb.ProofDocument(context.Background(), nil)
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 
+	err = cdb.StreamSearchDocuments(context.Background(), nil, nil, nil)
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
+	_, err = cdb.GetDocumentByKey(context.Background(), "", nil)
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
+	_, err = cdb.GetDocumentHistory(context.Background(), "", nil, 0, 0)
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
+	_, err = cdb.GetDocuments(context.Background(), "", nil)
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
+	_, err = cdb.GetDocumentVerified(context.Background(), nil)
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
 	_, err = cdb.DeleteDocuments(context.Background(), nil)
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 
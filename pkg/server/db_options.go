@@ -234,7 +234,10 @@ func (s *ImmuServer) databaseOptionsFrom(opts *dbOptions) *database.Options {
 		WithSyncAcks(opts.SyncAcks).
 		WithReadTxPoolSize(opts.ReadTxPoolSize).
 		WithRetentionPeriod(time.Millisecond * time.Duration(opts.RetentionPeriod)).
-		WithTruncationFrequency(time.Millisecond * time.Duration(opts.TruncationFrequency))
+		WithTruncationFrequency(time.Millisecond * time.Duration(opts.TruncationFrequency)).
+		WithDocumentSweepFrequency(s.Options.DocumentSweepFrequency).
+		WithMaxDocumentSize(s.Options.MaxDocumentSize).
+		WithMaxDocumentFields(s.Options.MaxDocumentFields)
 }
 
 func (opts *dbOptions) storeOptions() *store.Options {
@@ -47,6 +47,15 @@ func (s *ImmuServer) UpdateCollection(ctx context.Context, req *protomodel.Updat
 	return db.UpdateCollection(ctx, req)
 }
 
+func (s *ImmuServer) AlterCollection(ctx context.Context, req *protomodel.AlterCollectionRequest) (*protomodel.AlterCollectionResponse, error) {
+	db, err := s.getDBFromCtx(ctx, "AlterCollection")
+	if err != nil {
+		return nil, err
+	}
+
+	return db.AlterCollection(ctx, req)
+}
+
 func (s *ImmuServer) GetCollection(ctx context.Context, req *protomodel.GetCollectionRequest) (*protomodel.GetCollectionResponse, error) {
 	db, err := s.getDBFromCtx(ctx, "GetCollection")
 	if err != nil {
@@ -155,6 +164,7 @@ func (s *ImmuServer) SearchDocuments(ctx context.Context, req *protomodel.Search
 
 	searchID := req.SearchId
 	query := req.Query
+	fields := req.Fields
 
 	var pgreader *sessions.PaginatedDocumentReader
 
@@ -179,6 +189,7 @@ func (s *ImmuServer) SearchDocuments(ctx context.Context, req *protomodel.Search
 			}
 
 			query = pgreader.Query
+			fields = pgreader.Fields
 			pgreader = nil
 		}
 	}
@@ -187,7 +198,7 @@ func (s *ImmuServer) SearchDocuments(ctx context.Context, req *protomodel.Search
 		// create a new reader and add it to the session
 		offset := int64((req.Page - 1) * req.PageSize)
 
-		docReader, err := db.SearchDocuments(ctx, query, offset)
+		docReader, err := db.SearchDocuments(ctx, query, offset, fields)
 		if err != nil {
 			return nil, err
 		}
@@ -196,6 +207,7 @@ func (s *ImmuServer) SearchDocuments(ctx context.Context, req *protomodel.Search
 		pgreader = &sessions.PaginatedDocumentReader{
 			Reader:         docReader,
 			Query:          query,
+			Fields:         fields,
 			LastPageNumber: req.Page,
 			LastPageSize:   req.PageSize,
 		}
@@ -230,6 +242,19 @@ func (s *ImmuServer) SearchDocuments(ctx context.Context, req *protomodel.Search
 	}, nil
 }
 
+func (s *ImmuServer) StreamSearchDocuments(req *protomodel.StreamSearchDocumentsRequest, str protomodel.DocumentService_StreamSearchDocumentsServer) error {
+	db, err := s.getDBFromCtx(str.Context(), "StreamSearchDocuments")
+	if err != nil {
+		return err
+	}
+
+	if req == nil {
+		return ErrIllegalArguments
+	}
+
+	return db.StreamSearchDocuments(str.Context(), req.Query, req.Fields, str.Send)
+}
+
 func (s *ImmuServer) CountDocuments(ctx context.Context, req *protomodel.CountDocumentsRequest) (*protomodel.CountDocumentsResponse, error) {
 	db, err := s.getDBFromCtx(ctx, "CountDocuments")
 	if err != nil {
@@ -239,6 +264,15 @@ func (s *ImmuServer) CountDocuments(ctx context.Context, req *protomodel.CountDo
 	return db.CountDocuments(ctx, req)
 }
 
+func (s *ImmuServer) AggregateDocuments(ctx context.Context, req *protomodel.AggregateDocumentsRequest) (*protomodel.AggregateDocumentsResponse, error) {
+	db, err := s.getDBFromCtx(ctx, "AggregateDocuments")
+	if err != nil {
+		return nil, err
+	}
+
+	return db.AggregateDocuments(ctx, req)
+}
+
 func (s *ImmuServer) DeleteDocuments(ctx context.Context, req *protomodel.DeleteDocumentsRequest) (*protomodel.DeleteDocumentsResponse, error) {
 	db, err := s.getDBFromCtx(ctx, "DeleteDocuments")
 	if err != nil {
@@ -279,3 +313,12 @@ func (s *ImmuServer) ProofDocument(ctx context.Context, req *protomodel.ProofDoc
 
 	return res, nil
 }
+
+func (s *ImmuServer) GetDocumentVerified(ctx context.Context, req *protomodel.GetDocumentVerifiedRequest) (*protomodel.GetDocumentVerifiedResponse, error) {
+	db, err := s.getDBFromCtx(ctx, "GetDocumentVerified")
+	if err != nil {
+		return nil, err
+	}
+
+	return db.GetDocumentVerified(ctx, req)
+}
@@ -18,6 +18,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/server/sessions"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/structpb"
 )
@@ -50,6 +52,9 @@ func TestV2Authentication(t *testing.T) {
 	_, err = s.UpdateCollection(ctx, &protomodel.UpdateCollectionRequest{})
 	require.ErrorIs(t, err, ErrNotLoggedIn)
 
+	_, err = s.AlterCollection(ctx, &protomodel.AlterCollectionRequest{})
+	require.ErrorIs(t, err, ErrNotLoggedIn)
+
 	_, err = s.GetCollection(ctx, &protomodel.GetCollectionRequest{})
 	require.ErrorIs(t, err, ErrNotLoggedIn)
 
@@ -80,12 +85,18 @@ func TestV2Authentication(t *testing.T) {
 	_, err = s.CountDocuments(ctx, &protomodel.CountDocumentsRequest{})
 	require.ErrorIs(t, err, ErrNotLoggedIn)
 
+	_, err = s.AggregateDocuments(ctx, &protomodel.AggregateDocumentsRequest{})
+	require.ErrorIs(t, err, ErrNotLoggedIn)
+
 	_, err = s.DeleteDocuments(ctx, &protomodel.DeleteDocumentsRequest{})
 	require.ErrorIs(t, err, ErrNotLoggedIn)
 
 	_, err = s.ProofDocument(ctx, &protomodel.ProofDocumentRequest{})
 	require.ErrorIs(t, err, ErrNotLoggedIn)
 
+	err = s.StreamSearchDocuments(&protomodel.StreamSearchDocumentsRequest{}, &documentServiceStreamSearchDocumentsServer{ctx: ctx})
+	require.ErrorIs(t, err, ErrNotLoggedIn)
+
 	authServiceImp := &authenticationServiceImp{server: s}
 
 	logged, err := authServiceImp.OpenSession(ctx, &protomodel.OpenSessionRequest{
@@ -273,6 +284,73 @@ func TestPaginationOnReader(t *testing.T) {
 		})
 	})
 
+	t.Run("test reader with projected fields", func(t *testing.T) {
+		resp, err := s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{
+			Query: &protomodel.Query{
+				CollectionName: collectionName,
+				OrderBy:        []*protomodel.OrderByClause{{Field: "idx"}},
+			},
+			Page:     1,
+			PageSize: 5,
+			KeepOpen: true,
+			Fields:   []string{"idx"},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Revisions, 5)
+
+		for _, rev := range resp.Revisions {
+			require.Len(t, rev.Document.Fields, 2)
+			require.Contains(t, rev.Document.Fields, "_id")
+			require.Contains(t, rev.Document.Fields, "idx")
+		}
+
+		// changing the page size re-creates the reader, which should keep the projection
+		resp, err = s.SearchDocuments(ctx, &protomodel.SearchDocumentsRequest{
+			SearchId: resp.SearchId,
+			Page:     2,
+			PageSize: 10,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Revisions, 10)
+
+		for i, rev := range resp.Revisions {
+			require.Len(t, rev.Document.Fields, 2)
+			require.Equal(t, float64(11+i), rev.Document.Fields["idx"].GetNumberValue())
+		}
+	})
+
+	t.Run("test streamed search", func(t *testing.T) {
+		err := s.StreamSearchDocuments(nil, &documentServiceStreamSearchDocumentsServer{ctx: ctx})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		str := &documentServiceStreamSearchDocumentsServer{ctx: ctx}
+
+		err = s.StreamSearchDocuments(&protomodel.StreamSearchDocumentsRequest{
+			Query: &protomodel.Query{
+				CollectionName: collectionName,
+				OrderBy:        []*protomodel.OrderByClause{{Field: "idx"}},
+			},
+			Fields: []string{"idx"},
+		}, str)
+		require.NoError(t, err)
+		require.Len(t, str.revisions, 20)
+
+		for i, rev := range str.revisions {
+			require.Len(t, rev.Document.Fields, 2)
+			require.Equal(t, float64(i+1), rev.Document.Fields["idx"].GetNumberValue())
+		}
+
+		errSend := errors.New("send error")
+
+		str = &documentServiceStreamSearchDocumentsServer{ctx: ctx, sendErr: errSend}
+
+		err = s.StreamSearchDocuments(&protomodel.StreamSearchDocumentsRequest{
+			Query: &protomodel.Query{CollectionName: collectionName},
+		}, str)
+		require.ErrorIs(t, err, errSend)
+		require.Len(t, str.revisions, 1)
+	})
+
 	t.Run("test reader should throw error on reading backwards", func(t *testing.T) {
 		var searchID string
 
@@ -492,6 +570,43 @@ func TestPaginatedReader_NoMoreDocsFound(t *testing.T) {
 		require.EqualValues(t, 10, resp.Count)
 	})
 
+	t.Run("document aggregation should compute the value over matching documents", func(t *testing.T) {
+		resp, err := s.AggregateDocuments(ctx, &protomodel.AggregateDocumentsRequest{
+			Query: &protomodel.Query{
+				CollectionName: collectionName,
+				Expressions: []*protomodel.QueryExpression{
+					{
+						FieldComparisons: []*protomodel.FieldComparison{
+							{
+								Field:    "pincode",
+								Operator: protomodel.ComparisonOperator_LE,
+								Value:    structpb.NewNumberValue(4),
+							},
+						},
+					},
+				},
+			},
+			Field:    "idx",
+			Function: protomodel.AggregateFunction_SUM,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 1)
+		require.EqualValues(t, 10, resp.Results[0].Value.GetNumberValue())
+
+		resp, err = s.AggregateDocuments(ctx, &protomodel.AggregateDocumentsRequest{
+			Query: &protomodel.Query{
+				CollectionName: collectionName,
+			},
+			Field:    "idx",
+			Function: protomodel.AggregateFunction_MAX,
+			GroupBy:  "country",
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 10)
+		require.Equal(t, "country-1", resp.Results[0].Group.GetStringValue())
+		require.EqualValues(t, 1, resp.Results[0].Value.GetNumberValue())
+	})
+
 	t.Run("test reader with multiple paginated reads", func(t *testing.T) {
 		results := make([]*protomodel.DocumentAtRevision, 0)
 
@@ -959,3 +1074,20 @@ func TestDocuments(t *testing.T) {
 	})
 
 }
+
+type documentServiceStreamSearchDocumentsServer struct {
+	grpc.ServerStream
+
+	ctx       context.Context
+	sendErr   error
+	revisions []*protomodel.DocumentAtRevision
+}
+
+func (s *documentServiceStreamSearchDocumentsServer) Send(rev *protomodel.DocumentAtRevision) error {
+	s.revisions = append(s.revisions, rev)
+	return s.sendErr
+}
+
+func (s *documentServiceStreamSearchDocumentsServer) Context() context.Context {
+	return s.ctx
+}
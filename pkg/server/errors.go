@@ -46,6 +46,7 @@ var (
 	ErrReplicationInProgress       = errors.New("replication already in progress")
 	ErrReplicatorNotNeeded         = errors.New("replicator is not needed")
 	ErrReplicationNotInProgress    = errors.New("replication is not in progress")
+	ErrTooManyExportStreams        = status.Error(codes.ResourceExhausted, "too many concurrent export streams for the replica")
 	ErrSessionAlreadyPresent       = errors.New("session already present").WithCode(errors.CodInternalError)
 	ErrSessionNotFound             = errors.New("session not found").WithCode(errors.CodSqlserverRejectedEstablishmentOfSqlSession)
 	ErrOngoingReadWriteTx          = sessions.ErrOngoingReadWriteTx
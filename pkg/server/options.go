@@ -22,6 +22,7 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/logger"
 	"github.com/codenotary/immudb/pkg/replication"
@@ -73,6 +74,10 @@ type Options struct {
 	PProf                       bool
 	LogFormat                   string
 	GRPCReflectionServerEnabled bool
+	MaxExportStreamsPerReplica  int
+	DocumentSweepFrequency      time.Duration
+	MaxDocumentSize             int
+	MaxDocumentFields           int
 }
 
 type RemoteStorageOptions struct {
@@ -428,6 +433,34 @@ func (o *Options) WithStreamChunkSize(streamChunkSize int) *Options {
 	return o
 }
 
+// WithMaxExportStreamsPerReplica sets the max number of concurrent streams a replica can use to export
+// transactions, streams in excess are rejected with a retryable error. No limit is enforced when set to zero
+func (o *Options) WithMaxExportStreamsPerReplica(maxExportStreamsPerReplica int) *Options {
+	o.MaxExportStreamsPerReplica = maxExportStreamsPerReplica
+	return o
+}
+
+// WithDocumentSweepFrequency sets how frequently expired documents are deleted from the collections of every
+// database, expired documents are excluded from queries even if not yet deleted. Documents are never deleted when set to zero
+func (o *Options) WithDocumentSweepFrequency(documentSweepFrequency time.Duration) *Options {
+	o.DocumentSweepFrequency = documentSweepFrequency
+	return o
+}
+
+// WithMaxDocumentSize sets the maximum size in bytes of the documents written into the collections of every database,
+// oversized documents are rejected before being written. Document size is not limited when set to zero
+func (o *Options) WithMaxDocumentSize(maxDocumentSize int) *Options {
+	o.MaxDocumentSize = maxDocumentSize
+	return o
+}
+
+// WithMaxDocumentFields sets the maximum number of fields, nested ones included, of the documents written into the
+// collections of every database. The number of fields is not limited when set to zero
+func (o *Options) WithMaxDocumentFields(maxDocumentFields int) *Options {
+	o.MaxDocumentFields = maxDocumentFields
+	return o
+}
+
 // WithTokenExpiryTime set authentication token expiration time in minutes
 func (o *Options) WithTokenExpiryTime(tokenExpiryTimeMin int) *Options {
 	o.TokenExpiryTimeMin = tokenExpiryTimeMin
@@ -45,8 +45,9 @@ var (
 type PaginatedDocumentReader struct {
 	Reader         document.DocumentReader // reader to read from
 	Query          *protomodel.Query
-	LastPageNumber uint32 // last read page number
-	LastPageSize   uint32 // number of items per page
+	Fields         []string // fields the documents are projected on, whole documents are read when empty
+	LastPageNumber uint32   // last read page number
+	LastPageSize   uint32   // number of items per page
 }
 
 type Session struct {
@@ -18,20 +18,49 @@ package server
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"strconv"
 
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/stream"
 	"google.golang.org/grpc/metadata"
+
+	// registers the gzip compressor so replicas may request compressed transaction exports
+	_ "google.golang.org/grpc/encoding/gzip"
 )
 
 func (s *ImmuServer) ExportTx(req *schema.ExportTxRequest, txsServer schema.ImmuService_ExportTxServer) error {
-	return s.exportTx(req, txsServer, true, make([]byte, s.Options.StreamChunkSize))
+	if req == nil || txsServer == nil {
+		return ErrIllegalArguments
+	}
+
+	if s.Options.MaxExportStreamsPerReplica > 0 {
+		replicaUUID := replicaUUIDFromCtx(txsServer.Context())
+		if replicaUUID == "" {
+			replicaUUID = req.GetReplicaState().GetUUID()
+		}
+
+		release, err := s.acquireExportStream(replicaUUID)
+		if err != nil {
+			return err
+		}
+		defer release()
+	}
+
+	return s.exportTx(req, txsServer, true, make([]byte, s.exportTxChunkSize(req)))
 }
 
 // StreamExportTx implements the bidirectional streaming endpoint used to export transactions
 func (s *ImmuServer) StreamExportTx(stream schema.ImmuService_StreamExportTxServer) error {
+	if s.Options.MaxExportStreamsPerReplica > 0 {
+		release, err := s.acquireExportStream(replicaUUIDFromCtx(stream.Context()))
+		if err != nil {
+			return err
+		}
+		defer release()
+	}
+
 	buf := make([]byte, s.Options.StreamChunkSize)
 
 	for {
@@ -40,6 +69,12 @@ func (s *ImmuServer) StreamExportTx(stream schema.ImmuService_StreamExportTxServ
 			return err
 		}
 
+		// the buffer is only re-allocated when a different chunk size is requested
+		chunkSize := s.exportTxChunkSize(req)
+		if len(buf) != chunkSize {
+			buf = make([]byte, chunkSize)
+		}
+
 		err = s.exportTx(req, stream, false, buf)
 		if err != nil {
 			return err
@@ -47,6 +82,65 @@ func (s *ImmuServer) StreamExportTx(stream schema.ImmuService_StreamExportTxServ
 	}
 }
 
+// replicaUUIDFromCtx returns the uuid sent by the replica when opening an export stream, if any
+func replicaUUIDFromCtx(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get("replica-uuid")) == 0 {
+		return ""
+	}
+
+	return md.Get("replica-uuid")[0]
+}
+
+// acquireExportStream registers an export stream of the replica, returning ErrTooManyExportStreams
+// if it already reached the max number of concurrent export streams. Streams whose replica is
+// unknown are not limited. The returned function must be called once the stream is closed
+func (s *ImmuServer) acquireExportStream(replicaUUID string) (release func(), err error) {
+	if replicaUUID == "" {
+		return func() {}, nil
+	}
+
+	s.exportStreamsMutex.Lock()
+	defer s.exportStreamsMutex.Unlock()
+
+	if s.exportStreams[replicaUUID] >= s.Options.MaxExportStreamsPerReplica {
+		return nil, ErrTooManyExportStreams
+	}
+
+	s.exportStreams[replicaUUID]++
+
+	return func() {
+		s.exportStreamsMutex.Lock()
+		defer s.exportStreamsMutex.Unlock()
+
+		s.exportStreams[replicaUUID]--
+
+		if s.exportStreams[replicaUUID] == 0 {
+			delete(s.exportStreams, replicaUUID)
+		}
+	}, nil
+}
+
+// exportTxChunkSize returns the size of the chunks used to send an exported transaction,
+// the chunk size requested by the replica is used as long as it's within the supported range
+func (s *ImmuServer) exportTxChunkSize(req *schema.ExportTxRequest) int {
+	chunkSize := int(req.GetChunkSize())
+
+	if chunkSize == 0 {
+		return s.Options.StreamChunkSize
+	}
+
+	if chunkSize < stream.MinChunkSize {
+		return stream.MinChunkSize
+	}
+
+	if chunkSize > stream.MaxChunkSize {
+		return stream.MaxChunkSize
+	}
+
+	return chunkSize
+}
+
 func (s *ImmuServer) exportTx(req *schema.ExportTxRequest, txsServer schema.ImmuService_ExportTxServer, setTrailer bool, buf []byte) error {
 	if req == nil || req.Tx == 0 || txsServer == nil {
 		return ErrIllegalArguments
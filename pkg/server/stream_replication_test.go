@@ -23,6 +23,7 @@ import (
 
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/stream"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -135,3 +136,48 @@ func (s *immuServiceReplicateTxServer) SendAndClose(md *schema.TxHeader) error {
 func (s *immuServiceReplicateTxServer) Context() context.Context {
 	return s.ctx
 }
+
+func TestExportTxChunkSize(t *testing.T) {
+	s := DefaultServer().WithOptions(DefaultOptions().WithStreamChunkSize(8192)).(*ImmuServer)
+
+	require.Equal(t, 8192, s.exportTxChunkSize(nil))
+	require.Equal(t, 8192, s.exportTxChunkSize(&schema.ExportTxRequest{Tx: 1}))
+	require.Equal(t, 32768, s.exportTxChunkSize(&schema.ExportTxRequest{Tx: 1, ChunkSize: 32768}))
+	require.Equal(t, stream.MinChunkSize, s.exportTxChunkSize(&schema.ExportTxRequest{Tx: 1, ChunkSize: 1}))
+	require.Equal(t, stream.MaxChunkSize, s.exportTxChunkSize(&schema.ExportTxRequest{Tx: 1, ChunkSize: 1 << 30}))
+}
+
+func TestAcquireExportStream(t *testing.T) {
+	s := DefaultServer().WithOptions(DefaultOptions().WithMaxExportStreamsPerReplica(2)).(*ImmuServer)
+
+	release1, err := s.acquireExportStream("replica1")
+	require.NoError(t, err)
+
+	release2, err := s.acquireExportStream("replica1")
+	require.NoError(t, err)
+
+	_, err = s.acquireExportStream("replica1")
+	require.ErrorIs(t, err, ErrTooManyExportStreams)
+
+	// streams of each replica are limited independently
+	release3, err := s.acquireExportStream("replica2")
+	require.NoError(t, err)
+	defer release3()
+
+	// streams of unknown replicas are not limited
+	for i := 0; i < 3; i++ {
+		release, err := s.acquireExportStream("")
+		require.NoError(t, err)
+		defer release()
+	}
+
+	release1()
+
+	release4, err := s.acquireExportStream("replica1")
+	require.NoError(t, err)
+
+	release2()
+	release4()
+
+	require.NotContains(t, s.exportStreams, "replica1")
+}
@@ -61,6 +61,9 @@ type ImmuServer struct {
 	replicators      map[string]*replication.TxReplicator
 	replicationMutex sync.Mutex
 
+	exportStreams      map[string]int // number of ongoing export streams by replica uuid
+	exportStreamsMutex sync.Mutex
+
 	truncators     map[string]*truncator.Truncator
 	truncatorMutex sync.Mutex
 
@@ -94,6 +97,7 @@ func DefaultServer() *ImmuServer {
 		OS:                   immuos.NewStandardOS(),
 		dbList:               database.NewDatabaseList(),
 		replicators:          make(map[string]*replication.TxReplicator),
+		exportStreams:        make(map[string]int),
 		truncators:           make(map[string]*truncator.Truncator),
 		Logger:               logger.NewSimpleLogger("immudb ", os.Stderr),
 		Options:              DefaultOptions(),
@@ -18,4 +18,5 @@ package stream
 
 const DefaultChunkSize int = 64 * 1024 // 64 * 1024 64 KiB
 const MinChunkSize int = 4096
+const MaxChunkSize int = 1024 * 1024 // 1 MiB, well below the default grpc max message size
 const MaxTxValueLen int = 1 << 25 // 32Mb
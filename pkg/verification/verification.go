@@ -73,29 +73,7 @@ func VerifyDocument(ctx context.Context,
 		return nil, fmt.Errorf("%w: document entry was not found or it was found multiple times", store.ErrInvalidProof)
 	}
 
-	voff := sql.EncLenLen + sql.EncIDLen
-
-	// DocumentIDField
-	_, n, err := sql.DecodeValue(proof.EncodedDocument[voff:], sql.BLOBType)
-	if err != nil {
-		return nil, err
-	}
-
-	if n > document.MaxDocumentIDLength {
-		return nil, fmt.Errorf("%w: the proof contains invalid document data", store.ErrInvalidProof)
-	}
-
-	voff += n + sql.EncIDLen
-
-	// DocumentBLOBField
-	encodedDoc, _, err := sql.DecodeValue(proof.EncodedDocument[voff:], sql.BLOBType)
-	if err != nil {
-		return nil, err
-	}
-
-	proofDoc := &structpb.Struct{}
-
-	err = proto.Unmarshal(encodedDoc.RawValue().([]byte), proofDoc)
+	_, proofDoc, err := decodeDocument(proof.EncodedDocument)
 	if err != nil {
 		return nil, err
 	}
@@ -209,6 +187,82 @@ func VerifyDocument(ctx context.Context,
 	return state, nil
 }
 
+// VerifyDocumentInclusion verifies the document is included in the transaction it was written at and returns it.
+// The transaction header is part of the proof, so it should be checked against a trusted state, e.g. using ProofDocument
+func VerifyDocumentInclusion(collectionID uint32, verifiableDoc *protomodel.VerifiableDocument) (*structpb.Struct, error) {
+	if verifiableDoc == nil || verifiableDoc.TxHeader == nil || verifiableDoc.InclusionProof == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	docID, doc, err := decodeDocument(verifiableDoc.EncodedDocument)
+	if err != nil {
+		return nil, err
+	}
+
+	encDocKey, err := encodedKeyForDocument(collectionID, docID.EncodeToHexString())
+	if err != nil {
+		return nil, err
+	}
+
+	txHdr := schema.TxHeaderFromProto(verifiableDoc.TxHeader)
+
+	entrySpecDigest, err := store.EntrySpecDigestFor(txHdr.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	entryDigest := entrySpecDigest(&store.EntrySpec{
+		Key:              encDocKey,
+		Metadata:         schema.KVMetadataFromProto(verifiableDoc.Metadata),
+		HashValue:        sha256.Sum256(verifiableDoc.EncodedDocument),
+		IsValueTruncated: true,
+	})
+
+	inclusionProof := schema.InclusionProofFromProto(verifiableDoc.InclusionProof)
+
+	if !store.VerifyInclusion(inclusionProof, entryDigest, txHdr.Eh) {
+		return nil, store.ErrInvalidProof
+	}
+
+	return doc, nil
+}
+
+// decodeDocument decodes the value of the entry backing a document
+func decodeDocument(encodedDocument []byte) (document.DocumentID, *structpb.Struct, error) {
+	if len(encodedDocument) < sql.EncLenLen+sql.EncIDLen {
+		return nil, nil, fmt.Errorf("%w: the proof contains invalid document data", store.ErrInvalidProof)
+	}
+
+	voff := sql.EncLenLen + sql.EncIDLen
+
+	// DocumentIDField
+	docID, n, err := sql.DecodeValue(encodedDocument[voff:], sql.BLOBType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if n > document.MaxDocumentIDLength {
+		return nil, nil, fmt.Errorf("%w: the proof contains invalid document data", store.ErrInvalidProof)
+	}
+
+	voff += n + sql.EncIDLen
+
+	// DocumentBLOBField
+	encodedDoc, _, err := sql.DecodeValue(encodedDocument[voff:], sql.BLOBType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	doc := &structpb.Struct{}
+
+	err = proto.Unmarshal(encodedDoc.RawValue().([]byte), doc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return docID.RawValue().([]byte), doc, nil
+}
+
 func encodedKeyForDocument(collectionID uint32, documentID string) ([]byte, error) {
 	docID, err := document.NewDocumentIDFromHexEncodedString(documentID)
 	if err != nil {
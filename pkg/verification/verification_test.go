@@ -40,3 +40,11 @@ func TestVerifyDocument(t *testing.T) {
 	})
 
 }
+
+func TestVerifyDocumentInclusion(t *testing.T) {
+	_, err := VerifyDocumentInclusion(1, nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = VerifyDocumentInclusion(1, &protomodel.VerifiableDocument{})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+}
@@ -19,7 +19,6 @@ package main
 import (
 	"crypto/rand"
 	"encoding/binary"
-	"encoding/json"
 	"flag"
 	"log"
 	"os"
@@ -38,9 +37,22 @@ func main() {
 	flInfluxBucket := flag.String("bucket", "immudb-tests-results", "bucket for influxdb")
 	flInfluxRunner := flag.String("runner", "", "github runner for influxdb")
 	flInfluxVersion := flag.String("version", "", "immudb version for influxdb")
+	flAddress := flag.String("address", "", "address of a running immudb server to benchmark, an embedded server is used if not set")
+	flPort := flag.Int("port", 3322, "port of a running immudb server to benchmark")
+	flConcurrency := flag.Int("concurrency", 0, "number of concurrent clients, each benchmark uses its own default if not set")
+	flValueSize := flag.Int("value-size", 0, "size in bytes of written values, each benchmark uses its own default if not set")
+	flOutput := flag.String("output", runner.OutputJSON, "output format: text, json or csv")
+	flReadRatio := flag.Float64("read-ratio", 0, "fraction of read requests in an additional mixed read/write benchmark, it's not run if not set")
+	flKeySpace := flag.Int("key-space", 100000, "number of distinct keys used in the mixed read/write benchmark")
 
 	flag.Parse()
 
+	switch *flOutput {
+	case runner.OutputText, runner.OutputJSON, runner.OutputCSV:
+	default:
+		log.Fatalf("Unsupported output format '%s', use one of: text, json, csv", *flOutput)
+	}
+
 	if *flRandomSeed {
 		var rndSeed [8]byte
 		_, err := rand.Reader.Read(rndSeed[:])
@@ -50,14 +62,21 @@ func main() {
 		*flSeed = binary.BigEndian.Uint64(rndSeed[:])
 	}
 
-	results, err := runner.RunAllBenchmarks(*flDuration, *flSeed)
+	results, err := runner.RunAllBenchmarks(runner.Config{
+		Duration:    *flDuration,
+		Seed:        *flSeed,
+		Address:     *flAddress,
+		Port:        *flPort,
+		Concurrency: *flConcurrency,
+		ValueSize:   *flValueSize,
+		ReadRatio:   *flReadRatio,
+		KeySpace:    *flKeySpace,
+	})
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	e := json.NewEncoder(os.Stdout)
-	e.SetIndent("", "   ")
-	err = e.Encode(results)
+	err = runner.WriteResults(os.Stdout, *flOutput, results)
 	if err != nil {
 		log.Fatal(err)
 	}
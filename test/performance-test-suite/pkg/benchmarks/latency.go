@@ -0,0 +1,136 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package benchmarks
+
+import (
+	"fmt"
+	"math"
+	"sync/atomic"
+	"time"
+)
+
+// Latencies are gathered into buckets whose bounds grow by 1%, thus percentiles are reported
+// with a relative error below 1% while using a fixed amount of memory regardless of the run length
+const (
+	latencyBucketGrowth = 1.01
+	latencyBuckets      = 3000 // covers latencies above one hour
+)
+
+var latencyBucketGrowthLog = math.Log(latencyBucketGrowth)
+
+// LatencyStats contains the distribution of operation latencies
+type LatencyStats struct {
+	P50 float64 `json:"p50Ms"` // Median latency in milliseconds
+	P90 float64 `json:"p90Ms"` // 90th percentile latency in milliseconds
+	P99 float64 `json:"p99Ms"` // 99th percentile latency in milliseconds
+	Max float64 `json:"maxMs"` // Maximum latency in milliseconds
+}
+
+func (l *LatencyStats) String() string {
+	return fmt.Sprintf(
+		"Latency (p50/p90/p99/max): %.3fms/%.3fms/%.3fms/%.3fms",
+		l.P50,
+		l.P90,
+		l.P99,
+		l.Max,
+	)
+}
+
+// LatencyRecorder gathers operation latencies, it's safe for concurrent use
+type LatencyRecorder struct {
+	buckets [latencyBuckets]uint64
+	max     int64
+}
+
+func NewLatencyRecorder() *LatencyRecorder {
+	return &LatencyRecorder{}
+}
+
+func latencyBucket(d time.Duration) int {
+	if d <= 1 {
+		return 0
+	}
+
+	i := int(math.Ceil(math.Log(float64(d)) / latencyBucketGrowthLog))
+	if i >= latencyBuckets {
+		return latencyBuckets - 1
+	}
+
+	return i
+}
+
+func (r *LatencyRecorder) Record(d time.Duration) {
+	atomic.AddUint64(&r.buckets[latencyBucket(d)], 1)
+
+	for {
+		max := atomic.LoadInt64(&r.max)
+		if int64(d) <= max || atomic.CompareAndSwapInt64(&r.max, max, int64(d)) {
+			return
+		}
+	}
+}
+
+// Stats returns the distribution of the latencies recorded so far, nil is returned if none was recorded
+func (r *LatencyRecorder) Stats() *LatencyStats {
+	var counts [latencyBuckets]uint64
+	var total uint64
+
+	for i := range r.buckets {
+		counts[i] = atomic.LoadUint64(&r.buckets[i])
+		total += counts[i]
+	}
+
+	if total == 0 {
+		return nil
+	}
+
+	max := time.Duration(atomic.LoadInt64(&r.max))
+
+	percentile := func(p float64) float64 {
+		target := uint64(math.Ceil(p * float64(total)))
+
+		var seen uint64
+
+		for i, c := range counts {
+			seen += c
+			if seen < target {
+				continue
+			}
+
+			// upper bound of the bucket, it can not exceed the maximum latency
+			d := time.Duration(math.Pow(latencyBucketGrowth, float64(i)))
+			if d > max {
+				d = max
+			}
+
+			return toMilliseconds(d)
+		}
+
+		return toMilliseconds(max)
+	}
+
+	return &LatencyStats{
+		P50: percentile(0.5),
+		P90: percentile(0.9),
+		P99: percentile(0.99),
+		Max: toMilliseconds(max),
+	}
+}
+
+func toMilliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
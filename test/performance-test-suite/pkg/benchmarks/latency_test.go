@@ -0,0 +1,90 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package benchmarks
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatencyRecorder(t *testing.T) {
+	t.Run("no latencies", func(t *testing.T) {
+		r := NewLatencyRecorder()
+		assert.Nil(t, r.Stats())
+	})
+
+	t.Run("percentiles", func(t *testing.T) {
+		r := NewLatencyRecorder()
+
+		for i := 1; i <= 1000; i++ {
+			r.Record(time.Duration(i) * time.Millisecond)
+		}
+
+		stats := r.Stats()
+		require.NotNil(t, stats)
+		assert.InEpsilon(t, 500.0, stats.P50, 0.01)
+		assert.InEpsilon(t, 900.0, stats.P90, 0.01)
+		assert.InEpsilon(t, 990.0, stats.P99, 0.01)
+		assert.Equal(t, 1000.0, stats.Max)
+	})
+
+	t.Run("percentiles do not exceed the maximum latency", func(t *testing.T) {
+		r := NewLatencyRecorder()
+		r.Record(123456 * time.Nanosecond)
+
+		stats := r.Stats()
+		require.NotNil(t, stats)
+		assert.Equal(t, 0.123456, stats.P50)
+		assert.Equal(t, 0.123456, stats.P99)
+		assert.Equal(t, 0.123456, stats.Max)
+	})
+
+	t.Run("out of range latencies", func(t *testing.T) {
+		r := NewLatencyRecorder()
+		r.Record(0)
+		r.Record(2 * time.Hour)
+
+		stats := r.Stats()
+		require.NotNil(t, stats)
+		assert.Equal(t, toMilliseconds(time.Nanosecond), stats.P50)
+		assert.Equal(t, float64(2*time.Hour/time.Millisecond), stats.Max)
+	})
+
+	t.Run("concurrent recording", func(t *testing.T) {
+		r := NewLatencyRecorder()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					r.Record(time.Duration(i*100+j+1) * time.Microsecond)
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		stats := r.Stats()
+		require.NotNil(t, stats)
+		assert.Equal(t, 1.0, stats.Max)
+	})
+}
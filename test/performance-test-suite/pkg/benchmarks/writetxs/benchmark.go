@@ -21,6 +21,7 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"log"
+	mrand "math/rand"
 	"net"
 	"os"
 	"sync"
@@ -48,6 +49,18 @@ type Config struct {
 	ValueSize  int
 	AsyncWrite bool
 	Replica    string
+
+	// ReadRatio is the fraction of requests reading a single key instead of writing,
+	// reads require a key space to be set
+	ReadRatio float64
+	// KeySpace is the number of distinct keys read and written, keys are populated before the run.
+	// Every write uses a new key when it is not set
+	KeySpace int
+
+	// Address and Port of a running immudb server to benchmark,
+	// an embedded server is started when no address is provided
+	Address string
+	Port    int
 }
 
 type benchmark struct {
@@ -55,13 +68,17 @@ type benchmark struct {
 
 	txSoFar   int64
 	kvSoFar   int64
+	readSoFar int64
 	startTime time.Time
 
-	lastProbeTxSoFar int64
-	lastProbeKVSoFar int64
-	lastProbeTime    time.Time
+	lastProbeTxSoFar   int64
+	lastProbeKVSoFar   int64
+	lastProbeReadSoFar int64
+	lastProbeTime      time.Time
 
 	hwStatsGatherer *benchmarks.HWStatsProber
+	writeLatencies  *benchmarks.LatencyRecorder
+	readLatencies   *benchmarks.LatencyRecorder
 
 	m sync.Mutex
 
@@ -71,13 +88,18 @@ type benchmark struct {
 }
 
 type Result struct {
-	TxTotal int64               `json:"txTotal"`
-	KvTotal int64               `json:"kvTotal"`
-	Txs     float64             `json:"txs"`
-	Kvs     float64             `json:"kvs"`
-	TxsInst float64             `json:"txsInstant,omitempty"`
-	KvsInst float64             `json:"kvsInstant,omitempty"`
-	HWStats *benchmarks.HWStats `json:"hwStats"`
+	TxTotal      int64                    `json:"txTotal"`
+	KvTotal      int64                    `json:"kvTotal"`
+	ReadTotal    int64                    `json:"readTotal,omitempty"`
+	Txs          float64                  `json:"txs"`
+	Kvs          float64                  `json:"kvs"`
+	Reads        float64                  `json:"reads,omitempty"`
+	TxsInst      float64                  `json:"txsInstant,omitempty"`
+	KvsInst      float64                  `json:"kvsInstant,omitempty"`
+	ReadsInst    float64                  `json:"readsInstant,omitempty"`
+	WriteLatency *benchmarks.LatencyStats `json:"writeLatency,omitempty"`
+	ReadLatency  *benchmarks.LatencyStats `json:"readLatency,omitempty"`
+	HWStats      *benchmarks.HWStats      `json:"hwStats"`
 }
 
 func (r *Result) String() string {
@@ -95,6 +117,22 @@ func (r *Result) String() string {
 			r.KvsInst,
 		)
 	}
+	if r.ReadTotal != 0 {
+		s += fmt.Sprintf(
+			", Reads: %d, Reads/s: %.2f",
+			r.ReadTotal,
+			r.Reads,
+		)
+	}
+	if r.ReadsInst != 0.0 {
+		s += fmt.Sprintf(", Reads/s instant: %.2f", r.ReadsInst)
+	}
+	if r.WriteLatency != nil {
+		s += ", Write " + r.WriteLatency.String()
+	}
+	if r.ReadLatency != nil {
+		s += ", Read " + r.ReadLatency.String()
+	}
 	if r.HWStats != nil {
 		s += ", "
 		s += r.HWStats.String()
@@ -103,7 +141,11 @@ func (r *Result) String() string {
 }
 
 func NewBenchmark(cfg Config) benchmarks.Benchmark {
-	return &benchmark{cfg: cfg}
+	return &benchmark{
+		cfg:            cfg,
+		writeLatencies: benchmarks.NewLatencyRecorder(),
+		readLatencies:  benchmarks.NewLatencyRecorder(),
+	}
 }
 
 func (b *benchmark) Name() string {
@@ -111,6 +153,23 @@ func (b *benchmark) Name() string {
 }
 
 func (b *benchmark) Warmup() error {
+	if b.cfg.ReadRatio < 0 || b.cfg.ReadRatio > 1 {
+		return fmt.Errorf("invalid read ratio %v, it must be between 0 and 1", b.cfg.ReadRatio)
+	}
+
+	if b.cfg.ReadRatio > 0 && b.cfg.KeySpace <= 0 {
+		return fmt.Errorf("a key space is required when reading keys")
+	}
+
+	if b.cfg.Address != "" {
+		err := b.connectClients(b.cfg.Address, b.cfg.Port)
+		if err != nil {
+			return err
+		}
+
+		return b.populateKeySpace()
+	}
+
 	primaryPath, err := os.MkdirTemp("", "tx-test-primary")
 	if err != nil {
 		return err
@@ -205,13 +264,68 @@ func (b *benchmark) Warmup() error {
 		time.Sleep(1 * time.Second)
 	}
 
+	err = b.connectClients("127.0.0.1", primaryPort)
+	if err != nil {
+		return err
+	}
+
+	return b.populateKeySpace()
+}
+
+// keyAt returns the key at the given position of the key space
+func (b *benchmark) keyAt(i uint64) []byte {
+	key := h256(fmt.Sprintf("KEY:%010d", i))
+	if len(key) > b.cfg.KeySize {
+		key = key[:b.cfg.KeySize]
+	}
+	return key
+}
+
+// populateKeySpace writes every key of the key space so all of them can be read during the run
+func (b *benchmark) populateKeySpace() error {
+	if b.cfg.KeySpace <= 0 {
+		return nil
+	}
+
+	const populateBatchSize = 1000
+
+	rand := benchmarks.NewRandStringGen(b.cfg.ValueSize)
+	defer rand.Stop()
+
+	for i := 0; i < b.cfg.KeySpace; i += populateBatchSize {
+		n := b.cfg.KeySpace - i
+		if n > populateBatchSize {
+			n = populateBatchSize
+		}
+
+		setRequest := schema.SetRequest{
+			KVs: make([]*schema.KeyValue, n),
+		}
+
+		for j := 0; j < n; j++ {
+			setRequest.KVs[j] = &schema.KeyValue{
+				Key:   b.keyAt(uint64(i + j)),
+				Value: rand.GetRnd(),
+			}
+		}
+
+		_, err := b.clients[0].SetAll(context.Background(), &setRequest)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *benchmark) connectClients(address string, port int) error {
 	b.clients = []client.ImmuClient{}
 	for i := 0; i < b.cfg.Workers; i++ {
 		path, err := os.MkdirTemp("", "immudb_client")
 		if err != nil {
 			return err
 		}
-		c := client.NewClient().WithOptions(client.DefaultOptions().WithPort(primaryPort).WithDir(path))
+		c := client.NewClient().WithOptions(client.DefaultOptions().WithAddress(address).WithPort(port).WithDir(path))
 
 		err = c.OpenSession(context.Background(), []byte(`immudb`), []byte(`immudb`), "defaultdb")
 		if err != nil {
@@ -233,7 +347,9 @@ func (b *benchmark) Cleanup() error {
 		}
 	}
 
-	b.primaryServer.Stop()
+	if b.primaryServer != nil {
+		b.primaryServer.Stop()
+	}
 
 	if b.replicaServer != nil {
 		b.replicaServer.Stop()
@@ -268,6 +384,7 @@ func (b *benchmark) Run(duration time.Duration, seed uint64) (interface{}, error
 			defer wg.Done()
 
 			client := b.clients[i]
+			rnd := mrand.New(mrand.NewSource(int64(seed) + int64(i)))
 
 			for {
 
@@ -277,15 +394,42 @@ func (b *benchmark) Run(duration time.Duration, seed uint64) (interface{}, error
 				default:
 				}
 
+				if b.cfg.ReadRatio > 0 && rnd.Float64() < b.cfg.ReadRatio {
+					key := b.keyAt(rnd.Uint64() % uint64(b.cfg.KeySpace))
+
+					start := time.Now()
+
+					_, err := client.Get(context.Background(), key)
+
+					b.readLatencies.Record(time.Since(start))
+
+					if err != nil {
+						select {
+						case errChan <- err:
+							return
+						default:
+						}
+					}
+
+					atomic.AddInt64(&b.readSoFar, 1)
+					continue
+				}
+
 				setRequest := schema.SetRequest{
 					KVs:    make([]*schema.KeyValue, b.cfg.BatchSize),
 					NoWait: b.cfg.AsyncWrite,
 				}
 
 				for i := 0; i < b.cfg.BatchSize; i++ {
-					key := h256(kt.GetWKey())
-					if len(key) > b.cfg.KeySize {
-						key = key[:b.cfg.KeySize]
+					var key []byte
+
+					if b.cfg.KeySpace > 0 {
+						key = b.keyAt(rnd.Uint64() % uint64(b.cfg.KeySpace))
+					} else {
+						key = h256(kt.GetWKey())
+						if len(key) > b.cfg.KeySize {
+							key = key[:b.cfg.KeySize]
+						}
 					}
 
 					setRequest.KVs[i] = &schema.KeyValue{
@@ -294,8 +438,12 @@ func (b *benchmark) Run(duration time.Duration, seed uint64) (interface{}, error
 					}
 				}
 
+				start := time.Now()
+
 				_, err := client.SetAll(context.Background(), &setRequest)
 
+				b.writeLatencies.Record(time.Since(start))
+
 				if err != nil {
 					select {
 					case errChan <- err:
@@ -333,16 +481,21 @@ func (b *benchmark) genResults(asProbe bool) interface{} {
 
 	txSoFar := atomic.LoadInt64(&b.txSoFar)
 	kvSoFar := atomic.LoadInt64(&b.kvSoFar)
+	readSoFar := atomic.LoadInt64(&b.readSoFar)
 
 	now := time.Now()
 
 	d := now.Sub(b.startTime)
 
 	res := &Result{
-		TxTotal: txSoFar,
-		KvTotal: kvSoFar,
-		Txs:     float64(txSoFar) * float64(time.Second) / float64(d),
-		Kvs:     float64(kvSoFar) * float64(time.Second) / float64(d),
+		TxTotal:      txSoFar,
+		KvTotal:      kvSoFar,
+		ReadTotal:    readSoFar,
+		Txs:          float64(txSoFar) * float64(time.Second) / float64(d),
+		Kvs:          float64(kvSoFar) * float64(time.Second) / float64(d),
+		Reads:        float64(readSoFar) * float64(time.Second) / float64(d),
+		WriteLatency: b.writeLatencies.Stats(),
+		ReadLatency:  b.readLatencies.Stats(),
 	}
 
 	if asProbe {
@@ -354,9 +507,11 @@ func (b *benchmark) genResults(asProbe bool) interface{} {
 
 		res.TxsInst = float64(txSoFar-b.lastProbeTxSoFar) * float64(time.Second) / float64(dSinceLastProbe)
 		res.KvsInst = float64(kvSoFar-b.lastProbeKVSoFar) * float64(time.Second) / float64(dSinceLastProbe)
+		res.ReadsInst = float64(readSoFar-b.lastProbeReadSoFar) * float64(time.Second) / float64(dSinceLastProbe)
 
 		b.lastProbeTxSoFar = txSoFar
 		b.lastProbeKVSoFar = kvSoFar
+		b.lastProbeReadSoFar = readSoFar
 		b.lastProbeTime = now
 
 	}
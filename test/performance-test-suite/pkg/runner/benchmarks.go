@@ -17,130 +17,174 @@ limitations under the License.
 package runner
 
 import (
+	"fmt"
+
 	"github.com/codenotary/immudb/test/performance-test-suite/pkg/benchmarks"
 	"github.com/codenotary/immudb/test/performance-test-suite/pkg/benchmarks/writetxs"
 )
 
-func getBenchmarksToRun() []benchmarks.Benchmark {
-	return []benchmarks.Benchmark{
-		writetxs.NewBenchmark(writetxs.Config{
-			Name:       "Write TX/s async - no replicas",
-			Workers:    30,
-			BatchSize:  1,
-			KeySize:    32,
-			ValueSize:  128,
-			AsyncWrite: true,
-			Replica:    "",
-		}),
+var writeTxsConfigs = []writetxs.Config{
+	{
+		Name:       "Write TX/s async - no replicas",
+		Workers:    30,
+		BatchSize:  1,
+		KeySize:    32,
+		ValueSize:  128,
+		AsyncWrite: true,
+		Replica:    "",
+	},
+
+	{
+		Name:       "Write KV/s async - no replicas",
+		Workers:    30,
+		BatchSize:  1000,
+		KeySize:    32,
+		ValueSize:  128,
+		AsyncWrite: true,
+		Replica:    "",
+	},
+
+	{
+		Name:       "Write TX/s async - one async replica",
+		Workers:    30,
+		BatchSize:  1,
+		KeySize:    32,
+		ValueSize:  128,
+		AsyncWrite: true,
+		Replica:    "async",
+	},
+
+	{
+		Name:       "Write KV/s async - one async replica",
+		Workers:    30,
+		BatchSize:  1000,
+		KeySize:    32,
+		ValueSize:  128,
+		AsyncWrite: true,
+		Replica:    "async",
+	},
+
+	{
+		Name:       "Write TX/s async - one sync replica",
+		Workers:    30,
+		BatchSize:  1,
+		KeySize:    32,
+		ValueSize:  128,
+		AsyncWrite: true,
+		Replica:    "sync",
+	},
+
+	{
+		Name:       "Write KV/s async - one sync replica",
+		Workers:    30,
+		BatchSize:  1000,
+		KeySize:    32,
+		ValueSize:  128,
+		AsyncWrite: true,
+		Replica:    "sync",
+	},
+
+	{
+		Name:       "Write TX/s sync - no replicas",
+		Workers:    30,
+		BatchSize:  1,
+		KeySize:    32,
+		ValueSize:  128,
+		AsyncWrite: false,
+		Replica:    "",
+	},
+
+	{
+		Name:       "Write KV/s sync - no replicas",
+		Workers:    30,
+		BatchSize:  1000,
+		KeySize:    32,
+		ValueSize:  128,
+		AsyncWrite: false,
+		Replica:    "",
+	},
+
+	{
+		Name:       "Write TX/s sync - one async replica",
+		Workers:    30,
+		BatchSize:  1,
+		KeySize:    32,
+		ValueSize:  128,
+		AsyncWrite: false,
+		Replica:    "async",
+	},
+
+	{
+		Name:       "Write KV/s sync - one async replica",
+		Workers:    30,
+		BatchSize:  1000,
+		KeySize:    32,
+		ValueSize:  128,
+		AsyncWrite: false,
+		Replica:    "async",
+	},
+
+	{
+		Name:       "Write TX/s sync - one sync replica",
+		Workers:    30,
+		BatchSize:  1,
+		KeySize:    32,
+		ValueSize:  128,
+		AsyncWrite: false,
+		Replica:    "sync",
+	},
+
+	{
+		Name:       "Write KV/s sync - one sync replica",
+		Workers:    30,
+		BatchSize:  1000,
+		KeySize:    32,
+		ValueSize:  128,
+		AsyncWrite: false,
+		Replica:    "sync",
+	},
+}
 
-		writetxs.NewBenchmark(writetxs.Config{
-			Name:       "Write KV/s async - no replicas",
-			Workers:    30,
-			BatchSize:  1000,
-			KeySize:    32,
-			ValueSize:  128,
-			AsyncWrite: true,
-			Replica:    "",
-		}),
+func getBenchmarksToRun(cfg Config) []benchmarks.Benchmark {
+	var ret []benchmarks.Benchmark
 
-		writetxs.NewBenchmark(writetxs.Config{
-			Name:       "Write TX/s async - one async replica",
-			Workers:    30,
-			BatchSize:  1,
-			KeySize:    32,
-			ValueSize:  128,
-			AsyncWrite: true,
-			Replica:    "async",
-		}),
+	configs := append([]writetxs.Config{}, writeTxsConfigs...)
 
-		writetxs.NewBenchmark(writetxs.Config{
-			Name:       "Write KV/s async - one async replica",
-			Workers:    30,
-			BatchSize:  1000,
-			KeySize:    32,
-			ValueSize:  128,
-			AsyncWrite: true,
-			Replica:    "async",
-		}),
-
-		writetxs.NewBenchmark(writetxs.Config{
-			Name:       "Write TX/s async - one sync replica",
-			Workers:    30,
-			BatchSize:  1,
-			KeySize:    32,
-			ValueSize:  128,
-			AsyncWrite: true,
-			Replica:    "sync",
-		}),
-
-		writetxs.NewBenchmark(writetxs.Config{
-			Name:       "Write KV/s async - one sync replica",
-			Workers:    30,
-			BatchSize:  1000,
-			KeySize:    32,
-			ValueSize:  128,
-			AsyncWrite: true,
-			Replica:    "sync",
-		}),
-
-		writetxs.NewBenchmark(writetxs.Config{
-			Name:       "Write TX/s sync - no replicas",
+	if cfg.ReadRatio > 0 {
+		configs = append(configs, writetxs.Config{
+			Name:       fmt.Sprintf("Mixed %.0f%% reads sync - no replicas", cfg.ReadRatio*100),
 			Workers:    30,
 			BatchSize:  1,
 			KeySize:    32,
 			ValueSize:  128,
 			AsyncWrite: false,
 			Replica:    "",
-		}),
+			ReadRatio:  cfg.ReadRatio,
+			KeySpace:   cfg.KeySpace,
+		})
+	}
 
-		writetxs.NewBenchmark(writetxs.Config{
-			Name:       "Write KV/s sync - no replicas",
-			Workers:    30,
-			BatchSize:  1000,
-			KeySize:    32,
-			ValueSize:  128,
-			AsyncWrite: false,
-			Replica:    "",
-		}),
+	for _, benchmarkCfg := range configs {
+		if cfg.Concurrency > 0 {
+			benchmarkCfg.Workers = cfg.Concurrency
+		}
 
-		writetxs.NewBenchmark(writetxs.Config{
-			Name:       "Write TX/s sync - one async replica",
-			Workers:    30,
-			BatchSize:  1,
-			KeySize:    32,
-			ValueSize:  128,
-			AsyncWrite: false,
-			Replica:    "async",
-		}),
+		if cfg.ValueSize > 0 {
+			benchmarkCfg.ValueSize = cfg.ValueSize
+		}
 
-		writetxs.NewBenchmark(writetxs.Config{
-			Name:       "Write KV/s sync - one async replica",
-			Workers:    30,
-			BatchSize:  1000,
-			KeySize:    32,
-			ValueSize:  128,
-			AsyncWrite: false,
-			Replica:    "async",
-		}),
+		if cfg.Address != "" {
+			if benchmarkCfg.Replica != "" {
+				// replicas can only be set up when using embedded servers
+				continue
+			}
 
-		writetxs.NewBenchmark(writetxs.Config{
-			Name:       "Write TX/s sync - one sync replica",
-			Workers:    30,
-			BatchSize:  1,
-			KeySize:    32,
-			ValueSize:  128,
-			AsyncWrite: false,
-			Replica:    "sync",
-		}),
+			benchmarkCfg.Address = cfg.Address
+			benchmarkCfg.Port = cfg.Port
+		}
 
-		writetxs.NewBenchmark(writetxs.Config{
-			Name:       "Write KV/s sync - one sync replica",
-			Workers:    30,
-			BatchSize:  1000,
-			KeySize:    32,
-			ValueSize:  128,
-			AsyncWrite: false,
-			Replica:    "sync",
-		}),
+		ret = append(ret, writetxs.NewBenchmark(benchmarkCfg))
 	}
+
+	return ret
 }
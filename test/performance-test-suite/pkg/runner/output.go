@@ -0,0 +1,144 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/codenotary/immudb/test/performance-test-suite/pkg/benchmarks"
+)
+
+const (
+	OutputText = "text"
+	OutputJSON = "json"
+	OutputCSV  = "csv"
+)
+
+// csvHeader lists summary columns written in csv format, units are part of column names.
+// Columns must only be appended so that results of different runs remain comparable
+var csvHeader = []string{
+	"name",
+	"duration_s",
+	"tx_total",
+	"kv_total",
+	"read_total",
+	"tx_per_s",
+	"kv_per_s",
+	"read_per_s",
+	"write_latency_p50_ms",
+	"write_latency_p90_ms",
+	"write_latency_p99_ms",
+	"write_latency_max_ms",
+	"read_latency_p50_ms",
+	"read_latency_p90_ms",
+	"read_latency_p99_ms",
+	"read_latency_max_ms",
+}
+
+// WriteResults writes benchmark results in the requested format
+func WriteResults(w io.Writer, format string, results *BenchmarkSuiteResult) error {
+	switch format {
+	case OutputText:
+		return writeText(w, results)
+	case OutputJSON:
+		return writeJSON(w, results)
+	case OutputCSV:
+		return writeCSV(w, results)
+	}
+
+	return fmt.Errorf("unsupported output format '%s', use one of: %s, %s, %s", format, OutputText, OutputJSON, OutputCSV)
+}
+
+func writeText(w io.Writer, results *BenchmarkSuiteResult) error {
+	for _, b := range results.Benchmarks {
+		_, err := fmt.Fprintf(w, "%s (%s): %s\n", b.Name, time.Duration(b.Duration), b.Summary)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeJSON(w io.Writer, results *BenchmarkSuiteResult) error {
+	e := json.NewEncoder(w)
+	e.SetIndent("", "   ")
+	return e.Encode(results)
+}
+
+func writeCSV(w io.Writer, results *BenchmarkSuiteResult) error {
+	cw := csv.NewWriter(w)
+
+	err := cw.Write(csvHeader)
+	if err != nil {
+		return err
+	}
+
+	for _, b := range results.Benchmarks {
+		record := []string{
+			b.Name,
+			formatFloat(b.Duration.Seconds()),
+		}
+
+		if b.Results != nil {
+			record = append(record,
+				strconv.FormatInt(b.Results.TxTotal, 10),
+				strconv.FormatInt(b.Results.KvTotal, 10),
+				strconv.FormatInt(b.Results.ReadTotal, 10),
+				formatFloat(b.Results.Txs),
+				formatFloat(b.Results.Kvs),
+				formatFloat(b.Results.Reads),
+			)
+			record = append(record, latencyColumns(b.Results.WriteLatency)...)
+			record = append(record, latencyColumns(b.Results.ReadLatency)...)
+		}
+
+		for len(record) < len(csvHeader) {
+			record = append(record, "")
+		}
+
+		err = cw.Write(record)
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func latencyColumns(l *benchmarks.LatencyStats) []string {
+	if l == nil {
+		return []string{"", "", "", ""}
+	}
+
+	return []string{
+		formatFloat(l.P50),
+		formatFloat(l.P90),
+		formatFloat(l.P99),
+		formatFloat(l.Max),
+	}
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', 3, 64)
+}
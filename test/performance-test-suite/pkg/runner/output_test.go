@@ -0,0 +1,90 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/test/performance-test-suite/pkg/benchmarks"
+	"github.com/codenotary/immudb/test/performance-test-suite/pkg/benchmarks/writetxs"
+	"github.com/stretchr/testify/require"
+)
+
+func sampleResults() *BenchmarkSuiteResult {
+	return &BenchmarkSuiteResult{
+		Benchmarks: []BenchmarkRunResult{
+			{
+				Name:     "Write TX/s sync",
+				Summary:  "TX: 100, KV: 1000",
+				Duration: Duration(10 * time.Second),
+				Results: &writetxs.Result{
+					TxTotal:      100,
+					KvTotal:      1000,
+					Txs:          10,
+					Kvs:          100,
+					WriteLatency: &benchmarks.LatencyStats{P50: 1, P90: 2, P99: 3, Max: 4},
+				},
+			},
+			{
+				Name:     "Failed run",
+				Duration: Duration(time.Second),
+			},
+		},
+	}
+}
+
+func TestWriteResults(t *testing.T) {
+	t.Run("text", func(t *testing.T) {
+		var b bytes.Buffer
+		err := WriteResults(&b, OutputText, sampleResults())
+		require.NoError(t, err)
+		require.Equal(t, "Write TX/s sync (10s): TX: 100, KV: 1000\nFailed run (1s): \n", b.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var b bytes.Buffer
+		err := WriteResults(&b, OutputJSON, sampleResults())
+		require.NoError(t, err)
+
+		var decoded map[string]interface{}
+		err = json.Unmarshal(b.Bytes(), &decoded)
+		require.NoError(t, err)
+		require.Len(t, decoded["benchmarks"], 2)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		var b bytes.Buffer
+		err := WriteResults(&b, OutputCSV, sampleResults())
+		require.NoError(t, err)
+
+		lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+		require.Len(t, lines, 3)
+		require.Equal(t, strings.Join(csvHeader, ","), lines[0])
+		require.Equal(t, "Write TX/s sync,10.000,100,1000,0,10.000,100.000,0.000,1.000,2.000,3.000,4.000,,,,", lines[1])
+		require.Equal(t, "Failed run,1.000,,,,,,,,,,,,,,", lines[2])
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		var b bytes.Buffer
+		err := WriteResults(&b, "xml", sampleResults())
+		require.Error(t, err)
+	})
+}
@@ -22,10 +22,56 @@ import (
 	"sync"
 	"time"
 
+	"github.com/codenotary/immudb/test/performance-test-suite/pkg/benchmarks"
 	"github.com/codenotary/immudb/test/performance-test-suite/pkg/benchmarks/writetxs"
 )
 
-func RunAllBenchmarks(d time.Duration, seed uint64) (*BenchmarkSuiteResult, error) {
+// Config holds the parameters of a performance test suite run
+type Config struct {
+	Duration time.Duration
+	Seed     uint64
+
+	// Concurrency and ValueSize override the number of concurrent clients
+	// and the size of written values of every benchmark when set
+	Concurrency int
+	ValueSize   int
+
+	// ReadRatio and KeySpace configure an additional benchmark with a mix of reads and writes,
+	// it's only run when the read ratio is set
+	ReadRatio float64
+	KeySpace  int
+
+	// Address and Port of a running immudb server to benchmark,
+	// embedded servers are started when no address is provided
+	Address string
+	Port    int
+}
+
+// Runner executes a set of benchmarks one after another and gathers their results,
+// it can be used to embed immudb load tests in other test suites
+type Runner struct {
+	cfg        Config
+	benchmarks []benchmarks.Benchmark
+}
+
+// NewRunner creates a runner executing the standard set of benchmarks
+// adjusted to the given configuration
+func NewRunner(cfg Config) *Runner {
+	return &Runner{
+		cfg:        cfg,
+		benchmarks: getBenchmarksToRun(cfg),
+	}
+}
+
+// WithBenchmarks replaces the standard set of benchmarks with the given ones,
+// e.g. created with writetxs.NewBenchmark using a custom workload
+func (r *Runner) WithBenchmarks(b ...benchmarks.Benchmark) *Runner {
+	r.benchmarks = b
+	return r
+}
+
+// Run executes all benchmarks, each one is run for the configured duration
+func (r *Runner) Run() (*BenchmarkSuiteResult, error) {
 	ret := &BenchmarkSuiteResult{
 		StartTime:   time.Now(),
 		ProcessInfo: gatherProcessInfo(),
@@ -34,85 +80,103 @@ func RunAllBenchmarks(d time.Duration, seed uint64) (*BenchmarkSuiteResult, erro
 
 	log.Printf("Starting immudb performance test suite")
 
-	for _, b := range getBenchmarksToRun() {
-
-		log.Printf("Running benchmark: %s", b.Name())
-
-		result := BenchmarkRunResult{
-			Name:     b.Name(),
-			Timeline: []BenchmarkTimelineEntry{},
-		}
-
-		err := b.Warmup()
+	for _, b := range r.benchmarks {
+		result, err := r.runBenchmark(b)
 		if err != nil {
 			return nil, err
 		}
 
-		result.StartTime = time.Now()
-
-		// Start probing goroutine
-		done := make(chan bool)
-		wg := sync.WaitGroup{}
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-
-			ticker := time.NewTicker(time.Second)
-			defer ticker.Stop()
-
-			for {
-
-				select {
-				case <-done:
-					return
-				case <-ticker.C:
-				}
-
-				now := time.Now()
-				probe := b.Probe()
-				result.Timeline = append(result.Timeline, BenchmarkTimelineEntry{
-					Time:     now,
-					Duration: Duration(now.Sub(result.StartTime)),
-					Probe:    probe,
-				})
-
-				log.Printf(
-					"[%s] %v/%v %s",
-					result.Name,
-					now.Sub(result.StartTime).Round(time.Second),
-					d,
-					probe,
-				)
+		ret.Benchmarks = append(ret.Benchmarks, *result)
+	}
+
+	ret.EndTime = time.Now()
+	ret.Duration = Duration(ret.EndTime.Sub(ret.StartTime))
+
+	log.Printf("Finished immudb performance test suite")
+	return ret, nil
+}
+
+func (r *Runner) runBenchmark(b benchmarks.Benchmark) (*BenchmarkRunResult, error) {
+	d := r.cfg.Duration
+
+	log.Printf("Running benchmark: %s", b.Name())
+
+	result := &BenchmarkRunResult{
+		Name:     b.Name(),
+		Timeline: []BenchmarkTimelineEntry{},
+	}
+
+	defer b.Cleanup()
+
+	err := b.Warmup()
+	if err != nil {
+		return nil, fmt.Errorf("benchmark %s: %w", b.Name(), err)
+	}
+
+	result.StartTime = time.Now()
+
+	// Start probing goroutine
+	done := make(chan bool)
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		for {
+
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
 			}
-		}()
 
-		// Run the benchmark
-		res, err := b.Run(d, seed)
-		if err != nil {
-			return nil, err
+			now := time.Now()
+			probe := b.Probe()
+			result.Timeline = append(result.Timeline, BenchmarkTimelineEntry{
+				Time:     now,
+				Duration: Duration(now.Sub(result.StartTime)),
+				Probe:    probe,
+			})
+
+			log.Printf(
+				"[%s] %v/%v %s",
+				result.Name,
+				now.Sub(result.StartTime).Round(time.Second),
+				d,
+				probe,
+			)
 		}
+	}()
 
-		// Notify that we're done probing
-		close(done)
-		wg.Wait()
-
-		result.Summary = fmt.Sprint(res)
-		result.EndTime = time.Now()
-		result.Duration = Duration(result.EndTime.Sub(result.StartTime))
-		result.RequestedDuration = Duration(d)
-		result.Results = res.(*writetxs.Result)
+	// Run the benchmark
+	res, err := b.Run(d, r.cfg.Seed)
 
-		ret.Benchmarks = append(ret.Benchmarks, result)
+	// Notify that we're done probing
+	close(done)
+	wg.Wait()
 
-		log.Printf("Benchmark %s finished", b.Name())
-		log.Printf("Results: %s", res)
+	if err != nil {
+		return nil, fmt.Errorf("benchmark %s: %w", b.Name(), err)
+	}
 
-		b.Cleanup()
+	result.Summary = fmt.Sprint(res)
+	result.EndTime = time.Now()
+	result.Duration = Duration(result.EndTime.Sub(result.StartTime))
+	result.RequestedDuration = Duration(d)
+	if writeTxsResult, ok := res.(*writetxs.Result); ok {
+		result.Results = writeTxsResult
 	}
 
-	ret.EndTime = time.Now()
-	ret.Duration = Duration(ret.EndTime.Sub(ret.StartTime))
+	log.Printf("Benchmark %s finished", b.Name())
+	log.Printf("Results: %s", res)
 
-	log.Printf("Finished immudb performance test suite")
-	return ret, nil
+	return result, nil
+}
+
+// RunAllBenchmarks executes the standard set of benchmarks
+func RunAllBenchmarks(cfg Config) (*BenchmarkSuiteResult, error) {
+	return NewRunner(cfg).Run()
 }
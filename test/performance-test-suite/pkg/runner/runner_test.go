@@ -0,0 +1,110 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/test/performance-test-suite/pkg/benchmarks/writetxs"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeBenchmark struct {
+	name      string
+	warmupErr error
+	runErr    error
+	result    interface{}
+
+	ranFor    time.Duration
+	seed      uint64
+	cleanedUp bool
+}
+
+func (b *fakeBenchmark) Name() string       { return b.name }
+func (b *fakeBenchmark) Warmup() error      { return b.warmupErr }
+func (b *fakeBenchmark) Probe() interface{} { return nil }
+
+func (b *fakeBenchmark) Cleanup() error {
+	b.cleanedUp = true
+	return nil
+}
+
+func (b *fakeBenchmark) Run(duration time.Duration, seed uint64) (interface{}, error) {
+	b.ranFor = duration
+	b.seed = seed
+	return b.result, b.runErr
+}
+
+func TestRunner(t *testing.T) {
+	cfg := Config{
+		Duration: time.Millisecond,
+		Seed:     42,
+	}
+
+	t.Run("standard benchmarks", func(t *testing.T) {
+		r := NewRunner(cfg)
+		require.Len(t, r.benchmarks, len(writeTxsConfigs))
+	})
+
+	t.Run("custom benchmarks", func(t *testing.T) {
+		b1 := &fakeBenchmark{name: "b1", result: &writetxs.Result{TxTotal: 10}}
+		b2 := &fakeBenchmark{name: "b2", result: "custom result"}
+
+		res, err := NewRunner(cfg).WithBenchmarks(b1, b2).Run()
+		require.NoError(t, err)
+		require.Len(t, res.Benchmarks, 2)
+
+		for _, b := range []*fakeBenchmark{b1, b2} {
+			require.Equal(t, cfg.Duration, b.ranFor)
+			require.Equal(t, cfg.Seed, b.seed)
+			require.True(t, b.cleanedUp)
+		}
+
+		require.Equal(t, "b1", res.Benchmarks[0].Name)
+		require.EqualValues(t, 10, res.Benchmarks[0].Results.TxTotal)
+		require.Equal(t, Duration(cfg.Duration), res.Benchmarks[0].RequestedDuration)
+
+		require.Equal(t, "b2", res.Benchmarks[1].Name)
+		require.Equal(t, "custom result", res.Benchmarks[1].Summary)
+		require.Nil(t, res.Benchmarks[1].Results)
+	})
+
+	t.Run("failing benchmark", func(t *testing.T) {
+		errRun := errors.New("run error")
+
+		b1 := &fakeBenchmark{name: "b1", runErr: errRun}
+		b2 := &fakeBenchmark{name: "b2"}
+
+		_, err := NewRunner(cfg).WithBenchmarks(b1, b2).Run()
+		require.ErrorIs(t, err, errRun)
+		require.True(t, b1.cleanedUp)
+		require.Zero(t, b2.ranFor)
+	})
+
+	t.Run("failing warmup", func(t *testing.T) {
+		errWarmup := errors.New("warmup error")
+
+		b := &fakeBenchmark{name: "b", warmupErr: errWarmup}
+
+		_, err := NewRunner(cfg).WithBenchmarks(b).Run()
+		require.ErrorIs(t, err, errWarmup)
+		require.True(t, b.cleanedUp)
+		require.Zero(t, b.ranFor)
+	})
+}